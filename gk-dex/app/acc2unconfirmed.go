@@ -2,16 +2,30 @@ package app
 
 import (
 	"bytes"
+	"encoding/binary"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
 )
 
 const (
 	CodeSpaceUnconfirmedLimit sdk.CodespaceType = "unconfirmed_limit"
 	CodeTooManyUnconfirmedTx  sdk.CodeType      = 2100
+	CodeTxRateLimited         sdk.CodeType      = 2101
 )
 
-var errTooManyUnconfirmedTx = sdk.NewError(CodeSpaceUnconfirmedLimit, CodeTooManyUnconfirmedTx, "Too Many Unconfirmed Transactions")
+func errTooManyUnconfirmedTx(limit int) sdk.Error {
+	return sdk.NewError(CodeSpaceUnconfirmedLimit, CodeTooManyUnconfirmedTx,
+		fmt.Sprintf("Too Many Unconfirmed Transactions, at most %d unconfirmed transactions are allowed for an account", limit))
+}
+
+func errTxRateLimited(retryAfter int64) sdk.Error {
+	return sdk.NewError(CodeSpaceUnconfirmedLimit, CodeTxRateLimited,
+		fmt.Sprintf("Transaction Rate Limited, retry after %d seconds", retryAfter))
+}
 
 const (
 	SameTxExist      = 1
@@ -26,58 +40,191 @@ type UnconfirmedTx struct {
 	Timestamp int64
 }
 
+type removedTx struct {
+	addr   sdk.AccAddress
+	hashID []byte
+}
+
+// tokenBucket limits the rate of the txs of an account, one token is taken by every admitted tx
+type tokenBucket struct {
+	tier       AdmissionTier
+	tokens     float64
+	lastRefill int64
+}
+
+func (b *tokenBucket) refill(timestamp int64) {
+	if timestamp > b.lastRefill {
+		b.tokens += float64(timestamp-b.lastRefill) * b.tier.RefillPerSecond
+		b.lastRefill = timestamp
+	}
+	if b.tokens > b.tier.BucketSize {
+		b.tokens = b.tier.BucketSize
+	}
+}
+
+// retryAfter returns the seconds to wait for the next token
+func (b *tokenBucket) retryAfter() int64 {
+	return int64((1-b.tokens)/b.tier.RefillPerSecond) + 1
+}
+
 type Account2UnconfirmedTx struct {
-	auMap         map[string]UnconfirmedTx
+	auMap         map[string][]UnconfirmedTx
+	buckets       map[string]*tokenBucket
+	policy        AdmissionPolicy
 	limitTime     int64
-	removeList    []sdk.AccAddress
+	removeList    []removedTx
 	lastSweepTime int64
 }
 
 func NewAccount2UnconfirmedTx(limitTime int64) *Account2UnconfirmedTx {
 	return &Account2UnconfirmedTx{
-		auMap:         make(map[string]UnconfirmedTx),
+		auMap:         make(map[string][]UnconfirmedTx),
+		buckets:       make(map[string]*tokenBucket),
+		policy:        DefaultAdmissionPolicy(),
 		limitTime:     limitTime,
-		removeList:    make([]sdk.AccAddress, 0, 5000),
+		removeList:    make([]removedTx, 0, 5000),
 		lastSweepTime: 0,
 	}
 }
 
-func (acc2unc *Account2UnconfirmedTx) Lookup(addr sdk.AccAddress, hashid []byte, timestamp int64) int {
-	unconfirmedTx, ok := acc2unc.auMap[string(addr)]
-	expired := timestamp-unconfirmedTx.Timestamp > acc2unc.limitTime
-	if !ok || expired {
-		return NoTxExist
+// unconfirmedTxKeys returns the keys of the signers of stdTx in Account2UnconfirmedTx, the lane is
+// appended to the address of the signer using a nonce lane, so the unconfirmed txs of its lanes are
+// counted separately, while they share the token bucket of the signer
+func unconfirmedTxKeys(stdTx auth.StdTx, signers []sdk.AccAddress) []sdk.AccAddress {
+	keys := make([]sdk.AccAddress, len(signers))
+	copy(keys, signers)
+	for _, msg := range stdTx.Msgs {
+		msg, ok := msg.(authx.MsgUseNonceLane)
+		if !ok {
+			continue
+		}
+		for i, signer := range signers {
+			if signer.Equals(msg.Sender) {
+				var lane [4]byte
+				binary.BigEndian.PutUint32(lane[:], msg.Lane)
+				keys[i] = append(append(sdk.AccAddress{}, signer...), lane[:]...)
+			}
+		}
+	}
+	return keys
+}
+
+func (acc2unc *Account2UnconfirmedTx) SetAdmissionPolicy(policy AdmissionPolicy) {
+	acc2unc.policy = policy
+}
+
+// unexpiredCount returns the number of the unexpired txs of addr except hashid, and whether hashid exists
+func (acc2unc *Account2UnconfirmedTx) unexpiredCount(addr sdk.AccAddress, hashid []byte, timestamp int64) (int, bool) {
+	count := 0
+	for _, unconfirmedTx := range acc2unc.auMap[string(addr)] {
+		if timestamp-unconfirmedTx.Timestamp > acc2unc.limitTime {
+			continue
+		}
+		if bytes.Equal(unconfirmedTx.HashID, hashid) {
+			return count, true
+		}
+		count++
 	}
-	if bytes.Equal(unconfirmedTx.HashID, hashid) {
+	return count, false
+}
+
+func (acc2unc *Account2UnconfirmedTx) Lookup(addr sdk.AccAddress, hashid []byte, timestamp int64) int {
+	count, same := acc2unc.unexpiredCount(addr, hashid, timestamp)
+	if same {
 		return SameTxExist
 	}
-	return OtherTxExist
+	if count != 0 {
+		return OtherTxExist
+	}
+	return NoTxExist
 }
 
-func (acc2unc *Account2UnconfirmedTx) Add(addr sdk.AccAddress, hashid []byte, timestamp int64) {
-	acc2unc.auMap[string(addr)] = UnconfirmedTx{HashID: hashid, Timestamp: timestamp}
+// Admit checks whether a new tx of signer is allowed by the tier of the admission policy, the unconfirmed
+// txs are counted by key, which is the signer or its nonce lane, and the tokens are taken from the signer.
+// A tx which has been admitted is admitted again without taking a token.
+func (acc2unc *Account2UnconfirmedTx) Admit(signer, key sdk.AccAddress, hashid []byte, timestamp int64, priority bool) sdk.Error {
+	tier := acc2unc.policy.Default
+	if priority {
+		tier = acc2unc.policy.Priority
+	}
+	count, same := acc2unc.unexpiredCount(key, hashid, timestamp)
+	if same {
+		return nil
+	}
+	if count >= tier.MaxUnconfirmed {
+		return errTooManyUnconfirmedTx(tier.MaxUnconfirmed)
+	}
+	if tier.BucketSize <= 0 {
+		return nil
+	}
+	bucket, ok := acc2unc.buckets[string(signer)]
+	if !ok {
+		bucket = &tokenBucket{tier: tier, tokens: tier.BucketSize, lastRefill: timestamp}
+		acc2unc.buckets[string(signer)] = bucket
+	}
+	bucket.tier = tier
+	bucket.refill(timestamp)
+	if bucket.tokens < 1 {
+		return errTxRateLimited(bucket.retryAfter())
+	}
+	return nil
 }
 
-func (acc2unc *Account2UnconfirmedTx) AddToRemoveList(addrs []sdk.AccAddress) {
-	acc2unc.removeList = append(acc2unc.removeList, addrs...)
+func (acc2unc *Account2UnconfirmedTx) Add(signer, key sdk.AccAddress, hashid []byte, timestamp int64) {
+	s := string(key)
+	if _, same := acc2unc.unexpiredCount(key, hashid, timestamp); same {
+		return
+	}
+	acc2unc.auMap[s] = append(acc2unc.auMap[s], UnconfirmedTx{HashID: hashid, Timestamp: timestamp})
+	if bucket, ok := acc2unc.buckets[string(signer)]; ok {
+		bucket.tokens--
+	}
+}
+
+func (acc2unc *Account2UnconfirmedTx) AddToRemoveList(addrs []sdk.AccAddress, hashid []byte) {
+	for _, addr := range addrs {
+		acc2unc.removeList = append(acc2unc.removeList, removedTx{addr: addr, hashID: hashid})
+	}
 }
 
 func (acc2unc *Account2UnconfirmedTx) CommitRemove(timestamp int64) {
-	for _, addr := range acc2unc.removeList {
-		s := string(addr)
-		delete(acc2unc.auMap, s) // will do nothing if key not existing
+	for _, removed := range acc2unc.removeList {
+		acc2unc.remove(string(removed.addr), func(unconfirmedTx UnconfirmedTx) bool {
+			return bytes.Equal(unconfirmedTx.HashID, removed.hashID)
+		})
 	}
 	if timestamp-acc2unc.lastSweepTime > SweepPeriod {
-		for acc, unconfirmedTx := range acc2unc.auMap {
-			expired := timestamp-unconfirmedTx.Timestamp > acc2unc.limitTime
-			if expired {
-				delete(acc2unc.auMap, acc)
+		for acc := range acc2unc.auMap {
+			acc2unc.remove(acc, func(unconfirmedTx UnconfirmedTx) bool {
+				return timestamp-unconfirmedTx.Timestamp > acc2unc.limitTime
+			})
+		}
+		// the full buckets are the same as the new ones
+		for acc, bucket := range acc2unc.buckets {
+			bucket.refill(timestamp)
+			if bucket.tokens >= bucket.tier.BucketSize {
+				delete(acc2unc.buckets, acc)
 			}
 		}
 		acc2unc.lastSweepTime = timestamp
 	}
 }
 
+func (acc2unc *Account2UnconfirmedTx) remove(acc string, match func(UnconfirmedTx) bool) {
+	txs := acc2unc.auMap[acc]
+	kept := txs[:0]
+	for _, unconfirmedTx := range txs {
+		if !match(unconfirmedTx) {
+			kept = append(kept, unconfirmedTx)
+		}
+	}
+	if len(kept) == 0 {
+		delete(acc2unc.auMap, acc) // will do nothing if key not existing
+	} else {
+		acc2unc.auMap[acc] = kept
+	}
+}
+
 func (acc2unc *Account2UnconfirmedTx) ClearRemoveList() {
 	acc2unc.removeList = acc2unc.removeList[:0]
 }
@@ -14,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	"github.com/coinexchain/cet-sdk/testutil"
 	dex "github.com/coinexchain/cet-sdk/types"
@@ -45,12 +46,12 @@ func TestAccount2UnconfirmedTx(t *testing.T) {
 	hashID := tmtypes.Tx(txBytes).Hash()
 	exist := app.account2UnconfirmedTx.Lookup(fromAddr, hashID, header.Time.Unix())
 	require.Equal(t, exist, NoTxExist)
-	app.account2UnconfirmedTx.Add(fromAddr, hashID, header.Time.Unix())
+	app.account2UnconfirmedTx.Add(fromAddr, fromAddr, hashID, header.Time.Unix())
 
 	//deliver tx
 	result := app.Deliver(tx)
 	require.Equal(t, errors.CodeOK, result.Code)
-	acc := app.account2UnconfirmedTx.removeList[0]
+	acc := app.account2UnconfirmedTx.removeList[0].addr
 	require.True(t, bytes.Equal(acc, fromAddr))
 
 	//build another address tx
@@ -60,7 +61,7 @@ func TestAccount2UnconfirmedTx(t *testing.T) {
 	hashIDAnother := tmtypes.Tx(txBytes2).Hash()
 	exist = app.account2UnconfirmedTx.Lookup(fromAddr2, hashIDAnother, header.Time.Unix())
 	require.Equal(t, exist, NoTxExist)
-	app.account2UnconfirmedTx.Add(fromAddr, hashIDAnother, header.Time.Unix())
+	app.account2UnconfirmedTx.Add(fromAddr, fromAddr, hashIDAnother, header.Time.Unix())
 
 	//build another same address tx
 	msg = bankx.NewMsgSend(fromAddr, toAddr, coins, 0)
@@ -92,5 +93,102 @@ func TestAccount2UnconfirmedTx(t *testing.T) {
 	hashID3 := tmtypes.Tx(txBytes).Hash()
 	exist = app.account2UnconfirmedTx.Lookup(fromAddr, hashID3, header.Time.Unix())
 	require.Equal(t, exist, NoTxExist)
-	app.account2UnconfirmedTx.Add(fromAddr, hashID3, header.Time.Unix())
+	app.account2UnconfirmedTx.Add(fromAddr, fromAddr, hashID3, header.Time.Unix())
+}
+
+func TestAdmissionPolicy(t *testing.T) {
+	_, _, addr := testutil.KeyPubAddr()
+	acc2unc := NewAccount2UnconfirmedTx(100)
+	acc2unc.SetAdmissionPolicy(AdmissionPolicy{
+		Default:  AdmissionTier{MaxUnconfirmed: 1},
+		Priority: AdmissionTier{MaxUnconfirmed: 3, BucketSize: 2, RefillPerSecond: 0.5},
+	})
+	hash := func(i int) []byte { return []byte{byte(i)} }
+
+	// the default tier allows one unconfirmed tx, which can be checked again
+	require.Nil(t, acc2unc.Admit(addr, addr, hash(1), 0, false))
+	acc2unc.Add(addr, addr, hash(1), 0)
+	require.Nil(t, acc2unc.Admit(addr, addr, hash(1), 0, false))
+	err := acc2unc.Admit(addr, addr, hash(2), 0, false)
+	require.Equal(t, CodeTooManyUnconfirmedTx, err.Code())
+	require.Equal(t, OtherTxExist, acc2unc.Lookup(addr, hash(2), 0))
+
+	// the priority tier allows more unconfirmed txs, limited by the token bucket
+	require.Nil(t, acc2unc.Admit(addr, addr, hash(2), 0, true))
+	acc2unc.Add(addr, addr, hash(2), 0)
+	require.Nil(t, acc2unc.Admit(addr, addr, hash(3), 0, true))
+	acc2unc.Add(addr, addr, hash(3), 0)
+	err = acc2unc.Admit(addr, addr, hash(4), 0, true)
+	require.Equal(t, CodeTooManyUnconfirmedTx, err.Code())
+
+	// only the delivered tx is removed
+	acc2unc.AddToRemoveList([]sdk.AccAddress{addr}, hash(1))
+	acc2unc.CommitRemove(1)
+	acc2unc.ClearRemoveList()
+	require.Len(t, acc2unc.auMap[string(addr)], 2)
+	require.Equal(t, SameTxExist, acc2unc.Lookup(addr, hash(2), 1))
+	err = acc2unc.Admit(addr, addr, hash(4), 1, true)
+	require.Equal(t, CodeTxRateLimited, err.Code())
+	require.Contains(t, err.Error(), "retry after 2 seconds")
+	require.Nil(t, acc2unc.Admit(addr, addr, hash(4), 2, true))
+
+	// the expired txs and the full buckets are swept
+	acc2unc.CommitRemove(SweepPeriod + 200)
+	require.Empty(t, acc2unc.auMap)
+	require.Empty(t, acc2unc.buckets)
+}
+
+func TestAdmissionPolicyPriority(t *testing.T) {
+	policy := DefaultAdmissionPolicy()
+	require.NoError(t, policy.Validate())
+	stdTx := auth.NewStdTx(nil, auth.NewStdFee(1000, dex.NewCetCoins(20000)), nil, "")
+	require.False(t, policy.isPriorityGasPrice(stdTx))
+	policy.PriorityMinGasPrice = sdk.NewDec(20)
+	require.True(t, policy.isPriorityGasPrice(stdTx))
+	policy.PriorityMinGasPrice = sdk.NewDec(21)
+	require.False(t, policy.isPriorityGasPrice(stdTx))
+
+	policy.Priority.BucketSize = 10
+	require.Error(t, policy.Validate())
+	policy.Priority.RefillPerSecond = 1
+	require.NoError(t, policy.Validate())
+	policy.Default.MaxUnconfirmed = 0
+	require.Error(t, policy.Validate())
+}
+
+func TestUnconfirmedTxKeysOfNonceLanes(t *testing.T) {
+	_, _, addr := testutil.KeyPubAddr()
+	_, _, addr2 := testutil.KeyPubAddr()
+	send := bankx.NewMsgSend(addr2, addr, dex.NewCetCoins(1), 0)
+	stdTx := auth.NewStdTx([]sdk.Msg{authx.NewMsgUseNonceLane(addr, 1), send}, auth.NewStdFee(1000, nil), nil, "")
+	keys := unconfirmedTxKeys(stdTx, stdTx.GetSigners())
+	require.Len(t, keys, 2)
+	require.Equal(t, append(append(sdk.AccAddress{}, addr...), 0, 0, 0, 1), keys[0])
+	require.Equal(t, addr2, keys[1])
+
+	// the txs of different lanes of an account can be pending at the same time
+	acc2unc := NewAccount2UnconfirmedTx(100)
+	acc2unc.Add(addr, keys[0], []byte{1}, 0)
+	require.Nil(t, acc2unc.Admit(addr, addr, []byte{2}, 0, false))
+	stdTx.Msgs[0] = authx.NewMsgUseNonceLane(addr, 2)
+	require.Nil(t, acc2unc.Admit(addr, unconfirmedTxKeys(stdTx, stdTx.GetSigners())[0], []byte{2}, 0, false))
+	require.NotNil(t, acc2unc.Admit(addr, keys[0], []byte{2}, 0, false))
+
+	// but they share the token bucket of the account
+	acc2unc = NewAccount2UnconfirmedTx(100)
+	acc2unc.SetAdmissionPolicy(AdmissionPolicy{
+		Default:  AdmissionTier{MaxUnconfirmed: 1, BucketSize: 2, RefillPerSecond: 1},
+		Priority: AdmissionTier{MaxUnconfirmed: 1, BucketSize: 2, RefillPerSecond: 1},
+	})
+	for lane := uint32(1); lane <= 2; lane++ {
+		stdTx.Msgs[0] = authx.NewMsgUseNonceLane(addr, lane)
+		key := unconfirmedTxKeys(stdTx, stdTx.GetSigners())[0]
+		require.Nil(t, acc2unc.Admit(addr, key, []byte{byte(lane)}, 0, false))
+		acc2unc.Add(addr, key, []byte{byte(lane)}, 0)
+	}
+	stdTx.Msgs[0] = authx.NewMsgUseNonceLane(addr, 3)
+	err := acc2unc.Admit(addr, unconfirmedTxKeys(stdTx, stdTx.GetSigners())[0], []byte{3}, 0, false)
+	require.NotNil(t, err)
+	require.Equal(t, CodeTxRateLimited, err.Code())
+	require.Len(t, acc2unc.buckets, 1)
 }
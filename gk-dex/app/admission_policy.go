@@ -0,0 +1,97 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// the delegations beyond it are not counted as the staked CET of an account
+const maxStakeDelegations = 100
+
+// AdmissionTier limits the unconfirmed txs of an account. The rate of its txs is limited by a
+// token bucket of BucketSize tokens refilled by RefillPerSecond, which is disabled if BucketSize is 0.
+type AdmissionTier struct {
+	MaxUnconfirmed  int     `json:"max_unconfirmed"`
+	BucketSize      float64 `json:"bucket_size"`
+	RefillPerSecond float64 `json:"refill_per_second"`
+}
+
+// AdmissionPolicy decides which txs are accepted by CheckTx. The txs whose gas price is not less than
+// PriorityMinGasPrice, or whose signer has staked at least PriorityMinStake sato CET, are limited by the
+// Priority tier, and the others by the Default tier. The thresholds are disabled if they are not set.
+type AdmissionPolicy struct {
+	Default             AdmissionTier `json:"default"`
+	Priority            AdmissionTier `json:"priority"`
+	PriorityMinStake    int64         `json:"priority_min_stake"`
+	PriorityMinGasPrice sdk.Dec       `json:"priority_min_gas_price"`
+}
+
+// DefaultAdmissionPolicy allows one unconfirmed tx for every account
+func DefaultAdmissionPolicy() AdmissionPolicy {
+	tier := AdmissionTier{MaxUnconfirmed: 1}
+	return AdmissionPolicy{Default: tier, Priority: tier}
+}
+
+func LoadAdmissionPolicy(file string) (AdmissionPolicy, error) {
+	var policy AdmissionPolicy
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return policy, err
+	}
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		return policy, fmt.Errorf("invalid admission policy %s: %s", file, err.Error())
+	}
+	if err := policy.Validate(); err != nil {
+		return policy, fmt.Errorf("invalid admission policy %s: %s", file, err.Error())
+	}
+	return policy, nil
+}
+
+func (policy AdmissionPolicy) Validate() error {
+	for _, tier := range []AdmissionTier{policy.Default, policy.Priority} {
+		if tier.MaxUnconfirmed <= 0 {
+			return errors.New("max_unconfirmed must be positive")
+		}
+		if tier.BucketSize < 0 || (tier.BucketSize > 0 && tier.RefillPerSecond <= 0) {
+			return errors.New("a token bucket must have a positive size and refill rate")
+		}
+	}
+	if policy.PriorityMinStake < 0 {
+		return errors.New("priority_min_stake must not be negative")
+	}
+	if !policy.PriorityMinGasPrice.IsNil() && policy.PriorityMinGasPrice.IsNegative() {
+		return errors.New("priority_min_gas_price must not be negative")
+	}
+	return nil
+}
+
+func (policy AdmissionPolicy) isPriorityGasPrice(stdTx auth.StdTx) bool {
+	if policy.PriorityMinGasPrice.IsNil() || stdTx.Fee.Gas == 0 {
+		return false
+	}
+	gasPrice := sdk.NewDecFromInt(stdTx.Fee.Amount.AmountOf(dex.CET)).QuoInt64(int64(stdTx.Fee.Gas))
+	return gasPrice.GTE(policy.PriorityMinGasPrice)
+}
+
+// isPrioritySigner checks the CET staked by signer in the check state
+func (app *CetChainApp) isPrioritySigner(ctx sdk.Context, signer sdk.AccAddress) bool {
+	minStake := app.account2UnconfirmedTx.policy.PriorityMinStake
+	if minStake <= 0 {
+		return false
+	}
+	staked := sdk.ZeroDec()
+	for _, delegation := range app.stakingKeeper.GetDelegatorDelegations(ctx, signer, maxStakeDelegations) {
+		validator, found := app.stakingKeeper.GetValidator(ctx, delegation.ValidatorAddress)
+		if found {
+			staked = staked.Add(validator.TokensFromShares(delegation.Shares))
+		}
+	}
+	return staked.GTE(sdk.NewDec(minStake))
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/coinexchain/cet-sdk/types"
 
+	"github.com/coinexchain/cet-sdk/modules/alias"
 	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	"github.com/coinexchain/cet-sdk/modules/distributionx"
@@ -20,12 +21,14 @@ var _ authx.AnteHelper = anteHelper{}
 type anteHelper struct {
 	accountXKeeper authx.AccountXKeeper
 	stakingXKeeper stakingx.Keeper
+	aliasKeeper    alias.Keeper
 }
 
-func newAnteHelper(accountXKeeper authx.AccountXKeeper, stakingXKeeper stakingx.Keeper) anteHelper {
+func newAnteHelper(accountXKeeper authx.AccountXKeeper, stakingXKeeper stakingx.Keeper, aliasKeeper alias.Keeper) anteHelper {
 	return anteHelper{
 		accountXKeeper: accountXKeeper,
 		stakingXKeeper: stakingXKeeper,
+		aliasKeeper:    aliasKeeper,
 	}
 }
 
@@ -33,6 +36,11 @@ func (ah anteHelper) CheckMsg(ctx sdk.Context, msg sdk.Msg, memo string) sdk.Err
 	if err := checkAddr(msg); err != nil {
 		return err
 	}
+	if msg, ok := msg.(types.AliasedMsg); ok {
+		if err := ah.aliasKeeper.CheckAliasRefs(ctx, msg.GetAliasRefs()); err != nil {
+			return err
+		}
+	}
 
 	switch msg := msg.(type) {
 	case bankx.MsgSend:
@@ -53,7 +61,13 @@ func (ah anteHelper) CheckMsg(ctx sdk.Context, msg sdk.Msg, memo string) sdk.Err
 		return ah.checkMsgCreateValidator(ctx, msg)
 
 	case staking.MsgEditValidator:
-		return ah.checkMsgEditValidator(ctx, msg.CommissionRate)
+		return ah.checkMsgEditValidator(ctx, msg.ValidatorAddress, msg.CommissionRate)
+
+	case staking.MsgDelegate:
+		return ah.stakingXKeeper.CheckValidatorBondedShare(ctx, msg.ValidatorAddress, nil, msg.Amount.Amount)
+
+	case staking.MsgBeginRedelegate:
+		return ah.stakingXKeeper.CheckValidatorBondedShare(ctx, msg.ValidatorDstAddress, msg.ValidatorSrcAddress, msg.Amount.Amount)
 
 	case distribution.MsgSetWithdrawAddress:
 		if ah.memoRequired(ctx, msg.WithdrawAddress) {
@@ -69,18 +83,25 @@ func (ah anteHelper) CheckMsg(ctx sdk.Context, msg sdk.Msg, memo string) sdk.Err
 	return nil
 }
 
-func (ah anteHelper) checkMsgEditValidator(ctx sdk.Context, newRate *sdk.Dec) sdk.Error {
+func (ah anteHelper) checkMsgEditValidator(ctx sdk.Context, valAddr sdk.ValAddress, newRate *sdk.Dec) sdk.Error {
 	if newRate == nil {
 		return nil
 	}
 
-	return ah.checkMinMandatoryCommissionRate(ctx, *newRate)
+	if err := ah.checkMinMandatoryCommissionRate(ctx, *newRate); err != nil {
+		return err
+	}
+
+	return ah.stakingXKeeper.CheckCommissionChange(ctx, valAddr, *newRate)
 }
 
 func (ah anteHelper) checkMsgCreateValidator(ctx sdk.Context, msg staking.MsgCreateValidator) sdk.Error {
 	if err := ah.checkMinSelfDelegation(ctx, msg.MinSelfDelegation); err != nil {
 		return err
 	}
+	if err := ah.stakingXKeeper.CheckNewValidatorBondedShare(ctx, msg.Value.Amount); err != nil {
+		return err
+	}
 
 	return ah.checkMinMandatoryCommissionRate(ctx, msg.Commission.Rate)
 }
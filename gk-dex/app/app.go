@@ -43,9 +43,13 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	"github.com/coinexchain/cet-sdk/modules/comment"
 	"github.com/coinexchain/cet-sdk/modules/distributionx"
+	distrxclient "github.com/coinexchain/cet-sdk/modules/distributionx/client"
 	"github.com/coinexchain/cet-sdk/modules/incentive"
+	incentiveclient "github.com/coinexchain/cet-sdk/modules/incentive/client"
 	"github.com/coinexchain/cet-sdk/modules/market"
+	marketclient "github.com/coinexchain/cet-sdk/modules/market/client"
 	"github.com/coinexchain/cet-sdk/modules/stakingx"
+	stakingxclient "github.com/coinexchain/cet-sdk/modules/stakingx/client"
 	"github.com/coinexchain/cet-sdk/modules/supplyx"
 	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
@@ -74,13 +78,15 @@ var (
 
 	// account permissions
 	MaccPerms = map[string][]string{
-		auth.FeeCollectorName:     nil,
-		distr.ModuleName:          nil,
-		staking.BondedPoolName:    {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
-		gov.ModuleName:            {supply.Burner},
-		authx.ModuleName:          nil,
-		asset.ModuleName:          {supply.Burner, supply.Minter},
+		auth.FeeCollectorName:        nil,
+		distr.ModuleName:             nil,
+		staking.BondedPoolName:       {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:    {supply.Burner, supply.Staking},
+		gov.ModuleName:               {supply.Burner},
+		authx.ModuleName:             nil,
+		asset.ModuleName:             {supply.Burner, supply.Minter},
+		market.MiningPoolName:        nil,
+		distributionx.StreamPoolName: nil,
 	}
 )
 
@@ -103,7 +109,10 @@ func init() {
 		//modules of cosmos
 		AuthModuleBasic{},
 		CrisisModuleBasic{},
-		GovModuleBasic{gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler)},
+		GovModuleBasic{gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler, incentiveclient.ProposalHandler,
+			distrxclient.StreamProposalHandler, distrxclient.CancelStreamProposalHandler,
+			stakingxclient.ProposalHandler, marketclient.ListMarketProposalHandler, marketclient.DelistMarketProposalHandler,
+			marketclient.MarketFeeRateProposalHandler, marketclient.MarketHaltProposalHandler)},
 		SlashingModuleBasic{},
 		StakingModuleBasic{},
 		bank.AppModuleBasic{},
@@ -134,6 +143,8 @@ type CetChainApp struct {
 	txDecoder sdk.TxDecoder // unmarshal []byte into sdk.Tx
 	txCount   int64
 	height    int64
+	// whether the fee of the delivering tx is charged
+	txFeeCharged bool
 
 	invCheckPeriod uint
 
@@ -147,6 +158,7 @@ type CetChainApp struct {
 	tkeyStaking  *sdk.TransientStoreKey
 	keySlashing  *sdk.KVStoreKey
 	keyDistr     *sdk.KVStoreKey
+	keyDistrx    *sdk.KVStoreKey
 	keyGov       *sdk.KVStoreKey
 	keyParams    *sdk.KVStoreKey
 	tkeyParams   *sdk.TransientStoreKey
@@ -215,11 +227,11 @@ func NewCetChainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 	app.WaitPluginToggleSignal(logger)
 
 	ah := authx.NewAnteHandler(app.accountKeeper, app.supplyKeeper, app.accountXKeeper,
-		newAnteHelper(app.accountXKeeper, app.stakingXKeeper))
+		newAnteHelper(app.accountXKeeper, app.stakingXKeeper, app.aliasKeeper))
 
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.beginBlocker)
-	app.SetAnteHandler(ah)
+	app.SetAnteHandler(app.trackAnteHandler(ah))
 	app.SetEndBlocker(app.endBlocker)
 
 	if loadLatest {
@@ -240,12 +252,23 @@ func NewCetChainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 	} else {
 		limitTime = DefaultLimitTime
 	}
+	policyFile, hasPolicy := os.LookupEnv("COINEX_ADMISSION_POLICY")
+	if hasPolicy && limitTime <= 0 {
+		limitTime = DefaultLimitTime
+	}
 	if limitTime > 0 {
 		app.enableUnconfirmedLimit = true
 		app.account2UnconfirmedTx = NewAccount2UnconfirmedTx(limitTime)
 	} else {
 		app.enableUnconfirmedLimit = false
 	}
+	if hasPolicy {
+		policy, err := LoadAdmissionPolicy(policyFile)
+		if err != nil {
+			cmn.Exit(err.Error())
+		}
+		app.account2UnconfirmedTx.SetAdmissionPolicy(policy)
+	}
 	return app
 }
 
@@ -263,6 +286,7 @@ func newCetChainApp(bApp *bam.BaseApp, cdc *codec.Codec, invCheckPeriod uint, tx
 		keyStakingX:    sdk.NewKVStoreKey(stakingx.StoreKey),
 		tkeyStaking:    sdk.NewTransientStoreKey(staking.TStoreKey),
 		keyDistr:       sdk.NewKVStoreKey(distr.StoreKey),
+		keyDistrx:      sdk.NewKVStoreKey(distributionx.StoreKey),
 		keySlashing:    sdk.NewKVStoreKey(slashing.StoreKey),
 		keyGov:         sdk.NewKVStoreKey(gov.StoreKey),
 		keyParams:      sdk.NewKVStoreKey(params.StoreKey),
@@ -319,7 +343,7 @@ func (app *CetChainApp) initKeepers(invCheckPeriod uint) {
 		app.supplyKeeper,
 		distr.DefaultCodespace,
 		auth.FeeCollectorName,
-		app.ModuleAccountAddrs(),
+		app.communityPoolBlacklistedAddrs(),
 	)
 	supplyxKeeper := supplyx.NewKeeper(app.supplyKeeper, app.distrKeeper)
 
@@ -332,21 +356,13 @@ func (app *CetChainApp) initKeepers(invCheckPeriod uint) {
 		staking.DefaultCodespace,
 	)
 
-	// register the proposal types
-	govRouter := gov.NewRouter()
-	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper))
-
-	app.govKeeper = gov.NewKeeper(
-		app.cdc,
-		app.keyGov,
-		app.paramsKeeper, app.paramsKeeper.Subspace(gov.DefaultParamspace),
-		//app.supplyKeeper,
-		supplyxKeeper,
-		&stakingKeeper,
-		gov.DefaultCodespace,
-		govRouter,
+	app.incentiveKeeper = incentive.NewKeeper(
+		app.cdc, app.keyIncentive,
+		app.paramsKeeper.Subspace(incentive.DefaultParamspace),
+		app.bankKeeper,
+		app.distrKeeper,
+		app.supplyKeeper,
+		auth.FeeCollectorName,
 	)
 
 	app.crisisKeeper = crisis.NewKeeper(
@@ -379,13 +395,6 @@ func (app *CetChainApp) initKeepers(invCheckPeriod uint) {
 		app.paramsKeeper.Subspace(slashing.DefaultParamspace),
 		slashing.DefaultCodespace,
 	)
-	app.incentiveKeeper = incentive.NewKeeper(
-		app.cdc, app.keyIncentive,
-		app.paramsKeeper.Subspace(incentive.DefaultParamspace),
-		app.bankKeeper,
-		app.supplyKeeper,
-		auth.FeeCollectorName,
-	)
 	app.tokenKeeper = asset.NewBaseTokenKeeper(
 		app.cdc, app.keyAsset,
 	)
@@ -397,9 +406,13 @@ func (app *CetChainApp) initKeepers(invCheckPeriod uint) {
 		app.msgQueProducer,
 	)
 	app.distrxKeeper = distributionx.NewKeeper(
+		app.cdc,
+		app.keyDistrx,
 		app.bankxKeeper,
 		app.distrKeeper,
+		app.supplyKeeper,
 	)
+
 	app.assetKeeper = asset.NewBaseKeeper(
 		app.cdc,
 		app.keyAsset,
@@ -454,13 +467,51 @@ func (app *CetChainApp) initKeepers(invCheckPeriod uint) {
 		app.assetKeeper,
 		app.accountKeeper,
 		app.distrxKeeper,
+		app.paramsKeeper.Subspace(comment.DefaultParamspace),
 		eventTypeMsgQueue,
 	)
+	eventTypeMsgQueue = ""
+	if app.msgQueProducer.IsSubscribed(alias.ModuleName) {
+		eventTypeMsgQueue = msgqueue.EventTypeMsgQueue
+	}
 	app.aliasKeeper = alias.NewBaseKeeper(
 		app.keyAlias,
 		app.bankxKeeper,
 		app.assetKeeper,
 		app.paramsKeeper.Subspace(alias.StoreKey),
+		eventTypeMsgQueue,
+	)
+
+	// register the proposal types
+	paramValidators := map[string]dex.ParamValidator{
+		asset.DefaultParamspace:      asset.ValidateParams,
+		bankx.DefaultParamspace:      bankx.ValidateParams,
+		market.DefaultParamspace:     market.ValidateParams,
+		bancorlite.DefaultParamspace: bancorlite.ValidateParams,
+		alias.DefaultParamspace:      alias.ValidateParams,
+		authx.DefaultParamspace:      authx.ValidateParams,
+		incentive.DefaultParamspace:  app.incentiveKeeper.ValidateParams,
+		stakingx.DefaultParamspace:   stakingx.ValidateParams,
+		comment.DefaultParamspace:    comment.ValidateParams,
+	}
+	govRouter := gov.NewRouter()
+	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, dex.NewParamChangeProposalHandler(app.paramsKeeper, paramValidators)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(incentive.RouterKey, incentive.NewProposalHandler(app.incentiveKeeper)).
+		AddRoute(distributionx.RouterKey, distributionx.NewProposalHandler(app.distrxKeeper)).
+		AddRoute(stakingx.RouterKey, stakingx.NewProposalHandler(app.stakingXKeeper)).
+		AddRoute(market.RouterKey, market.NewProposalHandler(app.marketKeeper))
+
+	app.govKeeper = gov.NewKeeper(
+		app.cdc,
+		app.keyGov,
+		app.paramsKeeper, app.paramsKeeper.Subspace(gov.DefaultParamspace),
+		//app.supplyKeeper,
+		supplyxKeeper,
+		&stakingKeeper,
+		gov.DefaultCodespace,
+		govRouter,
 	)
 }
 
@@ -473,7 +524,7 @@ func (app *CetChainApp) initModules() {
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(market.ModuleName, incentive.ModuleName, distr.ModuleName, slashing.ModuleName)
 
-	app.mm.SetOrderEndBlockers(gov.ModuleName, staking.ModuleName, authx.ModuleName, market.ModuleName, crisis.ModuleName)
+	app.mm.SetOrderEndBlockers(gov.ModuleName, staking.ModuleName, authx.ModuleName, market.ModuleName, alias.ModuleName, crisis.ModuleName)
 
 	initGenesisOrder := getAppModuleInitOrder()
 
@@ -484,6 +535,8 @@ func (app *CetChainApp) initModules() {
 	app.mm.SetOrderExportGenesis(initGenesisOrder...)
 
 	app.crisisKeeper.RegisterRoute(authx.ModuleName, "pre-total-supply", authx.PreTotalSupplyInvariant(app.accountXKeeper))
+	app.crisisKeeper.RegisterRoute(authx.ModuleName, "frozen-coins", authx.FrozenCoinsInvariant(app.accountXKeeper,
+		market.FrozenCoinsInOrders(app.marketKeeper), bancorlite.FrozenCoinsInPools(app.bancorKeeper)))
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 
 	app.registerRoutesWithOrder(modules)
@@ -526,6 +579,7 @@ func getAppModuleInitOrder() []string {
 		supply.ModuleName,
 		authx.ModuleName,
 		bankx.ModuleName,
+		distributionx.ModuleName,
 		incentive.ModuleName,
 		asset.ModuleName,
 		stakingx.ModuleName,
@@ -554,12 +608,19 @@ func (app *CetChainApp) registerRoutesWithOrder(modules []module.AppModule) {
 
 // initialize BaseApp
 func (app *CetChainApp) mountStores() {
-	app.MountStores(app.keyMain, app.keyAccount, app.keySupply, app.keyStaking, app.keyDistr,
+	for _, key := range app.kvStoreKeys() {
+		app.MountStore(key, sdk.StoreTypeIAVL)
+	}
+	app.MountStores(app.tkeyParams, app.tkeyStaking)
+}
+
+// the keys of all the persistent stores
+func (app *CetChainApp) kvStoreKeys() []*sdk.KVStoreKey {
+	return []*sdk.KVStoreKey{app.keyMain, app.keyAccount, app.keySupply, app.keyStaking, app.keyDistr,
 		app.keySlashing, app.keyGov, app.keyParams,
-		app.tkeyParams, app.tkeyStaking,
 		app.keyAccountX, app.keyAsset, app.keyMarket, app.keyIncentive,
-		app.keyBancor, app.keyAlias, app.keyComment, app.keyStakingX,
-	)
+		app.keyBancor, app.keyAlias, app.keyComment, app.keyStakingX, app.keyDistrx,
+	}
 }
 
 // application updates every begin block
@@ -579,6 +640,7 @@ func (app *CetChainApp) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlock
 		app.currBlockTime = req.Header.Time.Unix()
 		app.account2UnconfirmedTx.ClearRemoveList()
 	}
+	app.PostBeginBlock(req, ret)
 	return ret
 }
 
@@ -590,6 +652,7 @@ func (app *CetChainApp) endBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 		ret.Events = collectKafkaEvents(ret.Events, app)
 		app.notifyEndBlock(ret.Events)
 	}
+	app.PostEndBlock(req, ret)
 	return ret
 }
 
@@ -619,6 +682,14 @@ func (app *CetChainApp) ModuleAccountAddrs() map[string]bool {
 	return modAccAddrs
 }
 
+// communityPoolBlacklistedAddrs returns the module account addresses which community pool spend
+// proposals can not pay to. The market mining pool is funded by such proposals.
+func (app *CetChainApp) communityPoolBlacklistedAddrs() map[string]bool {
+	addrs := app.ModuleAccountAddrs()
+	delete(addrs, supply.NewModuleAddress(market.MiningPoolName).String())
+	return addrs
+}
+
 func (app *CetChainApp) initPubMsgBuf() {
 	app.pubMsgs = make([]PubMsg, 0, 10000)
 }
@@ -640,10 +711,8 @@ func (app *CetChainApp) appendPubMsgKV(key string, val []byte) {
 /* "override" ABCI methods */
 
 func (app *CetChainApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
-	if p := app.GetPlugin(); p != nil {
-		if err := p.PreCheckTx(req, app.txDecoder, app.Logger()); err != nil {
-			return dex.ResponseFrom(err)
-		}
+	if err := app.Holder.PreCheckTx(req, app.txDecoder); err != nil {
+		return dex.ResponseFrom(err)
 	}
 
 	if !app.enableUnconfirmedLimit {
@@ -669,24 +738,22 @@ func (app *CetChainApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		}
 	}
 
-	otherTxExist := false
 	hashid := tmtypes.Tx(req.Tx).Hash()
 	signers := stdTx.GetSigners()
-	for _, signer := range signers {
-		res := app.account2UnconfirmedTx.Lookup(signer, hashid, app.currBlockTime)
-		if res == OtherTxExist {
-			otherTxExist = true
-			break
+	policy := app.account2UnconfirmedTx.policy
+	priorityTx := policy.isPriorityGasPrice(stdTx)
+	keys := unconfirmedTxKeys(stdTx, signers)
+	ctx := app.NewContext(true, abci.Header{})
+	for i, signer := range signers {
+		priority := priorityTx || app.isPrioritySigner(ctx, signer)
+		if err := app.account2UnconfirmedTx.Admit(signer, keys[i], hashid, app.currBlockTime, priority); err != nil {
+			return dex.ResponseFrom(err)
 		}
 	}
-
-	if otherTxExist {
-		return dex.ResponseFrom(errTooManyUnconfirmedTx)
-	}
 	ret := app.BaseApp.CheckTx(req)
 	if ret.IsOK() {
-		for _, signer := range signers {
-			app.account2UnconfirmedTx.Add(signer, hashid, app.currBlockTime)
+		for i, key := range keys {
+			app.account2UnconfirmedTx.Add(signers[i], key, hashid, app.currBlockTime)
 		}
 	}
 	return ret
@@ -704,6 +771,7 @@ func (app *CetChainApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDelive
 		formatOK = false
 	}
 
+	app.txFeeCharged = false
 	ret := app.BaseApp.DeliverTx(req)
 
 	if app.msgQueProducer.IsOpenToggle() {
@@ -718,9 +786,10 @@ func (app *CetChainApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDelive
 	}
 
 	if formatOK && app.enableUnconfirmedLimit {
-		signers := stdTx.GetSigners()
-		app.account2UnconfirmedTx.AddToRemoveList(signers)
+		keys := unconfirmedTxKeys(stdTx, stdTx.GetSigners())
+		app.account2UnconfirmedTx.AddToRemoveList(keys, tmtypes.Tx(req.Tx).Hash())
 	}
+	app.PostDeliverTx(req, ret)
 	return ret
 }
 
@@ -734,5 +803,7 @@ func (app *CetChainApp) Commit() abci.ResponseCommit {
 	if app.enableUnconfirmedLimit {
 		app.account2UnconfirmedTx.CommitRemove(app.currBlockTime)
 	}
-	return app.BaseApp.Commit()
+	ret := app.BaseApp.Commit()
+	app.PostCommit(ret)
+	return ret
 }
@@ -16,9 +16,21 @@ import (
 	sltypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
+func init() {
+	msgqueue.RegisterSchema("notify_tx", 2, NotificationTx{})
+	msgqueue.RegisterSchema("begin_unbonding", 1, NotificationBeginUnbonding{})
+	msgqueue.RegisterSchema("begin_redelegation", 1, NotificationBeginRedelegation{})
+	msgqueue.RegisterSchema("complete_unbonding", 1, NotificationCompleteUnbonding{})
+	msgqueue.RegisterSchema("complete_redelegation", 1, NotificationCompleteRedelegation{})
+	msgqueue.RegisterSchema("slash", 1, NotificationSlash{})
+	msgqueue.RegisterSchema("validator_commission", 1, NotificationValidatorCommission{})
+	msgqueue.RegisterSchema("delegator_rewards", 1, NotificationDelegatorRewards{})
+}
+
 type TxExtraInfo struct {
 	Code      uint32       `json:"code,omitempty"`
 	Data      []byte       `json:"data,omitempty"`
@@ -62,7 +74,13 @@ type NotificationTx struct {
 	TxJSON       string           `json:"tx_json"`
 	Height       int64            `json:"height"`
 	Hash         []byte           `json:"hash"`
-	ExtraInfo    string           `json:"extra_info,omitempty"`
+	// ExtraInfo is the TxExtraInfo of a failed tx, use Failure instead
+	ExtraInfo string      `json:"extra_info,omitempty"`
+	Fee       string      `json:"fee"`
+	Gas       uint64      `json:"gas"`
+	GasUsed   int64       `json:"gas_used"`
+	Effects   []MsgEffect `json:"effects"`
+	Failure   *TxFailure  `json:"failure,omitempty"`
 }
 
 func getTransferRecord(dualEvent []abci.Event) TransferRecord {
@@ -138,6 +156,11 @@ func (app *CetChainApp) notifyTx(req abci.RequestDeliverTx, stdTx auth.StdTx, re
 		MsgTypes:     msgTypes,
 		Height:       app.height,
 		Hash:         tmtypes.Tx(req.Tx).Hash(),
+		Fee:          stdTx.Fee.Amount.String(),
+		Gas:          stdTx.Fee.Gas,
+		GasUsed:      ret.GasUsed,
+		Effects:      getMsgEffects(stdTx, ret, app.txFeeCharged),
+		Failure:      getTxFailure(ret),
 	}
 
 	if ret.Code != uint32(sdk.CodeOK) {
@@ -0,0 +1,201 @@
+package app
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	"github.com/coinexchain/cet-sdk/modules/market"
+)
+
+// The kinds of MsgEffect
+const (
+	EffectFeeCharged   = "fee_charged"
+	EffectOrderCreated = "order_created"
+	EffectCoinsFrozen  = "coins_frozen"
+	EffectCoinsLocked  = "coins_locked"
+	EffectTokensMinted = "tokens_minted"
+	EffectTokensBurned = "tokens_burned"
+)
+
+// MsgEffect is a change made by a message of a tx. The effect whose MsgIndex is -1 is made by
+// the tx itself, i.e. the fee paid for the gas, which is charged even if a message fails.
+type MsgEffect struct {
+	MsgIndex    int    `json:"msg_index"`
+	Kind        string `json:"kind"`
+	Address     string `json:"address"`
+	Amount      string `json:"amount,omitempty"`
+	OrderID     string `json:"order_id,omitempty"`
+	TradingPair string `json:"trading_pair,omitempty"`
+}
+
+// TxFailure tells why a tx failed. MsgIndex is -1 if the tx failed before running its messages.
+type TxFailure struct {
+	MsgIndex  int    `json:"msg_index"`
+	Codespace string `json:"codespace"`
+	Code      uint32 `json:"code"`
+	Message   string `json:"message"`
+}
+
+var feeCollectorAddr = supply.NewModuleAddress(auth.FeeCollectorName).String()
+
+// trackAnteHandler records whether the ante handler of the delivered tx has passed,
+// which means its fee is charged even if its messages fail
+func (app *CetChainApp) trackAnteHandler(ah sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		newCtx, res, abort := ah(ctx, tx, simulate)
+		if !ctx.IsCheckTx() && !simulate {
+			app.txFeeCharged = !abort
+		}
+		return newCtx, res, abort
+	}
+}
+
+func getTxFailure(ret abci.ResponseDeliverTx) *TxFailure {
+	if ret.Code == uint32(sdk.CodeOK) {
+		return nil
+	}
+	failure := &TxFailure{
+		MsgIndex:  -1,
+		Codespace: ret.Codespace,
+		Code:      ret.Code,
+		Message:   ret.Log,
+	}
+	errLog := ret.Log
+	// the logs of the messages are returned if a message failed
+	if logs, err := sdk.ParseABCILogs(ret.Log); err == nil {
+		for _, log := range logs {
+			if !log.Success {
+				failure.MsgIndex = int(log.MsgIndex)
+				failure.Message = log.Log
+				errLog = log.Log
+			}
+		}
+	}
+	var sdkErr struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal([]byte(errLog), &sdkErr); err == nil && len(sdkErr.Message) != 0 {
+		failure.Message = sdkErr.Message
+	}
+	return failure
+}
+
+// splitMsgEvents splits the events of a tx by its messages, the events of every message
+// are ended by the message event with the action attribute
+func splitMsgEvents(events []abci.Event) [][]abci.Event {
+	res := make([][]abci.Event, 0, 1)
+	start := 0
+	for i, event := range events {
+		if event.Type != sdk.EventTypeMessage {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == sdk.AttributeKeyAction {
+				res = append(res, events[start:i+1])
+				start = i + 1
+				break
+			}
+		}
+	}
+	return res
+}
+
+func getMsgEffects(stdTx auth.StdTx, ret abci.ResponseDeliverTx, feeCharged bool) []MsgEffect {
+	effects := make([]MsgEffect, 0, 4)
+	// the fee is paid by the first signer
+	if signers := stdTx.GetSigners(); feeCharged && !stdTx.Fee.Amount.IsZero() && len(signers) != 0 {
+		effects = append(effects, MsgEffect{
+			MsgIndex: -1,
+			Kind:     EffectFeeCharged,
+			Address:  signers[0].String(),
+			Amount:   stdTx.Fee.Amount.String(),
+		})
+	}
+	// the changes of the failed messages are reverted
+	if ret.Code != uint32(sdk.CodeOK) {
+		return effects
+	}
+	for i, events := range splitMsgEvents(ret.Events) {
+		signer := ""
+		if i < len(stdTx.Msgs) && len(stdTx.Msgs[i].GetSigners()) != 0 {
+			signer = stdTx.Msgs[i].GetSigners()[0].String()
+		}
+		effects = append(effects, getEffectsFromEvents(i, signer, events)...)
+	}
+	return effects
+}
+
+// the effects are taken from the events which are emitted whatever msgqueue topics are subscribed
+func getEffectsFromEvents(msgIndex int, signer string, events []abci.Event) []MsgEffect {
+	effects := make([]MsgEffect, 0, 2)
+	for i, event := range events {
+		switch event.Type {
+		case bankx.EventTypeTransfer:
+			if effect, ok := getLockedCoinsEffect(msgIndex, event); ok {
+				effects = append(effects, effect)
+			} else if i+1 < len(events) && events[i+1].Type == sdk.EventTypeMessage {
+				transfer := getTransferRecord(events[i : i+2])
+				if transfer.Recipient == feeCollectorAddr {
+					effects = append(effects, MsgEffect{MsgIndex: msgIndex, Kind: EffectFeeCharged,
+						Address: transfer.Sender, Amount: transfer.Amount})
+				}
+			}
+		case asset.EventTypeMintToken, asset.EventTypeBurnToken:
+			kind := EffectTokensMinted
+			if event.Type == asset.EventTypeBurnToken {
+				kind = EffectTokensBurned
+			}
+			var symbol, amount string
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == asset.AttributeKeySymbol {
+					symbol = string(attr.Value)
+				} else if string(attr.Key) == asset.AttributeKeyAmount {
+					amount = string(attr.Value)
+				}
+			}
+			effects = append(effects, MsgEffect{MsgIndex: msgIndex, Kind: kind, Address: signer, Amount: amount + symbol})
+		case market.EventTypeKeyCreateOrder:
+			var orderID, tradingPair, frozen string
+			for _, attr := range event.Attributes {
+				switch string(attr.Key) {
+				case market.AttributeKeyOrder:
+					orderID = string(attr.Value)
+				case market.AttributeKeyTradingPair:
+					tradingPair = string(attr.Value)
+				case market.AttributeKeyFrozen:
+					frozen = string(attr.Value)
+				}
+			}
+			effects = append(effects,
+				MsgEffect{MsgIndex: msgIndex, Kind: EffectOrderCreated, Address: signer,
+					OrderID: orderID, TradingPair: tradingPair},
+				MsgEffect{MsgIndex: msgIndex, Kind: EffectCoinsFrozen, Address: signer,
+					Amount: frozen, OrderID: orderID})
+		}
+	}
+	return effects
+}
+
+// getLockedCoinsEffect returns the effect of a transfer of locked coins, which has the unlock time
+func getLockedCoinsEffect(msgIndex int, event abci.Event) (MsgEffect, bool) {
+	effect := MsgEffect{MsgIndex: msgIndex, Kind: EffectCoinsLocked}
+	locked := false
+	for _, attr := range event.Attributes {
+		switch string(attr.Key) {
+		case bankx.AttributeKeyRecipient:
+			effect.Address = string(attr.Value)
+		case bankx.AttributeKeyAmount:
+			effect.Amount = string(attr.Value)
+		case bankx.AttributeKeyUnlockTime:
+			locked = true
+		}
+	}
+	return effect, locked
+}
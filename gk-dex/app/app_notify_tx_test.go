@@ -0,0 +1,84 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func kv(key, value string) common.KVPair {
+	return common.KVPair{Key: []byte(key), Value: []byte(value)}
+}
+
+func TestNotifyTxEffects(t *testing.T) {
+	_, _, fromAddr := testutil.KeyPubAddr()
+	toAddr := sdk.AccAddress([]byte("addr"))
+	msg := bankx.MsgSend{FromAddress: fromAddr, ToAddress: toAddr, Amount: dex.NewCetCoins(1000000000)}
+	stdTx := auth.NewStdTx([]sdk.Msg{msg}, auth.NewStdFee(1000000, dex.NewCetCoins(100)), nil, "")
+
+	// the activation fee of toAddr is charged by the message
+	ret := abci.ResponseDeliverTx{Events: []abci.Event{
+		{Type: "transfer", Attributes: []common.KVPair{kv("recipient", feeCollectorAddr), kv("amount", dex.NewCetCoins(100000000).String())}},
+		{Type: sdk.EventTypeMessage, Attributes: []common.KVPair{kv(sdk.AttributeKeySender, fromAddr.String())}},
+		{Type: "transfer", Attributes: []common.KVPair{kv("recipient", toAddr.String()), kv("amount", dex.NewCetCoins(900000000).String())}},
+		{Type: sdk.EventTypeMessage, Attributes: []common.KVPair{kv(sdk.AttributeKeySender, fromAddr.String())}},
+		{Type: sdk.EventTypeMessage, Attributes: []common.KVPair{kv(sdk.AttributeKeyAction, "send")}},
+	}}
+	require.Nil(t, getTxFailure(ret))
+	require.Equal(t, []MsgEffect{
+		{MsgIndex: -1, Kind: EffectFeeCharged, Address: fromAddr.String(), Amount: dex.NewCetCoins(100).String()},
+		{MsgIndex: 0, Kind: EffectFeeCharged, Address: fromAddr.String(), Amount: dex.NewCetCoins(100000000).String()},
+	}, getMsgEffects(stdTx, ret, true))
+
+	// the fee is charged even if the message fails, whose changes are reverted
+	ret.Code, ret.Codespace = uint32(sdk.CodeInsufficientCoins), "sdk"
+	ret.Log = `[{"msg_index":0,"success":false,"log":"{\"codespace\":\"sdk\",\"code\":10,\"message\":\"insufficient account funds\"}"}]`
+	require.Equal(t, []MsgEffect{
+		{MsgIndex: -1, Kind: EffectFeeCharged, Address: fromAddr.String(), Amount: dex.NewCetCoins(100).String()},
+	}, getMsgEffects(stdTx, ret, true))
+	require.Equal(t, TxFailure{MsgIndex: 0, Codespace: "sdk", Code: 10, Message: "insufficient account funds"}, *getTxFailure(ret))
+
+	// the fee is not charged if the tx fails in the ante handler
+	ret = abci.ResponseDeliverTx{Code: uint32(sdk.CodeUnauthorized), Codespace: "sdk",
+		Log: `{"codespace":"sdk","code":4,"message":"signature verification failed"}`}
+	require.Empty(t, getMsgEffects(stdTx, ret, false))
+	require.Equal(t, TxFailure{MsgIndex: -1, Codespace: "sdk", Code: 4, Message: "signature verification failed"}, *getTxFailure(ret))
+}
+
+func TestMsgEffectsFromEvents(t *testing.T) {
+	frozen := sdk.NewCoins(sdk.NewInt64Coin("abc", 100)).Add(dex.NewCetCoins(11)).String()
+	locked := dex.NewCetCoins(300).String()
+	events := []abci.Event{
+		{Type: "create_order", Attributes: []common.KVPair{kv("order", "coinex1-1"), kv("trading_pair", "abc/"+dex.CET),
+			kv("height", "10"), kv("frozen", frozen)}},
+		{Type: sdk.EventTypeMessage, Attributes: []common.KVPair{kv(sdk.AttributeKeyAction, "create_order")}},
+		{Type: "mint_token", Attributes: []common.KVPair{kv("symbol", "abc"), kv("amount", "500")}},
+		{Type: sdk.EventTypeMessage, Attributes: []common.KVPair{kv(sdk.AttributeKeyAction, "mint_token")}},
+		{Type: "transfer", Attributes: []common.KVPair{kv("sender", "coinex2"), kv("recipient", "coinex3"),
+			kv("amount", locked), kv("unlock_time", "1000")}},
+		{Type: sdk.EventTypeMessage, Attributes: []common.KVPair{kv(sdk.AttributeKeySender, "coinex2")}},
+		{Type: sdk.EventTypeMessage, Attributes: []common.KVPair{kv(sdk.AttributeKeyAction, "send")}},
+	}
+	msgEvents := splitMsgEvents(events)
+	require.Len(t, msgEvents, 3)
+
+	require.Equal(t, []MsgEffect{
+		{MsgIndex: 0, Kind: EffectOrderCreated, Address: "coinex1", OrderID: "coinex1-1", TradingPair: "abc/" + dex.CET},
+		{MsgIndex: 0, Kind: EffectCoinsFrozen, Address: "coinex1", Amount: frozen, OrderID: "coinex1-1"},
+	}, getEffectsFromEvents(0, "coinex1", msgEvents[0]))
+	require.Equal(t, []MsgEffect{
+		{MsgIndex: 1, Kind: EffectTokensMinted, Address: "coinex2", Amount: "500abc"},
+	}, getEffectsFromEvents(1, "coinex2", msgEvents[1]))
+	require.Equal(t, []MsgEffect{
+		{MsgIndex: 2, Kind: EffectCoinsLocked, Address: "coinex3", Amount: locked},
+	}, getEffectsFromEvents(2, "coinex2", msgEvents[2]))
+}
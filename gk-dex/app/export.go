@@ -26,6 +26,11 @@ func (app *CetChainApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhit
 
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	return app.exportAppStateAndValidators(ctx, forZeroHeight, jailWhiteList)
+}
+
+func (app *CetChainApp) exportAppStateAndValidators(ctx sdk.Context, forZeroHeight bool, jailWhiteList []string) (
+	appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
 
 	if forZeroHeight {
 		app.prepForZeroHeightGenesis(ctx, jailWhiteList)
@@ -37,6 +42,10 @@ func (app *CetChainApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhit
 		incentive.ModuleCdc.MustUnmarshalJSON(genState[incentive.ModuleName], &ig)
 		ig.State.HeightAdjustment = ig.State.HeightAdjustment + ctx.BlockHeader().Height
 		genState[incentive.ModuleName] = incentive.ModuleCdc.MustMarshalJSON(ig)
+		// keep the store in step with the exported state, which export --verify compares with
+		if sdkErr := app.incentiveKeeper.SetState(ctx, ig.State); sdkErr != nil {
+			return nil, nil, sdkErr
+		}
 	}
 
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
@@ -174,4 +183,9 @@ func (app *CetChainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList
 			return false
 		},
 	)
+
+	/* Handle the heights recorded by the dex modules. */
+
+	app.marketKeeper.RebaseHeights(ctx, height)
+	app.distrxKeeper.RebaseHeights(ctx, height)
 }
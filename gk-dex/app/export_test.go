@@ -8,16 +8,22 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/genaccounts"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
+	"github.com/coinexchain/cet-sdk/modules/alias"
 	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/distributionx"
+	"github.com/coinexchain/cet-sdk/modules/market"
 	"github.com/coinexchain/cet-sdk/testutil"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
@@ -253,3 +259,108 @@ func initAppWithValidators(gs GenesisState) *CetChainApp {
 
 	return app
 }
+
+func TestDiffStores(t *testing.T) {
+	exported := dbadapter.Store{DB: dbm.NewMemDB()}
+	imported := dbadapter.Store{DB: dbm.NewMemDB()}
+	require.Empty(t, diffStores("market", exported, imported))
+
+	exported.Set([]byte{1}, []byte("a"))
+	exported.Set([]byte{2}, []byte("b"))
+	exported.Set([]byte{4}, []byte("d"))
+	imported.Set([]byte{2}, []byte("b"))
+	imported.Set([]byte{3}, []byte("c"))
+	imported.Set([]byte{4}, []byte("e"))
+	imported.Set([]byte{5}, []byte("f"))
+
+	diffs := diffStores("market", exported, imported)
+	require.Equal(t, []StoreDiff{
+		{Store: "market", Key: []byte{1}, Exported: []byte("a")},
+		{Store: "market", Key: []byte{3}, Imported: []byte("c")},
+		{Store: "market", Key: []byte{4}, Exported: []byte("d"), Imported: []byte("e")},
+		{Store: "market", Key: []byte{5}, Imported: []byte("f")},
+	}, diffs)
+
+	diffs = append(diffs, StoreDiff{Store: "alias", Key: []byte{6}, Exported: []byte("g")})
+	require.Equal(t, "store market: 4 key(s) didn't round-trip\n"+
+		"  market: key 01 is lost, value 61\n"+
+		"  market: key 03 is added, value 63\n"+
+		"  market: key 04 is changed, value 64 -> 65\n"+
+		"  market: key 05 is added, value 66\n"+
+		"store alias: 1 key(s) didn't round-trip\n"+
+		"  alias: key 06 is lost, value 67\n", FormatStoreDiffs(diffs))
+}
+
+func TestExportAndVerifyAppState(t *testing.T) {
+	_, acc := testutil.NewBaseAccount(1e8, 0, 0)
+	addr := acc.Address
+	streamPool := supply.NewEmptyModuleAccount(distributionx.StreamPoolName)
+	_ = streamPool.SetCoins(dex.NewCetCoins(1000))
+
+	accX := authx.NewAccountXWithAddress(addr)
+	accX.FrozenCoins = dex.NewCetCoins(100)
+	accX.NonceLanes = []uint64{3, 0, 7}
+	sales := []alias.AliasSale{{Alias: "superman", Seller: addr, Price: 100}}
+	delists := []market.DelistRequest{{TradingPair: "xyz/" + dex.CET, Time: 4102444800}}
+	app := newApp()
+	genState := NewDefaultGenesisState()
+	genState.StakingData.Params.BondDenom = dex.DefaultBondDenom
+	genState.Accounts = append(genState.Accounts, genaccounts.NewGenesisAccount(&acc))
+	genStreamPool, _ := genaccounts.NewGenesisAccountI(streamPool)
+	genState.Accounts = append(genState.Accounts, genStreamPool)
+	genState.Supply.Supply = dex.NewCetCoins(1e8 + 1000 + 100)
+
+	genState.AuthXData.AccountXs = append(genState.AuthXData.AccountXs, accX)
+	genState.AliasData.AliasEntryList = []alias.AliasEntry{{Alias: "superman", Addr: addr, AsDefault: true}}
+	genState.AliasData.AliasSales = sales
+	genState.DistrxData.NextStreamID = 2
+	genState.DistrxData.Streams = []distributionx.Stream{
+		{ID: 1, Recipient: addr, Amount: dex.NewCetCoins(1000), StartHeight: 1, EndHeight: 1001},
+	}
+	genState.MarketData.MarketInfos = []market.MarketInfo{
+		{Stock: "abc", Money: dex.CET, LastExecutedPrice: sdk.OneDec(), BuyFeeRate: sdk.ZeroDec(), SellFeeRate: sdk.ZeroDec(),
+			Halted: true, HaltEndHeight: 50, HaltReason: market.HaltByProposal},
+		{Stock: "xyz", Money: dex.CET, LastExecutedPrice: sdk.OneDec(), BuyFeeRate: sdk.ZeroDec(), SellFeeRate: sdk.ZeroDec()},
+	}
+	genState.MarketData.Orders = []*market.Order{{
+		Sender: addr, Sequence: 1, TradingPair: "abc/" + dex.CET, OrderType: market.LimitOrder, Price: sdk.OneDec(),
+		Quantity: 100, Side: market.BUY, TimeInForce: market.GTE, Height: 1, ExistBlocks: 100,
+		LeftStock: 100, Freeze: 100, FeeRate: sdk.ZeroDec(),
+	}}
+	genState.MarketData.DelistRequests = delists
+	genState.MarketData.PriceWindows = []market.MarketPriceWindow{
+		{TradingPair: "xyz/" + dex.CET, Window: market.PriceWindow{StartHeight: 1, StartPrice: sdk.OneDec()}},
+	}
+	genStateBytes, _ := app.cdc.MarshalJSON(genState)
+	app.InitChain(abci.RequestInitChain{ChainId: testChainID, AppStateBytes: genStateBytes})
+	app.Commit()
+	for h := int64(2); h <= 3; h++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: h}})
+		app.EndBlock(abci.RequestEndBlock{Height: h})
+		app.Commit()
+	}
+	height := app.LastBlockHeight()
+
+	for _, forZeroHeight := range []bool{false, true} {
+		appState, _, diffs, err := app.ExportAndVerifyAppState(forZeroHeight, nil)
+		require.Nil(t, err)
+		require.Empty(t, diffs, FormatStoreDiffs(diffs))
+
+		var exported GenesisState
+		app.cdc.MustUnmarshalJSON(appState, &exported)
+		require.Equal(t, accX.NonceLanes, exported.AuthXData.AccountXs[0].NonceLanes)
+		require.Equal(t, sales, exported.AliasData.AliasSales)
+		require.Equal(t, delists, exported.MarketData.DelistRequests)
+		if !forZeroHeight {
+			continue
+		}
+		// the heights are moved back by the export height, and the order still expires at block 101
+		order := exported.MarketData.Orders[0]
+		require.Equal(t, int64(0), order.Height)
+		require.Equal(t, 101-height, order.Height+order.ExistBlocks)
+		require.Equal(t, 50-height, exported.MarketData.MarketInfos[0].HaltEndHeight)
+		require.Equal(t, 1-height, exported.MarketData.PriceWindows[0].Window.StartHeight)
+		require.Equal(t, 1-height, exported.DistrxData.Streams[0].StartHeight)
+		require.Equal(t, 1001-height, exported.DistrxData.Streams[0].EndHeight)
+	}
+}
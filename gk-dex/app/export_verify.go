@@ -0,0 +1,134 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/msgqueue"
+)
+
+// StoreDiff is a key of a module store which didn't round-trip through the exported genesis
+type StoreDiff struct {
+	Store    string
+	Key      []byte
+	Exported []byte // the value in the exporting chain, nil if the key is missing there
+	Imported []byte // the value after reimporting the genesis, nil if the key is missing there
+}
+
+func (d StoreDiff) String() string {
+	switch {
+	case d.Imported == nil:
+		return fmt.Sprintf("%s: key %X is lost, value %X", d.Store, d.Key, d.Exported)
+	case d.Exported == nil:
+		return fmt.Sprintf("%s: key %X is added, value %X", d.Store, d.Key, d.Imported)
+	default:
+		return fmt.Sprintf("%s: key %X is changed, value %X -> %X", d.Store, d.Key, d.Exported, d.Imported)
+	}
+}
+
+// FormatStoreDiffs reports the diffs module by module
+func FormatStoreDiffs(diffs []StoreDiff) string {
+	var sb strings.Builder
+	for i, d := range diffs {
+		if i == 0 || diffs[i-1].Store != d.Store {
+			n := 1
+			for n < len(diffs)-i && diffs[i+n].Store == d.Store {
+				n++
+			}
+			fmt.Fprintf(&sb, "store %s: %d key(s) didn't round-trip\n", d.Store, n)
+		}
+		fmt.Fprintf(&sb, "  %s\n", d)
+	}
+	return sb.String()
+}
+
+// ExportAndVerifyAppState exports the state like ExportAppStateAndValidators, then imports it into
+// a fresh in-memory app and returns the keys of the module stores which are not the same there
+func (app *CetChainApp) ExportAndVerifyAppState(forZeroHeight bool, jailWhiteList []string) (
+	appState json.RawMessage, validators []tmtypes.GenesisValidator, diffs []StoreDiff, err error) {
+
+	// compare with the context used by the export, which has the changes made for zero height
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	appState, validators, err = app.exportAppStateAndValidators(ctx, forZeroHeight, jailWhiteList)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	diffs, err = app.reimportAndDiff(ctx, appState)
+	return appState, validators, diffs, err
+}
+
+func (app *CetChainApp) reimportAndDiff(ctx sdk.Context, appState json.RawMessage) (diffs []StoreDiff, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to import the exported state: %v", r)
+		}
+	}()
+
+	// the fresh app must not publish to the message queue or start a trade server
+	brokers := viper.Get(msgqueue.FlagBrokers)
+	viper.Set(msgqueue.FlagBrokers, []string{})
+	defer viper.Set(msgqueue.FlagBrokers, brokers)
+
+	newApp := NewCetChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+	newApp.InitChain(abci.RequestInitChain{
+		ChainId:       ctx.ChainID(),
+		Time:          ctx.BlockTime(),
+		AppStateBytes: appState,
+	})
+	newApp.Commit()
+	newCtx := newApp.NewContext(true, abci.Header{})
+
+	newKeys := newApp.kvStoreKeys()
+	for i, key := range app.kvStoreKeys() {
+		// the main store only has the consensus params and the commit info
+		if key == app.keyMain {
+			continue
+		}
+		diffs = append(diffs, diffStores(key.Name(), ctx.KVStore(key), newCtx.KVStore(newKeys[i]))...)
+	}
+	return diffs, nil
+}
+
+// diffStores walks the two stores in key order and returns the keys whose values differ
+func diffStores(name string, exported, imported sdk.KVStore) (diffs []StoreDiff) {
+	expIter := exported.Iterator(nil, nil)
+	defer expIter.Close()
+	impIter := imported.Iterator(nil, nil)
+	defer impIter.Close()
+
+	for expIter.Valid() || impIter.Valid() {
+		cmp := -1
+		if !expIter.Valid() {
+			cmp = 1
+		} else if impIter.Valid() {
+			cmp = bytes.Compare(expIter.Key(), impIter.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			diffs = append(diffs, StoreDiff{Store: name, Key: expIter.Key(), Exported: expIter.Value()})
+			expIter.Next()
+		case cmp > 0:
+			diffs = append(diffs, StoreDiff{Store: name, Key: impIter.Key(), Imported: impIter.Value()})
+			impIter.Next()
+		default:
+			if !bytes.Equal(expIter.Value(), impIter.Value()) {
+				diffs = append(diffs, StoreDiff{Store: name, Key: expIter.Key(),
+					Exported: expIter.Value(), Imported: impIter.Value()})
+			}
+			expIter.Next()
+			impIter.Next()
+		}
+	}
+	return diffs
+}
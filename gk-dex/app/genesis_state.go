@@ -21,6 +21,7 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/bancorlite"
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	"github.com/coinexchain/cet-sdk/modules/comment"
+	"github.com/coinexchain/cet-sdk/modules/distributionx"
 	"github.com/coinexchain/cet-sdk/modules/incentive"
 	"github.com/coinexchain/cet-sdk/modules/market"
 	"github.com/coinexchain/cet-sdk/modules/stakingx"
@@ -28,25 +29,26 @@ import (
 
 // State to Unmarshal
 type GenesisState struct {
-	Accounts     genaccounts.GenesisState  `json:"accounts"`
-	AuthData     auth.GenesisState         `json:"auth"`
-	AuthXData    authx.GenesisState        `json:"authx"`
-	BankData     bank.GenesisState         `json:"bank"`
-	BankXData    bankx.GenesisState        `json:"bankx"`
-	StakingData  staking.GenesisState      `json:"staking"`
-	StakingXData stakingx.GenesisState     `json:"stakingx"`
-	DistrData    distribution.GenesisState `json:"distribution"`
-	GovData      gov.GenesisState          `json:"gov"`
-	CrisisData   crisis.GenesisState       `json:"crisis"`
-	SlashingData slashing.GenesisState     `json:"slashing"`
-	AssetData    asset.GenesisState        `json:"asset"`
-	MarketData   market.GenesisState       `json:"market"`
-	BancorData   bancorlite.GenesisState   `json:"bancorlite"`
-	CommentData  comment.GenesisState      `json:"comment"`
-	AliasData    alias.GenesisState        `json:"alias"`
-	Incentive    incentive.GenesisState    `json:"incentive"`
-	Supply       supply.GenesisState       `json:"supply"`
-	GenUtil      genutil.GenesisState      `json:"genutil"`
+	Accounts     genaccounts.GenesisState   `json:"accounts"`
+	AuthData     auth.GenesisState          `json:"auth"`
+	AuthXData    authx.GenesisState         `json:"authx"`
+	BankData     bank.GenesisState          `json:"bank"`
+	BankXData    bankx.GenesisState         `json:"bankx"`
+	StakingData  staking.GenesisState       `json:"staking"`
+	StakingXData stakingx.GenesisState      `json:"stakingx"`
+	DistrData    distribution.GenesisState  `json:"distribution"`
+	DistrxData   distributionx.GenesisState `json:"distrx"`
+	GovData      gov.GenesisState           `json:"gov"`
+	CrisisData   crisis.GenesisState        `json:"crisis"`
+	SlashingData slashing.GenesisState      `json:"slashing"`
+	AssetData    asset.GenesisState         `json:"asset"`
+	MarketData   market.GenesisState        `json:"market"`
+	BancorData   bancorlite.GenesisState    `json:"bancorlite"`
+	CommentData  comment.GenesisState       `json:"comment"`
+	AliasData    alias.GenesisState         `json:"alias"`
+	Incentive    incentive.GenesisState     `json:"incentive"`
+	Supply       supply.GenesisState        `json:"supply"`
+	GenUtil      genutil.GenesisState       `json:"genutil"`
 }
 
 func NewDefaultGenesisState() GenesisState {
@@ -59,6 +61,7 @@ func NewDefaultGenesisState() GenesisState {
 		StakingData:  staking.DefaultGenesisState(),
 		StakingXData: stakingx.DefaultGenesisState(),
 		DistrData:    distribution.DefaultGenesisState(),
+		DistrxData:   distributionx.DefaultGenesisState(),
 		GovData:      gov.DefaultGenesisState(),
 		CrisisData:   crisis.DefaultGenesisState(),
 		SlashingData: slashing.DefaultGenesisState(),
@@ -84,6 +87,7 @@ func FromMap(cdc *codec.Codec, g map[string]json.RawMessage) GenesisState {
 	unmarshalField(cdc, g[staking.ModuleName], &gs.StakingData)
 	unmarshalField(cdc, g[stakingx.ModuleName], &gs.StakingXData)
 	unmarshalField(cdc, g[distribution.ModuleName], &gs.DistrData)
+	unmarshalField(cdc, g[distributionx.ModuleName], &gs.DistrxData)
 	unmarshalField(cdc, g[gov.ModuleName], &gs.GovData)
 	unmarshalField(cdc, g[crisis.ModuleName], &gs.CrisisData)
 	unmarshalField(cdc, g[slashing.ModuleName], &gs.SlashingData)
@@ -115,6 +119,7 @@ func (gs GenesisState) toMap(cdc *codec.Codec) map[string]json.RawMessage {
 	m[staking.ModuleName] = cdc.MustMarshalJSON(gs.StakingData)
 	m[stakingx.ModuleName] = cdc.MustMarshalJSON(gs.StakingXData)
 	m[distribution.ModuleName] = cdc.MustMarshalJSON(gs.DistrData)
+	m[distributionx.ModuleName] = cdc.MustMarshalJSON(gs.DistrxData)
 	m[gov.ModuleName] = cdc.MustMarshalJSON(gs.GovData)
 	m[crisis.ModuleName] = cdc.MustMarshalJSON(gs.CrisisData)
 	m[slashing.ModuleName] = cdc.MustMarshalJSON(gs.SlashingData)
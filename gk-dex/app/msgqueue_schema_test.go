@@ -0,0 +1,66 @@
+package app
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	"github.com/coinexchain/cet-sdk/modules/market"
+	"github.com/coinexchain/cet-sdk/msgqueue"
+)
+
+const msgqueueSchemaFile = "../docs/msgqueue_schema.json"
+
+// The consumers rely on the schemas of docs/msgqueue_schema.json, a payload can only change
+// together with the version of its topic. Regenerate the file with `cetd msgqueue schema`.
+func TestMsgQueueSchemas(t *testing.T) {
+	bz, err := ioutil.ReadFile(msgqueueSchemaFile)
+	require.NoError(t, err)
+	var published []msgqueue.Schema
+	require.NoError(t, json.Unmarshal(bz, &published))
+
+	current := msgqueue.GetSchemas()
+	for _, old := range published {
+		for _, s := range current {
+			if s.Topic == old.Topic && s.Version == old.Version {
+				require.Equal(t, old, s, "the payload of %s changed, increase its schema version", s.Topic)
+			}
+		}
+	}
+	require.Equal(t, published, current, "%s is out of date", msgqueueSchemaFile)
+}
+
+func TestMsgQueueConsumer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consumer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	lines := "height_info#{\"height\":10}\r\n" +
+		"fill_order_info#{\"order_id\":\"coinex1-1\",\"trading_pair\":\"abc/cet\",\"height\":10}\r\n" +
+		"bancor_trade#{\"sender\":\"\",\"stock\":\"abc\",\"money\":\"cet\"}\r\n" +
+		"send_lock_coins#{\"unlock_time\":100}\r\n" +
+		"notify_unlock#{\"height\":10}\r\n" +
+		"commit#{}\r\n"
+	require.NoError(t, ioutil.WriteFile(msgqueue.GetFileName(dir, 0), []byte(lines), 0644))
+
+	c, err := msgqueue.NewConsumer(msgqueue.CfgPrefixDir+dir, "")
+	require.NoError(t, err)
+	var block msgqueue.Block
+	require.NoError(t, c.Run(func(b msgqueue.Block) error {
+		block = b
+		c.Close()
+		return nil
+	}))
+	require.EqualValues(t, 10, block.Height)
+	require.Len(t, block.Msgs, 4)
+	require.IsType(t, market.FillOrderInfo{}, block.Msgs[0].Value)
+	require.Equal(t, "abc/cet", block.Msgs[0].Value.(market.FillOrderInfo).TradingPair)
+	require.IsType(t, bancorlite.MsgBancorTradeInfoForKafka{}, block.Msgs[1].Value)
+	require.IsType(t, bankx.LockedSendMsg{}, block.Msgs[2].Value)
+	require.IsType(t, authx.NotificationUnlock{}, block.Msgs[3].Value)
+}
@@ -1,12 +1,49 @@
 package plugin
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
+// AppPlugin is the Instance exported by data/plugin.so, which can also implement the hook sets below.
+// The requests and the responses passed to the hooks must not be modified. PreCheckTx is called
+// while the other hooks run for the delivered blocks, and a timed out hook keeps running after the
+// plugin is disabled, so the hooks must be safe for concurrent use.
 type AppPlugin interface {
 	PreCheckTx(abci.RequestCheckTx, sdk.TxDecoder, log.Logger) sdk.Error
 	Name() string
 }
+
+// DeliverTxHook observes the results of the delivered txs
+type DeliverTxHook interface {
+	PostDeliverTx(abci.RequestDeliverTx, abci.ResponseDeliverTx, log.Logger)
+}
+
+// BlockHook observes the events of BeginBlock and EndBlock
+type BlockHook interface {
+	PostBeginBlock(abci.RequestBeginBlock, abci.ResponseBeginBlock, log.Logger)
+	PostEndBlock(abci.RequestEndBlock, abci.ResponseEndBlock, log.Logger)
+}
+
+// CommitHook observes the committed blocks
+type CommitHook interface {
+	PostCommit(abci.ResponseCommit, log.Logger)
+}
+
+// The names of the hooks
+const (
+	HookPreCheckTx     = "pre_check_tx"
+	HookPostDeliverTx  = "post_deliver_tx"
+	HookPostBeginBlock = "post_begin_block"
+	HookPostEndBlock   = "post_end_block"
+	HookPostCommit     = "post_commit"
+)
+
+// HookTimeouts can be implemented to set the timeouts of the hooks,
+// the hooks whose timeouts are not positive use DefaultHookTimeout
+type HookTimeouts interface {
+	HookTimeout(hook string) time.Duration
+}
@@ -36,6 +36,7 @@ func (loader *Holder) WaitPluginToggleSignal(logger log.Logger) {
 
 type Holder struct {
 	isEnabled      int32
+	runningHooks   int32 // the hooks of the plugin which have not returned, including the timed out ones
 	pluginInstance AppPlugin
 	logger         log.Logger
 }
@@ -66,6 +67,10 @@ func (loader *Holder) togglePlugin() {
 
 	if loader.isPluginEnabled() {
 		loader.disablePlugin()
+	} else if n := atomic.LoadInt32(&loader.runningHooks); n != 0 {
+		// a timed out hook may still be stuck in the plugin
+		loader.logger.Error(fmt.Sprintf("plugin %s can not be enabled until its %d running hooks return",
+			loader.pluginInstance.Name(), n))
 	} else {
 		loader.enablePlugin()
 	}
@@ -0,0 +1,105 @@
+package plugin
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// DefaultHookTimeout is the longest time a hook can take, the plugin is disabled if one of its hooks
+// times out or panics, and can be enabled again by the reload signal. A timed out hook can not be
+// stopped, so the plugin can not be enabled again until the hook returns.
+var DefaultHookTimeout = time.Second
+
+type hookResult struct {
+	panicked bool
+	value    interface{}
+	stack    []byte
+}
+
+// runHook runs fn with the enabled plugin, it returns false if fn is not finished successfully
+func (loader *Holder) runHook(hook string, fn func(AppPlugin)) bool {
+	p := loader.GetPlugin()
+	if p == nil {
+		return false
+	}
+	timeout := DefaultHookTimeout
+	if ht, ok := p.(HookTimeouts); ok && ht.HookTimeout(hook) > 0 {
+		timeout = ht.HookTimeout(hook)
+	}
+
+	done := make(chan hookResult, 1)
+	atomic.AddInt32(&loader.runningHooks, 1)
+	go func() {
+		defer atomic.AddInt32(&loader.runningHooks, -1)
+		defer func() {
+			if r := recover(); r != nil {
+				done <- hookResult{panicked: true, value: r, stack: debug.Stack()}
+			}
+		}()
+		fn(p)
+		done <- hookResult{}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case res := <-done:
+		if !res.panicked {
+			return true
+		}
+		loader.logger.Error(fmt.Sprintf("hook %s of plugin %s panicked: %v\n%s", hook, p.Name(), res.value, string(res.stack)))
+	case <-timer.C:
+		loader.logger.Error(fmt.Sprintf("hook %s of plugin %s timed out after %s", hook, p.Name(), timeout))
+	}
+	loader.disablePlugin()
+	return false
+}
+
+// PreCheckTx runs the PreCheckTx of the enabled plugin, the tx is accepted if the plugin fails
+func (loader *Holder) PreCheckTx(req abci.RequestCheckTx, txDecoder sdk.TxDecoder) sdk.Error {
+	var err sdk.Error
+	if !loader.runHook(HookPreCheckTx, func(p AppPlugin) {
+		err = p.PreCheckTx(req, txDecoder, loader.logger)
+	}) {
+		return nil
+	}
+	return err
+}
+
+// PostDeliverTx, PostBeginBlock, PostEndBlock and PostCommit run the hooks implemented by the enabled plugin
+func (loader *Holder) PostDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	loader.runHook(HookPostDeliverTx, func(p AppPlugin) {
+		if h, ok := p.(DeliverTxHook); ok {
+			h.PostDeliverTx(req, res, loader.logger)
+		}
+	})
+}
+
+func (loader *Holder) PostBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	loader.runHook(HookPostBeginBlock, func(p AppPlugin) {
+		if h, ok := p.(BlockHook); ok {
+			h.PostBeginBlock(req, res, loader.logger)
+		}
+	})
+}
+
+func (loader *Holder) PostEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	loader.runHook(HookPostEndBlock, func(p AppPlugin) {
+		if h, ok := p.(BlockHook); ok {
+			h.PostEndBlock(req, res, loader.logger)
+		}
+	})
+}
+
+func (loader *Holder) PostCommit(res abci.ResponseCommit) {
+	loader.runHook(HookPostCommit, func(p AppPlugin) {
+		if h, ok := p.(CommitHook); ok {
+			h.PostCommit(res, loader.logger)
+		}
+	})
+}
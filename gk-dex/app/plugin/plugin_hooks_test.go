@@ -0,0 +1,131 @@
+package plugin
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+type hookPlugin struct {
+	calls   []string
+	panicAt string
+	sleepAt string
+}
+
+func (p *hookPlugin) call(hook string) {
+	p.calls = append(p.calls, hook)
+	if p.panicAt == hook {
+		panic("hook failed")
+	}
+	if p.sleepAt == hook {
+		time.Sleep(time.Second)
+	}
+}
+
+func (p *hookPlugin) PreCheckTx(abci.RequestCheckTx, sdk.TxDecoder, log.Logger) sdk.Error {
+	p.call(HookPreCheckTx)
+	return sdk.ErrUnauthorized("rejected")
+}
+
+func (p *hookPlugin) Name() string {
+	return "hook_plugin"
+}
+
+func (p *hookPlugin) PostDeliverTx(abci.RequestDeliverTx, abci.ResponseDeliverTx, log.Logger) {
+	p.call(HookPostDeliverTx)
+}
+
+func (p *hookPlugin) PostBeginBlock(abci.RequestBeginBlock, abci.ResponseBeginBlock, log.Logger) {
+	p.call(HookPostBeginBlock)
+}
+
+func (p *hookPlugin) PostEndBlock(abci.RequestEndBlock, abci.ResponseEndBlock, log.Logger) {
+	p.call(HookPostEndBlock)
+}
+
+func (p *hookPlugin) PostCommit(abci.ResponseCommit, log.Logger) {
+	p.call(HookPostCommit)
+}
+
+func (p *hookPlugin) HookTimeout(hook string) time.Duration {
+	if hook == HookPostCommit {
+		return 10 * time.Millisecond
+	}
+	return 0
+}
+
+func newHookHolder(p AppPlugin) *Holder {
+	holder := &Holder{pluginInstance: p, logger: log.NewNopLogger()}
+	holder.enablePlugin()
+	return holder
+}
+
+func TestPluginHooks(t *testing.T) {
+	p := &hookPlugin{}
+	holder := newHookHolder(p)
+	require.NotNil(t, holder.PreCheckTx(abci.RequestCheckTx{}, nil))
+	holder.PostBeginBlock(abci.RequestBeginBlock{}, abci.ResponseBeginBlock{})
+	holder.PostDeliverTx(abci.RequestDeliverTx{}, abci.ResponseDeliverTx{})
+	holder.PostEndBlock(abci.RequestEndBlock{}, abci.ResponseEndBlock{})
+	holder.PostCommit(abci.ResponseCommit{})
+	require.Equal(t, []string{HookPreCheckTx, HookPostBeginBlock, HookPostDeliverTx,
+		HookPostEndBlock, HookPostCommit}, p.calls)
+	require.NotNil(t, holder.GetPlugin())
+
+	// the hooks are optional
+	holder = newHookHolder(&testPlugin{})
+	holder.PostCommit(abci.ResponseCommit{})
+	require.NotNil(t, holder.GetPlugin())
+
+	// no hook is called after the plugin is disabled
+	holder = &Holder{pluginInstance: p, logger: log.NewNopLogger()}
+	p.calls = nil
+	require.Nil(t, holder.PreCheckTx(abci.RequestCheckTx{}, nil))
+	holder.PostCommit(abci.ResponseCommit{})
+	require.Empty(t, p.calls)
+}
+
+func TestPluginHookFailures(t *testing.T) {
+	// a panicking plugin is disabled and its tx check is skipped
+	p := &hookPlugin{panicAt: HookPreCheckTx}
+	holder := newHookHolder(p)
+	require.Nil(t, holder.PreCheckTx(abci.RequestCheckTx{}, nil))
+	require.Nil(t, holder.GetPlugin())
+
+	p = &hookPlugin{panicAt: HookPostDeliverTx}
+	holder = newHookHolder(p)
+	holder.PostDeliverTx(abci.RequestDeliverTx{}, abci.ResponseDeliverTx{})
+	require.Nil(t, holder.GetPlugin())
+
+	// a slow plugin is disabled after the timeout of the hook
+	p = &hookPlugin{sleepAt: HookPostCommit}
+	holder = newHookHolder(p)
+	start := time.Now()
+	holder.PostCommit(abci.ResponseCommit{})
+	require.True(t, time.Since(start) < 500*time.Millisecond)
+	require.Nil(t, holder.GetPlugin())
+
+	// the plugin can not be enabled again until the timed out hook returns
+	holder.togglePlugin()
+	require.Nil(t, holder.GetPlugin())
+	for i := 0; i < 200 && atomic.LoadInt32(&holder.runningHooks) != 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	holder.togglePlugin()
+	require.NotNil(t, holder.GetPlugin())
+}
+
+type testPlugin struct{}
+
+func (p *testPlugin) PreCheckTx(abci.RequestCheckTx, sdk.TxDecoder, log.Logger) sdk.Error {
+	return nil
+}
+
+func (p *testPlugin) Name() string {
+	return "test_plugin"
+}
@@ -44,7 +44,14 @@ func (f MsgFilter) Name() string {
 	return "SimplePlugin"
 }
 
+func (f MsgFilter) PostDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx, logger log.Logger) {
+	if !res.IsOK() {
+		logger.Info("tx failed", "codespace", res.Codespace, "code", res.Code)
+	}
+}
+
 var _ plugin.AppPlugin = (*MsgFilter)(nil)
+var _ plugin.DeliverTxHook = (*MsgFilter)(nil)
 
 // Instance is the exported symbol
 var Instance MsgFilter
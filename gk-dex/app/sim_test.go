@@ -298,7 +298,7 @@ func GenAssetDefaultGenesisState(cdc *codec.Codec, accs []simulation.Account, am
 	tokenTotalSupply := sdk.NewInt(amount * (int64(len(accs)) + numInitiallyBonded))
 	assetGenesis := asset.DefaultGenesisState()
 	baseToken, _ := asset.NewToken("CoinEx Chain Native Token",
-		"cet",
+		dex.CET,
 		tokenTotalSupply,
 		accs[0].Address,
 		false,
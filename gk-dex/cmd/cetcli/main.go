@@ -155,7 +155,9 @@ func txCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		bankxcmd.SendTxCmd(cdc),
 		bankxcmd.RequireMemoCmd(cdc),
+		authxcmd.SetNonceLanesCmd(cdc),
 		distrxcmd.DonateTxCmd(cdc),
+		distrxcmd.ClaimStreamTxCmd(cdc),
 		client.LineBreak,
 		authcmd.GetSignCommand(cdc),
 		authcmd.GetMultiSignCommand(cdc),
@@ -2,7 +2,9 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
+	"os"
 	"syscall"
 	"time"
 
@@ -34,13 +36,20 @@ import (
 )
 
 // cetd custom flags
-const flagInvCheckPeriod = "inv-check-period"
+const (
+	flagInvCheckPeriod = "inv-check-period"
+	flagVerifyExport   = "verify"
+)
 
-var invCheckPeriod uint
+var (
+	invCheckPeriod uint
+	verifyExport   bool
+)
 
 func main() {
 	plugin.SetReloadPluginSignal(syscall.SIGUSR1)
 	msgqueue.SetMkFifoFunc(syscall.Mkfifo)
+	msgqueue.SetReloadRoutesSignal(syscall.SIGUSR2)
 
 	dex.InitSdkConfig()
 	rootCmd := createCetdCmd()
@@ -68,6 +77,10 @@ func createCetdCmd() *cobra.Command {
 	addInitCommands(ctx, cdc, rootCmd)
 	rootCmd.AddCommand(client.NewCompletionCmd(rootCmd, true))
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
+	if exportCmd, _, err := rootCmd.Find([]string{"export"}); err == nil {
+		exportCmd.Flags().BoolVar(&verifyExport, flagVerifyExport, false,
+			"Reimport the exported state into a fresh in-memory app and report the store keys which didn't round-trip")
+	}
 
 	rootCmd.PersistentFlags().UintVar(&invCheckPeriod, flagInvCheckPeriod,
 		0, "Assert registered invariants every N blocks")
@@ -91,6 +104,7 @@ func addInitCommands(ctx *server.Context, cdc *codec.Codec, rootCmd *cobra.Comma
 	rootCmd.AddCommand(assetcli.AddGenesisTokenCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome))
 	rootCmd.AddCommand(testnetCmd(ctx, cdc, app.ModuleBasics, genaccounts.AppModuleBasic{}))
 	rootCmd.AddCommand(migrateCmd(cdc))
+	rootCmd.AddCommand(msgqueueCmd())
 }
 
 func adjustBlockCommitSpeed(config *tmconfig.Config) {
@@ -129,8 +143,26 @@ func exportAppStateAndTMValidators(
 		if err != nil {
 			return nil, nil, err
 		}
-		return gApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+		return exportFromApp(gApp, forZeroHeight, jailWhiteList)
 	}
 	gApp := app.NewCetChainApp(logger, db, traceStore, true, uint(1))
-	return gApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+	return exportFromApp(gApp, forZeroHeight, jailWhiteList)
+}
+
+func exportFromApp(gApp *app.CetChainApp, forZeroHeight bool, jailWhiteList []string) (
+	json.RawMessage, []tmtypes.GenesisValidator, error) {
+
+	if !verifyExport {
+		return gApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+	}
+	appState, validators, diffs, err := gApp.ExportAndVerifyAppState(forZeroHeight, jailWhiteList)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(diffs) != 0 {
+		fmt.Fprint(os.Stderr, app.FormatStoreDiffs(diffs))
+		return nil, nil, fmt.Errorf("%d key(s) didn't round-trip through the exported state", len(diffs))
+	}
+	fmt.Fprintln(os.Stderr, "all the module stores round-trip through the exported state")
+	return appState, validators, nil
 }
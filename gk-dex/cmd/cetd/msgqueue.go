@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/coinexchain/cet-sdk/msgqueue"
+)
+
+func msgqueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msgqueue",
+		Short: "Tools for the messages pushed to msgqueue",
+	}
+	cmd.AddCommand(msgqueueSchemaCmd())
+	cmd.AddCommand(msgqueueReplayCmd())
+	return cmd
+}
+
+func msgqueueSchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema [topic]",
+		Short: "Print the schemas of the msgqueue topics as JSON",
+		Long: `Print the schemas of the msgqueue topics as JSON, which are generated from the Go types of the payloads.
+Every schema has a version, which is carried by the envelopes of the writers configured with ?encoding=json or ?encoding=proto.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var res interface{} = msgqueue.GetSchemas()
+			if len(args) == 1 {
+				schema, ok := msgqueue.GetSchema(args[0])
+				if !ok {
+					return fmt.Errorf("unknown topic: %s", args[0])
+				}
+				res = schema
+			}
+			bz, err := json.MarshalIndent(res, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+const (
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+	flagWriter     = "writer"
+)
+
+func msgqueueReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay the messages of a height range from the outbox of a kafka writer",
+		Long: `Replay the messages of a height range from the outbox of a kafka writer, which is configured like
+kafka:broker1,broker2?outbox=/path/to/outbox. The messages are sent again even if they have been delivered,
+so the consumers should skip the heights they have processed.
+By default the first kafka writer with an outbox in the brokers of config.toml is used.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromHeight := viper.GetInt64(flagFromHeight)
+			if fromHeight <= 0 {
+				return fmt.Errorf("--%s must be positive", flagFromHeight)
+			}
+			writer := viper.GetString(flagWriter)
+			if len(writer) == 0 {
+				for _, cfg := range viper.GetStringSlice(msgqueue.FlagBrokers) {
+					if strings.HasPrefix(cfg, msgqueue.CfgPrefixKafka) && strings.Contains(cfg, "outbox=") {
+						writer = cfg
+						break
+					}
+				}
+			}
+			if len(writer) == 0 {
+				return fmt.Errorf("no kafka writer with an outbox is configured, use --%s", flagWriter)
+			}
+			count, err := msgqueue.ReplayOutbox(writer, fromHeight, viper.GetInt64(flagToHeight))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("replayed %d blocks\n", count)
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagFromHeight, 0, "The first height to replay")
+	cmd.Flags().Int64(flagToHeight, 0, "The last height to replay, 0 means the last height in the outbox")
+	cmd.Flags().String(flagWriter, "", "The kafka writer to replay, e.g. kafka:localhost:9092?outbox=/path/to/outbox")
+	return cmd
+}
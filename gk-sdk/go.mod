@@ -12,7 +12,9 @@ require (
 	github.com/emirpasic/gods v1.12.0
 	github.com/facebookgo/ensure v0.0.0-20200202191622-63f1cf65ac4c // indirect
 	github.com/facebookgo/subset v0.0.0-20200203212716-c811ad88dec4 // indirect
+	github.com/golang/protobuf v1.3.2
 	github.com/gorilla/mux v1.7.3
+	github.com/gorilla/websocket v1.4.1
 	github.com/pelletier/go-toml v1.4.0
 	github.com/pierrec/lz4 v2.0.5+incompatible // indirect
 	github.com/pkg/errors v0.8.1
@@ -23,6 +25,7 @@ require (
 	github.com/tendermint/tendermint v0.32.9
 	github.com/tendermint/tm-db v0.2.0
 	golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550
+	google.golang.org/grpc v1.25.1
 	gopkg.in/jcmturner/goidentity.v3 v3.0.0 // indirect
 )
 
@@ -6,17 +6,26 @@ import (
 )
 
 const (
-	StoreKey   = types.StoreKey
-	ModuleName = types.ModuleName
+	StoreKey          = types.StoreKey
+	ModuleName        = types.ModuleName
+	DefaultParamspace = types.DefaultParamspace
 )
 
 var (
-	ModuleCdc     = types.ModuleCdc
-	NewBaseKeeper = keepers.NewKeeper
-	DefaultParams = types.DefaultParams
+	ModuleCdc      = types.ModuleCdc
+	NewBaseKeeper  = keepers.NewKeeper
+	DefaultParams  = types.DefaultParams
+	ValidateParams = types.ValidateParams
 )
 
 type (
-	Keeper         = keepers.Keeper
-	MsgAliasUpdate = types.MsgAliasUpdate
+	Keeper             = keepers.Keeper
+	AliasEntry         = keepers.AliasEntry
+	AliasSale          = keepers.AliasSale
+	MsgAliasUpdate     = types.MsgAliasUpdate
+	MsgAliasRenew      = types.MsgAliasRenew
+	MsgAliasTransfer   = types.MsgAliasTransfer
+	MsgAliasSell       = types.MsgAliasSell
+	MsgAliasCancelSale = types.MsgAliasCancelSale
+	MsgAliasBuy        = types.MsgAliasBuy
 )
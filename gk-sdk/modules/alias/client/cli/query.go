@@ -62,3 +62,15 @@ Example :
 		},
 	}
 }
+
+func QueryAliasSalesCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sales",
+		Args:  cobra.NoArgs,
+		Short: "Query the aliases listed for sale",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryAliasSales)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+}
@@ -17,6 +17,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		QueryParamsCmd(cdc),
 		QueryAliasCmd(cdc),
 		QueryAddressCmd(cdc),
+		QueryAliasSalesCmd(cdc),
 	)...)
 	return aliasQueryCmd
 }
@@ -30,6 +31,11 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	aliasTxCmd.AddCommand(client.PostCommands(
 		AliasAddCmd(cdc),
 		AliasRemoveCmd(cdc),
+		AliasRenewCmd(cdc),
+		AliasTransferCmd(cdc),
+		AliasSellCmd(cdc),
+		AliasCancelSaleCmd(cdc),
+		AliasBuyCmd(cdc),
 	)...)
 
 	return aliasTxCmd
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/alias/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/alias/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// QueryAddressOfAlias asks a full node for the address bound to an alias, it is replaced in unit tests
+var QueryAddressOfAlias = func(alias string) (sdk.AccAddress, error) {
+	cliCtx := context.NewCLIContext().WithCodec(types.ModuleCdc)
+	route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryAliasInfo)
+	param := keepers.QueryAliasInfoParam{Alias: alias, QueryOp: keepers.GetAddressFromAlias}
+	bz, _, err := cliCtx.QueryWithData(route, types.ModuleCdc.MustMarshalJSON(param))
+	if err != nil {
+		return nil, err
+	}
+	var res []string
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &res); err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no address is bound to alias '%s'", alias)
+	}
+	return sdk.AccAddressFromBech32(res[0])
+}
+
+// ResolveAddress parses s as a bech32 address, or as an alias in the form of "@alias".
+// For an alias, the bound address and the alias are returned, otherwise the alias is empty.
+func ResolveAddress(s string) (sdk.AccAddress, string, error) {
+	if !dex.IsAliasRef(s) {
+		addr, err := sdk.AccAddressFromBech32(s)
+		return addr, "", err
+	}
+	alias := dex.TrimAliasPrefix(s)
+	if !types.IsValidAlias(alias) {
+		return nil, "", types.ErrInvalidAlias()
+	}
+	addr, err := QueryAddressOfAlias(alias)
+	if err != nil {
+		return nil, "", err
+	}
+	return addr, alias, nil
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestResolveAddress(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("coinex", "coinexpub")
+	alice, _ := sdk.AccAddressFromHex("01234567890123456789012345678901234abcde")
+	QueryAddressOfAlias = func(alias string) (sdk.AccAddress, error) {
+		if alias == "alice" {
+			return alice, nil
+		}
+		return nil, errors.New("no address")
+	}
+
+	addr, alias, err := ResolveAddress("@alice")
+	require.NoError(t, err)
+	require.Equal(t, alice, addr)
+	require.Equal(t, "alice", alias)
+
+	addr, alias, err = ResolveAddress(alice.String())
+	require.NoError(t, err)
+	require.Equal(t, alice, addr)
+	require.Equal(t, "", alias)
+
+	_, _, err = ResolveAddress("@bob")
+	require.Error(t, err)
+	_, _, err = ResolveAddress("@I Love U")
+	require.Error(t, err)
+}
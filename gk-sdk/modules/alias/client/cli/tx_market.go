@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/alias/internal/types"
+	"github.com/coinexchain/cosmos-utils/client/cliutil"
+)
+
+func AliasRenewCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew [alias]",
+		Short: "Renew an alias of current account for another registration period",
+		Long: `Renew an alias of current account for another registration period.
+The same fee as adding the alias is charged.
+
+Example: 
+	 cetcli tx alias renew super_super_boy --from local_user_1
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg := &types.MsgAliasRenew{
+				Alias: args[0],
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func AliasTransferCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [alias] [new-owner]",
+		Short: "Transfer an alias of current account to another account",
+		Long: `Transfer an alias of current account to another account.
+
+Example: 
+	 cetcli tx alias transfer super_super_boy coinex1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4 --from local_user_1
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgAliasTransfer{
+				Alias:    args[0],
+				NewOwner: newOwner,
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func AliasSellCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell [alias] [price]",
+		Short: "List an alias of current account for sale at a fixed price",
+		Long: `List an alias of current account for sale at a fixed price, in sato.CET.
+Listing it again changes the price.
+
+Example: 
+	 cetcli tx alias sell super_super_boy 100000000000 --from local_user_1
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			price, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgAliasSell{
+				Alias: args[0],
+				Price: price,
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func AliasCancelSaleCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-sale [alias]",
+		Short: "Cancel the sale listing of an alias",
+		Long: `Cancel the sale listing of an alias.
+
+Example: 
+	 cetcli tx alias cancel-sale super_super_boy --from local_user_1
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg := &types.MsgAliasCancelSale{
+				Alias: args[0],
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func AliasBuyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy [alias] [price]",
+		Short: "Buy an alias listed for sale",
+		Long: `Buy an alias listed for sale. The price, in sato.CET, must be the same as the listed one.
+
+Example: 
+	 cetcli tx alias buy super_super_boy 100000000000 --from local_user_2
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			price, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgAliasBuy{
+				Alias: args[0],
+				Price: price,
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
@@ -20,6 +20,7 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc("/alias/address-of-alias/{alias}", queryAddressHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/alias/aliases-of-address/{address}", queryAliasesHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/alias/parameters", queryParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/alias/sales", querySalesHandlerFn(cliCtx)).Methods("GET")
 }
 
 func queryAddressHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -57,3 +58,11 @@ func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
 	}
 }
+
+// HTTP request handler to query the aliases listed for sale
+func querySalesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryAliasSales)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/coinexchain/cosmos-utils/client/restutil"
 )
 
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
@@ -14,4 +16,9 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec)
 
 func registerTXRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/alias/update", aliasUpdateHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/alias/renew", restutil.NewRestHandler(cdc, cliCtx, new(AliasRenewReq))).Methods("POST")
+	r.HandleFunc("/alias/transfer", restutil.NewRestHandler(cdc, cliCtx, new(AliasTransferReq))).Methods("POST")
+	r.HandleFunc("/alias/sell", restutil.NewRestHandler(cdc, cliCtx, new(AliasSellReq))).Methods("POST")
+	r.HandleFunc("/alias/cancel-sale", restutil.NewRestHandler(cdc, cliCtx, new(AliasCancelSaleReq))).Methods("POST")
+	r.HandleFunc("/alias/buy", restutil.NewRestHandler(cdc, cliCtx, new(AliasBuyReq))).Methods("POST")
 }
@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/coinexchain/cet-sdk/modules/alias/internal/types"
+	"github.com/coinexchain/cosmos-utils/client/restutil"
+)
+
+type AliasRenewReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Alias   string       `json:"alias"`
+}
+
+type AliasTransferReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Alias    string       `json:"alias"`
+	NewOwner string       `json:"new_owner"`
+}
+
+type AliasSellReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Alias   string       `json:"alias"`
+	Price   int64        `json:"price,string"`
+}
+
+type AliasCancelSaleReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Alias   string       `json:"alias"`
+}
+
+type AliasBuyReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Alias   string       `json:"alias"`
+	Price   int64        `json:"price,string"`
+}
+
+var _ restutil.RestReq = (*AliasRenewReq)(nil)
+var _ restutil.RestReq = (*AliasTransferReq)(nil)
+var _ restutil.RestReq = (*AliasSellReq)(nil)
+var _ restutil.RestReq = (*AliasCancelSaleReq)(nil)
+var _ restutil.RestReq = (*AliasBuyReq)(nil)
+
+func (req *AliasRenewReq) New() restutil.RestReq {
+	return new(AliasRenewReq)
+}
+func (req *AliasRenewReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *AliasRenewReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return &types.MsgAliasRenew{
+		Owner: sender,
+		Alias: req.Alias,
+	}, nil
+}
+
+func (req *AliasTransferReq) New() restutil.RestReq {
+	return new(AliasTransferReq)
+}
+func (req *AliasTransferReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *AliasTransferReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	newOwner, err := sdk.AccAddressFromBech32(req.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAliasTransfer{
+		Owner:    sender,
+		Alias:    req.Alias,
+		NewOwner: newOwner,
+	}, nil
+}
+
+func (req *AliasSellReq) New() restutil.RestReq {
+	return new(AliasSellReq)
+}
+func (req *AliasSellReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *AliasSellReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return &types.MsgAliasSell{
+		Owner: sender,
+		Alias: req.Alias,
+		Price: req.Price,
+	}, nil
+}
+
+func (req *AliasCancelSaleReq) New() restutil.RestReq {
+	return new(AliasCancelSaleReq)
+}
+func (req *AliasCancelSaleReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *AliasCancelSaleReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return &types.MsgAliasCancelSale{
+		Owner: sender,
+		Alias: req.Alias,
+	}, nil
+}
+
+func (req *AliasBuyReq) New() restutil.RestReq {
+	return new(AliasBuyReq)
+}
+func (req *AliasBuyReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *AliasBuyReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return &types.MsgAliasBuy{
+		Buyer: sender,
+		Alias: req.Alias,
+		Price: req.Price,
+	}, nil
+}
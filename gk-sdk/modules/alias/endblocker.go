@@ -0,0 +1,46 @@
+package alias
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/alias/internal/types"
+	"github.com/coinexchain/cet-sdk/msgqueue"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func init() {
+	msgqueue.RegisterSchema(types.AliasExpiredKey, 1, NotificationAliasExpired{})
+}
+
+type NotificationAliasExpired struct {
+	Alias      string         `json:"alias"`
+	Owner      sdk.AccAddress `json:"owner"`
+	ExpireTime int64          `json:"expire_time"`
+	Height     int64          `json:"height"`
+}
+
+// EndBlocker releases the aliases whose registration period has passed
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	currTime := ctx.BlockHeader().Time.Unix()
+	for _, alias := range k.GetExpiredAliases(ctx, currTime) {
+		addr, _ := k.GetAddressFromAlias(ctx, alias)
+		expireTime := k.GetExpireTime(ctx, alias)
+		k.RemoveAlias(ctx, alias, addr)
+
+		if len(k.GetEventTypeMsgQueue()) != 0 {
+			notify := NotificationAliasExpired{
+				Alias:      alias,
+				Owner:      addr,
+				ExpireTime: expireTime,
+				Height:     ctx.BlockHeight(),
+			}
+			bytes := dex.SafeJSONMarshal(notify)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					k.GetEventTypeMsgQueue(),
+					sdk.NewAttribute(types.AliasExpiredKey, string(bytes)),
+				),
+			)
+		}
+	}
+}
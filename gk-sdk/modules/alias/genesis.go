@@ -2,6 +2,7 @@ package alias
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,6 +13,7 @@ import (
 type GenesisState struct {
 	Params         types.Params         `json:"params"`
 	AliasEntryList []keepers.AliasEntry `json:"alias_entry_list"`
+	AliasSales     []keepers.AliasSale  `json:"alias_sales,omitempty"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -32,12 +34,23 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 	for _, entry := range data.AliasEntryList {
 		keeper.AddAlias(ctx, entry.Alias, entry.Addr, entry.AsDefault, 0)
+		// an entry without expire time starts a new registration period at genesis
+		expireTime := entry.ExpireTime
+		if expireTime == 0 {
+			expireTime = ctx.BlockHeader().Time.Unix() + data.Params.RegistrationPeriod
+		}
+		keeper.SetExpireTime(ctx, entry.Alias, expireTime)
+	}
+	for _, sale := range data.AliasSales {
+		keeper.SetAliasSale(ctx, sale)
 	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
-	return NewGenesisState(k.GetParams(ctx), k.GetAllAlias(ctx))
+	gs := NewGenesisState(k.GetParams(ctx), k.GetAllAlias(ctx))
+	gs.AliasSales = k.GetAllAliasSales(ctx)
+	return gs
 }
 
 func (data GenesisState) Validate() error {
@@ -45,10 +58,20 @@ func (data GenesisState) Validate() error {
 		return err
 	}
 
+	owners := make(map[string]string, len(data.AliasEntryList))
 	for _, entry := range data.AliasEntryList {
 		if !types.IsValidAlias(entry.Alias) {
 			return errors.New("Invalid Alias")
 		}
+		owners[entry.Alias] = entry.Addr.String()
+	}
+	for _, sale := range data.AliasSales {
+		if sale.Price <= 0 {
+			return fmt.Errorf("invalid price of alias sale: %s", sale.Alias)
+		}
+		if owners[sale.Alias] != sale.Seller.String() {
+			return fmt.Errorf("alias %s is not owned by its seller %s", sale.Alias, sale.Seller)
+		}
 	}
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/coinexchain/cet-sdk/modules/alias/internal/keepers"
 	"github.com/coinexchain/cet-sdk/modules/alias/internal/types"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
@@ -17,6 +18,16 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgAliasUpdate:
 			return handleMsgAliasUpdate(ctx, k, msg)
+		case types.MsgAliasRenew:
+			return handleMsgAliasRenew(ctx, k, msg)
+		case types.MsgAliasTransfer:
+			return handleMsgAliasTransfer(ctx, k, msg)
+		case types.MsgAliasSell:
+			return handleMsgAliasSell(ctx, k, msg)
+		case types.MsgAliasCancelSale:
+			return handleMsgAliasCancelSale(ctx, k, msg)
+		case types.MsgAliasBuy:
+			return handleMsgAliasBuy(ctx, k, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 		}
@@ -89,6 +100,7 @@ func handleAliasAdd(ctx sdk.Context, k Keeper, msg types.MsgAliasUpdate) sdk.Err
 		if err := k.DeductInt64CetFee(ctx, msg.Owner, fee); err != nil {
 			return err
 		}
+		k.SetExpireTime(ctx, msg.Alias, ctx.BlockHeader().Time.Unix()+params.RegistrationPeriod)
 	}
 
 	return nil
@@ -103,3 +115,180 @@ func handleAliasRemove(ctx sdk.Context, k Keeper, msg types.MsgAliasUpdate) sdk.
 	k.RemoveAlias(ctx, msg.Alias, msg.Owner)
 	return nil
 }
+
+func handleMsgAliasRenew(ctx sdk.Context, k Keeper, msg types.MsgAliasRenew) sdk.Result {
+	addr, _ := k.GetAddressFromAlias(ctx, msg.Alias)
+	if !bytes.Equal(addr, msg.Owner) {
+		return types.ErrNoSuchAlias().Result()
+	}
+
+	params := k.GetParams(ctx)
+	fee := params.GetFeeForAlias(msg.Alias)
+	if err := k.DeductInt64CetFee(ctx, msg.Owner, fee); err != nil {
+		return err.Result()
+	}
+
+	// an alias which is renewed before expiring keeps its remaining time
+	expireTime := k.GetExpireTime(ctx, msg.Alias)
+	if now := ctx.BlockHeader().Time.Unix(); expireTime < now {
+		expireTime = now
+	}
+	expireTime += params.RegistrationPeriod
+	k.SetExpireTime(ctx, msg.Alias, expireTime)
+
+	emitMessageEvent(ctx, msg.Owner)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRenewAlias,
+			sdk.NewAttribute(types.AttributeKeyAlias, msg.Alias),
+			sdk.NewAttribute(types.AttributeKeyExpireTime, fmt.Sprintf("%d", expireTime)),
+		),
+	)
+	return sdk.Result{
+		Codespace: types.CodeSpaceAlias,
+		Events:    ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgAliasTransfer(ctx sdk.Context, k Keeper, msg types.MsgAliasTransfer) sdk.Result {
+	if err := checkAliasOwner(ctx, k, msg.Alias, msg.Owner); err != nil {
+		return err.Result()
+	}
+	if err := checkNewAliasOwner(ctx, k, msg.Alias, msg.NewOwner); err != nil {
+		return err.Result()
+	}
+
+	k.TransferAlias(ctx, msg.Alias, msg.Owner, msg.NewOwner)
+
+	emitMessageEvent(ctx, msg.Owner)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferAlias,
+			sdk.NewAttribute(types.AttributeKeyAlias, msg.Alias),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner.String()),
+		),
+	)
+	return sdk.Result{
+		Codespace: types.CodeSpaceAlias,
+		Events:    ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgAliasSell(ctx sdk.Context, k Keeper, msg types.MsgAliasSell) sdk.Result {
+	if err := checkAliasOwner(ctx, k, msg.Alias, msg.Owner); err != nil {
+		return err.Result()
+	}
+
+	k.SetAliasSale(ctx, keepers.AliasSale{
+		Alias:  msg.Alias,
+		Seller: msg.Owner,
+		Price:  msg.Price,
+	})
+
+	emitMessageEvent(ctx, msg.Owner)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSellAlias,
+			sdk.NewAttribute(types.AttributeKeyAlias, msg.Alias),
+			sdk.NewAttribute(types.AttributeKeyPrice, fmt.Sprintf("%d", msg.Price)),
+		),
+	)
+	return sdk.Result{
+		Codespace: types.CodeSpaceAlias,
+		Events:    ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgAliasCancelSale(ctx sdk.Context, k Keeper, msg types.MsgAliasCancelSale) sdk.Result {
+	sale, ok := k.GetAliasSale(ctx, msg.Alias)
+	if !ok || !sale.Seller.Equals(msg.Owner) {
+		return types.ErrAliasNotForSale(msg.Alias).Result()
+	}
+
+	k.DeleteAliasSale(ctx, msg.Alias)
+
+	emitMessageEvent(ctx, msg.Owner)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelSale,
+			sdk.NewAttribute(types.AttributeKeyAlias, msg.Alias),
+		),
+	)
+	return sdk.Result{
+		Codespace: types.CodeSpaceAlias,
+		Events:    ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgAliasBuy(ctx sdk.Context, k Keeper, msg types.MsgAliasBuy) sdk.Result {
+	sale, ok := k.GetAliasSale(ctx, msg.Alias)
+	if !ok || sale.Seller.Equals(msg.Buyer) {
+		return types.ErrAliasNotForSale(msg.Alias).Result()
+	}
+	if sale.Price != msg.Price {
+		return types.ErrAliasPriceMismatch(sale.Price, msg.Price).Result()
+	}
+	if err := checkAliasOwner(ctx, k, msg.Alias, sale.Seller); err != nil {
+		return err.Result()
+	}
+	if err := checkNewAliasOwner(ctx, k, msg.Alias, msg.Buyer); err != nil {
+		return err.Result()
+	}
+
+	if err := k.SendCoins(ctx, msg.Buyer, sale.Seller, dex.NewCetCoins(sale.Price)); err != nil {
+		return err.Result()
+	}
+	k.TransferAlias(ctx, msg.Alias, sale.Seller, msg.Buyer)
+
+	emitMessageEvent(ctx, msg.Buyer)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBuyAlias,
+			sdk.NewAttribute(types.AttributeKeyAlias, msg.Alias),
+			sdk.NewAttribute(types.AttributeKeyPrice, fmt.Sprintf("%d", sale.Price)),
+		),
+	)
+	return sdk.Result{
+		Codespace: types.CodeSpaceAlias,
+		Events:    ctx.EventManager().Events(),
+	}
+}
+
+func emitMessageEvent(ctx sdk.Context, sender sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+}
+
+// checkAliasOwner makes sure the alias belongs to owner and will not be released in this block
+func checkAliasOwner(ctx sdk.Context, k Keeper, alias string, owner sdk.AccAddress) sdk.Error {
+	addr, _ := k.GetAddressFromAlias(ctx, alias)
+	if !bytes.Equal(addr, owner) {
+		return types.ErrNoSuchAlias()
+	}
+	expireTime := k.GetExpireTime(ctx, alias)
+	if expireTime != 0 && expireTime <= ctx.BlockHeader().Time.Unix() {
+		return types.ErrAliasExpired(alias)
+	}
+	return nil
+}
+
+// checkNewAliasOwner makes sure newOwner can hold one more alias
+func checkNewAliasOwner(ctx sdk.Context, k Keeper, alias string, newOwner sdk.AccAddress) sdk.Error {
+	if types.IsOnlyForCoinEx(alias) && !k.IsTokenIssuer(ctx, dex.CET, newOwner) {
+		return types.ErrCanOnlyBeUsedByCetOwner(alias)
+	}
+	aliasList := k.GetAliasListOfAccount(ctx, newOwner)
+	count := len(aliasList)
+	if aliasList[0] == "" {
+		count--
+	}
+	if count >= k.GetParams(ctx).MaxAliasCount {
+		return types.ErrMaxAliasCountReached()
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -24,6 +25,8 @@ import (
 
 var logStr string
 
+const testBlockTime = 1570000000
+
 type mocBankxKeeper struct {
 	maxAmount sdk.Int
 }
@@ -44,6 +47,14 @@ func (k *mocBankxKeeper) DeductFee(ctx sdk.Context, addr sdk.AccAddress, amt sdk
 	return nil
 }
 
+func (k *mocBankxKeeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if amt.AmountOf(dex.CET).GT(k.maxAmount) {
+		return sdk.NewError(types.CodeSpaceAlias, 1199, "Not enough coins")
+	}
+	logStr = "Send " + amt.String() + " from " + from.String() + " to " + to.String()
+	return nil
+}
+
 type mocAssetKeeper struct {
 	tokenIssuer map[string]sdk.AccAddress
 }
@@ -72,12 +83,13 @@ func newContextAndKeeper(chainid string) (sdk.Context, *Keeper) {
 			maxAmount: sdk.NewInt(913000000000),
 		},
 		&mocAssetKeeper{
-			tokenIssuer: map[string]sdk.AccAddress{"cet": simpleAddr("00000")},
+			tokenIssuer: map[string]sdk.AccAddress{dex.CET: simpleAddr("00000")},
 		},
 		paramsKeeper.Subspace(types.StoreKey),
+		"",
 	)
 
-	ctx := sdk.NewContext(ms, abci.Header{ChainID: chainid, Height: 1000}, false, log.NewNopLogger())
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: chainid, Height: 1000, Time: time.Unix(testBlockTime, 0)}, false, log.NewNopLogger())
 	parameters := types.DefaultParams()
 	keeper.SetParams(ctx, parameters)
 
@@ -101,15 +113,17 @@ func Test1(t *testing.T) {
 	aliasEntryList := keeper.GetAllAlias(ctx)
 	require.Equal(t, 0, len(aliasEntryList))
 
+	expireTime := int64(testBlockTime + types.DefaultRegistrationPeriod)
 	refList := []keepers.AliasEntry{
-		{Alias: "alice", Addr: alice, AsDefault: false},
-		{Alias: "tom", Addr: tom, AsDefault: false},
+		{Alias: "alice", Addr: alice, AsDefault: false, ExpireTime: expireTime},
+		{Alias: "tom", Addr: tom, AsDefault: false, ExpireTime: expireTime},
 		{Alias: "tom@gmail.com", Addr: tom, AsDefault: false},
 	}
 	genS := NewGenesisState(types.DefaultParams(), refList)
 	InitGenesis(ctx, *keeper, genS)
 
 	aliasEntryList = ExportGenesis(ctx, *keeper).AliasEntryList
+	refList[2].ExpireTime = expireTime
 	require.Equal(t, refList, aliasEntryList)
 
 	err := NewGenesisState(types.DefaultParams(), []keepers.AliasEntry{
@@ -158,10 +172,10 @@ func Test1(t *testing.T) {
 
 	aliasEntryList = ExportGenesis(ctx, *keeper).AliasEntryList
 	refList = []keepers.AliasEntry{
-		{Alias: "alice", Addr: alice, AsDefault: false},
-		{Alias: "supergirl", Addr: alice, AsDefault: false},
-		{Alias: "superman", Addr: bob, AsDefault: false},
-		{Alias: "tom@gmail.com", Addr: tom, AsDefault: false},
+		{Alias: "alice", Addr: alice, AsDefault: false, ExpireTime: expireTime},
+		{Alias: "supergirl", Addr: alice, AsDefault: false, ExpireTime: expireTime},
+		{Alias: "superman", Addr: bob, AsDefault: false, ExpireTime: expireTime},
+		{Alias: "tom@gmail.com", Addr: tom, AsDefault: false, ExpireTime: expireTime},
 	}
 	require.Equal(t, refList, aliasEntryList)
 
@@ -251,3 +265,129 @@ func TestReservedAliases(t *testing.T) {
 		require.Equal(t, types.ErrCanOnlyBeUsedByCetOwner(alias).Result(), res)
 	}
 }
+
+func TestRenewAndExpire(t *testing.T) {
+	ctx, keeper := newContextAndKeeper("test-1")
+	handlerFunc := NewHandler(*keeper)
+	alice := simpleAddr("00003")
+	period := types.DefaultParams().RegistrationPeriod
+
+	res := handlerFunc(ctx, types.MsgAliasUpdate{Owner: alice, Alias: "supergirl", IsAdd: true})
+	require.True(t, res.IsOK())
+	require.Equal(t, testBlockTime+period, keeper.GetExpireTime(ctx, "supergirl"))
+
+	// renewing before expiry extends from the current expire time
+	res = handlerFunc(ctx, types.MsgAliasRenew{Owner: alice, Alias: "supergirl"})
+	require.True(t, res.IsOK())
+	require.Equal(t, "Deduct 1000000000"+dex.CET+" from "+alice.String(), logStr)
+	require.Equal(t, testBlockTime+2*period, keeper.GetExpireTime(ctx, "supergirl"))
+
+	res = handlerFunc(ctx, types.MsgAliasRenew{Owner: simpleAddr("00002"), Alias: "supergirl"})
+	require.Equal(t, types.ErrNoSuchAlias().Result(), res)
+
+	EndBlocker(ctx.WithBlockTime(time.Unix(testBlockTime+2*period-1, 0)), *keeper)
+	addr, _ := keeper.GetAddressFromAlias(ctx, "supergirl")
+	require.Equal(t, alice, sdk.AccAddress(addr))
+
+	ctx = ctx.WithBlockTime(time.Unix(testBlockTime+2*period, 0))
+	res = handlerFunc(ctx, types.MsgAliasSell{Owner: alice, Alias: "supergirl", Price: 100})
+	require.Equal(t, types.ErrAliasExpired("supergirl").Result(), res)
+
+	EndBlocker(ctx, *keeper)
+	addr, _ = keeper.GetAddressFromAlias(ctx, "supergirl")
+	require.Nil(t, addr)
+	require.Equal(t, int64(0), keeper.GetExpireTime(ctx, "supergirl"))
+	require.Equal(t, []string{""}, keeper.GetAliasListOfAccount(ctx, alice))
+}
+
+func TestTransferAndSale(t *testing.T) {
+	ctx, keeper := newContextAndKeeper("test-1")
+	handlerFunc := NewHandler(*keeper)
+	tom := simpleAddr("00001")
+	bob := simpleAddr("00002")
+	alice := simpleAddr("00003")
+
+	handlerFunc(ctx, types.MsgAliasUpdate{Owner: alice, Alias: "supergirl", IsAdd: true, AsDefault: true})
+	expireTime := keeper.GetExpireTime(ctx, "supergirl")
+
+	res := handlerFunc(ctx, types.MsgAliasTransfer{Owner: bob, Alias: "supergirl", NewOwner: tom})
+	require.Equal(t, types.ErrNoSuchAlias().Result(), res)
+	res = handlerFunc(ctx, types.MsgAliasTransfer{Owner: alice, Alias: "supergirl", NewOwner: bob})
+	require.True(t, res.IsOK())
+	addr, asDefault := keeper.GetAddressFromAlias(ctx, "supergirl")
+	require.Equal(t, bob, sdk.AccAddress(addr))
+	require.False(t, asDefault)
+	require.Equal(t, expireTime, keeper.GetExpireTime(ctx, "supergirl"))
+	require.Equal(t, []string{""}, keeper.GetAliasListOfAccount(ctx, alice))
+
+	res = handlerFunc(ctx, types.MsgAliasSell{Owner: bob, Alias: "supergirl", Price: 5000})
+	require.True(t, res.IsOK())
+	require.Equal(t, []keepers.AliasSale{{Alias: "supergirl", Seller: bob, Price: 5000}}, keeper.GetAllAliasSales(ctx))
+
+	res = handlerFunc(ctx, types.MsgAliasBuy{Buyer: bob, Alias: "supergirl", Price: 5000})
+	require.Equal(t, types.ErrAliasNotForSale("supergirl").Result(), res)
+	res = handlerFunc(ctx, types.MsgAliasBuy{Buyer: tom, Alias: "supergirl", Price: 4000})
+	require.Equal(t, types.ErrAliasPriceMismatch(5000, 4000).Result(), res)
+	res = handlerFunc(ctx, types.MsgAliasCancelSale{Owner: tom, Alias: "supergirl"})
+	require.Equal(t, types.ErrAliasNotForSale("supergirl").Result(), res)
+
+	res = handlerFunc(ctx, types.MsgAliasBuy{Buyer: tom, Alias: "supergirl", Price: 5000})
+	require.True(t, res.IsOK())
+	require.Equal(t, "Send 5000"+dex.CET+" from "+tom.String()+" to "+bob.String(), logStr)
+	addr, _ = keeper.GetAddressFromAlias(ctx, "supergirl")
+	require.Equal(t, tom, sdk.AccAddress(addr))
+	require.Equal(t, 0, len(keeper.GetAllAliasSales(ctx)))
+
+	res = handlerFunc(ctx, types.MsgAliasSell{Owner: tom, Alias: "supergirl", Price: 5000})
+	require.True(t, res.IsOK())
+	res = handlerFunc(ctx, types.MsgAliasCancelSale{Owner: tom, Alias: "supergirl"})
+	require.True(t, res.IsOK())
+	res = handlerFunc(ctx, types.MsgAliasBuy{Buyer: bob, Alias: "supergirl", Price: 5000})
+	require.Equal(t, types.ErrAliasNotForSale("supergirl").Result(), res)
+
+	// reserved aliases can only be transferred to the CET owner
+	cetOwner := simpleAddr("00000")
+	handlerFunc(ctx, types.MsgAliasUpdate{Owner: cetOwner, Alias: "coinex", IsAdd: true})
+	res = handlerFunc(ctx, types.MsgAliasTransfer{Owner: cetOwner, Alias: "coinex", NewOwner: tom})
+	require.Equal(t, types.ErrCanOnlyBeUsedByCetOwner("coinex").Result(), res)
+
+	msg := types.MsgAliasSell{Owner: tom, Alias: "supergirl", Price: 0}
+	require.Equal(t, types.ErrInvalidAliasPrice(0), msg.ValidateBasic())
+	transfer := types.MsgAliasTransfer{Owner: tom, Alias: "supergirl", NewOwner: tom}
+	require.Error(t, transfer.ValidateBasic())
+}
+
+func TestGenesisWithSales(t *testing.T) {
+	alice := simpleAddr("00003")
+	bob := simpleAddr("00002")
+	entries := []keepers.AliasEntry{{Alias: "alice", Addr: alice, ExpireTime: testBlockTime + 100}}
+
+	gs := NewGenesisState(types.DefaultParams(), entries)
+	gs.AliasSales = []keepers.AliasSale{{Alias: "alice", Seller: alice, Price: 10}}
+	require.NoError(t, gs.Validate())
+
+	ctx, keeper := newContextAndKeeper("test-1")
+	InitGenesis(ctx, *keeper, gs)
+	require.Equal(t, gs, ExportGenesis(ctx, *keeper))
+
+	gs.AliasSales = []keepers.AliasSale{{Alias: "alice", Seller: bob, Price: 10}}
+	require.Error(t, gs.Validate())
+}
+
+func TestCheckAliasRefs(t *testing.T) {
+	ctx, keeper := newContextAndKeeper("test-1")
+	handlerFunc := NewHandler(*keeper)
+	bob := simpleAddr("00002")
+	alice := simpleAddr("00003")
+
+	handlerFunc(ctx, types.MsgAliasUpdate{Owner: alice, Alias: "supergirl", IsAdd: true})
+	require.Nil(t, keeper.CheckAliasRefs(ctx, []dex.AliasRef{{Alias: "supergirl", Addr: alice}}))
+	require.Equal(t, types.ErrAliasAddressMismatch("supergirl", bob),
+		keeper.CheckAliasRefs(ctx, []dex.AliasRef{{Alias: "supergirl", Addr: bob}}))
+	require.Equal(t, types.ErrAliasAddressMismatch("superman", bob),
+		keeper.CheckAliasRefs(ctx, []dex.AliasRef{{Alias: "superman", Addr: bob}}))
+
+	// the resolution made before a transfer is rejected after it
+	handlerFunc(ctx, types.MsgAliasTransfer{Owner: alice, Alias: "supergirl", NewOwner: bob})
+	require.NotNil(t, keeper.CheckAliasRefs(ctx, []dex.AliasRef{{Alias: "supergirl", Addr: alice}}))
+}
@@ -1,6 +1,8 @@
 package keepers
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
@@ -12,12 +14,23 @@ var (
 	AliasToAccountKey    = []byte{0x10}
 	AliasToAccountKeyEnd = []byte{0x11}
 	AccountToAliasKey    = []byte{0x12}
+	AliasExpireTimeKey   = []byte{0x13}
+	AliasExpireQueueKey  = []byte{0x14}
+	AliasSaleKey         = []byte{0x15}
 )
 
 type AliasEntry struct {
-	Alias     string         `json:"alias"`
-	Addr      sdk.AccAddress `json:"addr"`
-	AsDefault bool           `json:"is_default"`
+	Alias      string         `json:"alias"`
+	Addr       sdk.AccAddress `json:"addr"`
+	AsDefault  bool           `json:"is_default"`
+	ExpireTime int64          `json:"expire_time,omitempty"`
+}
+
+// AliasSale is a fixed-price listing of an alias, which any other account can buy
+type AliasSale struct {
+	Alias  string         `json:"alias"`
+	Seller sdk.AccAddress `json:"seller"`
+	Price  int64          `json:"price"`
 }
 
 type AliasKeeper struct {
@@ -72,10 +85,12 @@ func (keeper *AliasKeeper) GetAllAlias(ctx sdk.Context) []AliasEntry {
 	defer iter.Close()
 	res := make([]AliasEntry, 0, 1000)
 	for ; iter.Valid(); iter.Next() {
+		alias := string(iter.Key()[1:])
 		res = append(res, AliasEntry{
-			Alias:     string(iter.Key()[1:]),
-			Addr:      iter.Value()[1:],
-			AsDefault: iter.Value()[0] != 0,
+			Alias:      alias,
+			Addr:       iter.Value()[1:],
+			AsDefault:  iter.Value()[0] != 0,
+			ExpireTime: keeper.GetExpireTime(ctx, alias),
 		})
 	}
 	return res
@@ -123,32 +138,121 @@ func (keeper *AliasKeeper) RemoveAlias(ctx sdk.Context, alias string, addr sdk.A
 	store.Delete(key)
 }
 
+func getExpireQueueKey(expireTime int64, alias string) []byte {
+	return dex.ConcatKeys(AliasExpireQueueKey, sdk.Uint64ToBigEndian(uint64(expireTime)), []byte(alias))
+}
+
+// GetExpireTime returns the unix time when the alias expires, 0 means it never expires
+func (keeper *AliasKeeper) GetExpireTime(ctx sdk.Context, alias string) int64 {
+	store := ctx.KVStore(keeper.aliasKey)
+	bz := store.Get(append(AliasExpireTimeKey, []byte(alias)...))
+	if len(bz) == 0 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// SetExpireTime sets the expire time of an alias and moves it to the right place in the expire queue
+func (keeper *AliasKeeper) SetExpireTime(ctx sdk.Context, alias string, expireTime int64) {
+	keeper.DeleteExpireTime(ctx, alias)
+	store := ctx.KVStore(keeper.aliasKey)
+	store.Set(append(AliasExpireTimeKey, []byte(alias)...), sdk.Uint64ToBigEndian(uint64(expireTime)))
+	store.Set(getExpireQueueKey(expireTime, alias), []byte(alias))
+}
+
+func (keeper *AliasKeeper) DeleteExpireTime(ctx sdk.Context, alias string) {
+	oldTime := keeper.GetExpireTime(ctx, alias)
+	if oldTime == 0 {
+		return
+	}
+	store := ctx.KVStore(keeper.aliasKey)
+	store.Delete(append(AliasExpireTimeKey, []byte(alias)...))
+	store.Delete(getExpireQueueKey(oldTime, alias))
+}
+
+// GetExpiredAliases returns the aliases whose expire time is not later than currTime
+func (keeper *AliasKeeper) GetExpiredAliases(ctx sdk.Context, currTime int64) []string {
+	store := ctx.KVStore(keeper.aliasKey)
+	iter := store.Iterator(AliasExpireQueueKey, getExpireQueueKey(currTime+1, ""))
+	defer iter.Close()
+	var res []string
+	for ; iter.Valid(); iter.Next() {
+		res = append(res, string(iter.Value()))
+	}
+	return res
+}
+
+func (keeper *AliasKeeper) GetAliasSale(ctx sdk.Context, alias string) (AliasSale, bool) {
+	var sale AliasSale
+	store := ctx.KVStore(keeper.aliasKey)
+	bz := store.Get(append(AliasSaleKey, []byte(alias)...))
+	if len(bz) == 0 {
+		return sale, false
+	}
+	types.ModuleCdc.MustUnmarshalBinaryBare(bz, &sale)
+	return sale, true
+}
+
+func (keeper *AliasKeeper) SetAliasSale(ctx sdk.Context, sale AliasSale) {
+	store := ctx.KVStore(keeper.aliasKey)
+	store.Set(append(AliasSaleKey, []byte(sale.Alias)...), types.ModuleCdc.MustMarshalBinaryBare(sale))
+}
+
+func (keeper *AliasKeeper) DeleteAliasSale(ctx sdk.Context, alias string) {
+	store := ctx.KVStore(keeper.aliasKey)
+	store.Delete(append(AliasSaleKey, []byte(alias)...))
+}
+
+func (keeper *AliasKeeper) GetAllAliasSales(ctx sdk.Context) []AliasSale {
+	store := ctx.KVStore(keeper.aliasKey)
+	iter := sdk.KVStorePrefixIterator(store, AliasSaleKey)
+	defer iter.Close()
+	res := make([]AliasSale, 0, 100)
+	for ; iter.Valid(); iter.Next() {
+		var sale AliasSale
+		types.ModuleCdc.MustUnmarshalBinaryBare(iter.Value(), &sale)
+		res = append(res, sale)
+	}
+	return res
+}
+
 //============================================================================
 
 type Keeper struct {
-	paramSubspace params.Subspace
-	aliasKeeper   *AliasKeeper
-	bankKeeper    types.ExpectedBankxKeeper
-	assetKeeper   types.ExpectedAssetStatusKeeper
+	paramSubspace     params.Subspace
+	aliasKeeper       *AliasKeeper
+	bankKeeper        types.ExpectedBankxKeeper
+	assetKeeper       types.ExpectedAssetStatusKeeper
+	eventTypeMsgQueue string
 }
 
 func NewKeeper(key sdk.StoreKey,
 	bankKeeper types.ExpectedBankxKeeper,
 	assetKeeper types.ExpectedAssetStatusKeeper,
-	paramstore params.Subspace) Keeper {
+	paramstore params.Subspace,
+	eventTypeMsgQueue string) Keeper {
 
 	return Keeper{
-		paramSubspace: paramstore.WithKeyTable(types.ParamKeyTable()),
-		aliasKeeper:   NewAliasKeeper(key),
-		bankKeeper:    bankKeeper,
-		assetKeeper:   assetKeeper,
+		paramSubspace:     paramstore.WithKeyTable(types.ParamKeyTable()),
+		aliasKeeper:       NewAliasKeeper(key),
+		bankKeeper:        bankKeeper,
+		assetKeeper:       assetKeeper,
+		eventTypeMsgQueue: eventTypeMsgQueue,
 	}
 }
 
+func (k *Keeper) GetEventTypeMsgQueue() string {
+	return k.eventTypeMsgQueue
+}
+
 func (k *Keeper) DeductInt64CetFee(ctx sdk.Context, addr sdk.AccAddress, amt int64) sdk.Error {
 	return k.bankKeeper.DeductInt64CetFee(ctx, addr, amt)
 }
 
+func (k *Keeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	return k.bankKeeper.SendCoins(ctx, from, to, amt)
+}
+
 func (k *Keeper) IsTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
 	return k.assetKeeper.IsTokenIssuer(ctx, denom, addr)
 }
@@ -157,6 +261,17 @@ func (k *Keeper) GetAddressFromAlias(ctx sdk.Context, alias string) ([]byte, boo
 	return k.aliasKeeper.GetAddressFromAlias(ctx, alias)
 }
 
+// CheckAliasRefs makes sure each alias written in a msg is still bound to the address it was resolved to
+func (k *Keeper) CheckAliasRefs(ctx sdk.Context, refs []dex.AliasRef) sdk.Error {
+	for _, ref := range refs {
+		addr, _ := k.GetAddressFromAlias(ctx, ref.Alias)
+		if len(addr) == 0 || !ref.Addr.Equals(sdk.AccAddress(addr)) {
+			return types.ErrAliasAddressMismatch(ref.Alias, ref.Addr)
+		}
+	}
+	return nil
+}
+
 func (k *Keeper) GetAliasListOfAccount(ctx sdk.Context, addr sdk.AccAddress) []string {
 	return k.aliasKeeper.GetAliasListOfAccount(ctx, addr)
 }
@@ -169,8 +284,47 @@ func (k *Keeper) AddAlias(ctx sdk.Context, alias string, addr sdk.AccAddress, as
 	return k.aliasKeeper.AddAlias(ctx, alias, addr, asDefault, maxCount)
 }
 
+// RemoveAlias releases the alias, together with its expire time and sale listing
 func (k *Keeper) RemoveAlias(ctx sdk.Context, alias string, addr sdk.AccAddress) {
 	k.aliasKeeper.RemoveAlias(ctx, alias, addr)
+	k.aliasKeeper.DeleteExpireTime(ctx, alias)
+	k.aliasKeeper.DeleteAliasSale(ctx, alias)
+}
+
+// TransferAlias binds the alias to a new owner as a non-default alias, keeping its expire time.
+// The sale listing, if any, is cancelled.
+func (k *Keeper) TransferAlias(ctx sdk.Context, alias string, from, to sdk.AccAddress) {
+	k.aliasKeeper.RemoveAlias(ctx, alias, from)
+	k.aliasKeeper.setAlias(ctx, alias, to, false)
+	k.aliasKeeper.DeleteAliasSale(ctx, alias)
+}
+
+func (k *Keeper) GetExpireTime(ctx sdk.Context, alias string) int64 {
+	return k.aliasKeeper.GetExpireTime(ctx, alias)
+}
+
+func (k *Keeper) SetExpireTime(ctx sdk.Context, alias string, expireTime int64) {
+	k.aliasKeeper.SetExpireTime(ctx, alias, expireTime)
+}
+
+func (k *Keeper) GetExpiredAliases(ctx sdk.Context, currTime int64) []string {
+	return k.aliasKeeper.GetExpiredAliases(ctx, currTime)
+}
+
+func (k *Keeper) GetAliasSale(ctx sdk.Context, alias string) (AliasSale, bool) {
+	return k.aliasKeeper.GetAliasSale(ctx, alias)
+}
+
+func (k *Keeper) SetAliasSale(ctx sdk.Context, sale AliasSale) {
+	k.aliasKeeper.SetAliasSale(ctx, sale)
+}
+
+func (k *Keeper) DeleteAliasSale(ctx sdk.Context, alias string) {
+	k.aliasKeeper.DeleteAliasSale(ctx, alias)
+}
+
+func (k *Keeper) GetAllAliasSales(ctx sdk.Context) []AliasSale {
+	return k.aliasKeeper.GetAllAliasSales(ctx)
 }
 
 // -----------------------------------------------------------------------------
@@ -12,6 +12,7 @@ import (
 const (
 	QueryAliasInfo  = "alias-info"
 	QueryParameters = "parameters"
+	QueryAliasSales = "alias-sales"
 )
 
 // creates a querier for asset REST endpoints
@@ -22,6 +23,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAliasInfo(ctx, req, keeper)
 		case QueryParameters:
 			return queryParameters(ctx, keeper)
+		case QueryAliasSales:
+			return queryAliasSales(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("query symbol : " + path[0])
 		}
@@ -75,3 +78,11 @@ func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 
 	return res, nil
 }
+
+func queryAliasSales(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetAllAliasSales(ctx))
+	if err != nil {
+		return nil, sdk.NewError(types.CodeSpaceAlias, types.CodeMarshalFailed, "could not marshal result to JSON")
+	}
+	return res, nil
+}
@@ -12,4 +12,9 @@ func init() {
 
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAliasUpdate{}, "alias/MsgAliasUpdate", nil)
+	cdc.RegisterConcrete(MsgAliasRenew{}, "alias/MsgAliasRenew", nil)
+	cdc.RegisterConcrete(MsgAliasTransfer{}, "alias/MsgAliasTransfer", nil)
+	cdc.RegisterConcrete(MsgAliasSell{}, "alias/MsgAliasSell", nil)
+	cdc.RegisterConcrete(MsgAliasCancelSale{}, "alias/MsgAliasCancelSale", nil)
+	cdc.RegisterConcrete(MsgAliasBuy{}, "alias/MsgAliasBuy", nil)
 }
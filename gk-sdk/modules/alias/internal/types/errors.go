@@ -19,6 +19,11 @@ const (
 	CodeNoSuchAlias             sdk.CodeType = 1107
 	CodeCanOnlyBeUsedByCetOwner sdk.CodeType = 1108
 	CodeMaxAliasCountReached    sdk.CodeType = 1109
+	CodeAliasNotForSale         sdk.CodeType = 1110
+	CodeAliasPriceMismatch      sdk.CodeType = 1111
+	CodeInvalidAliasPrice       sdk.CodeType = 1112
+	CodeAliasExpired            sdk.CodeType = 1113
+	CodeAliasAddressMismatch    sdk.CodeType = 1114
 )
 
 func ErrEmptyAlias() sdk.Error {
@@ -44,3 +49,23 @@ func ErrCanOnlyBeUsedByCetOwner(a string) sdk.Error {
 func ErrMaxAliasCountReached() sdk.Error {
 	return sdk.NewError(CodeSpaceAlias, CodeMaxAliasCountReached, "Have reached the maximum alias count and can not add new aliases")
 }
+
+func ErrAliasNotForSale(a string) sdk.Error {
+	return sdk.NewError(CodeSpaceAlias, CodeAliasNotForSale, fmt.Sprintf("The alias '%s' is not for sale", a))
+}
+
+func ErrAliasPriceMismatch(expected, actual int64) sdk.Error {
+	return sdk.NewError(CodeSpaceAlias, CodeAliasPriceMismatch, fmt.Sprintf("The sale price is %d, but %d is offered", expected, actual))
+}
+
+func ErrInvalidAliasPrice(price int64) sdk.Error {
+	return sdk.NewError(CodeSpaceAlias, CodeInvalidAliasPrice, fmt.Sprintf("Invalid price: %d", price))
+}
+
+func ErrAliasExpired(a string) sdk.Error {
+	return sdk.NewError(CodeSpaceAlias, CodeAliasExpired, fmt.Sprintf("The alias '%s' has expired", a))
+}
+
+func ErrAliasAddressMismatch(a string, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(CodeSpaceAlias, CodeAliasAddressMismatch, fmt.Sprintf("The alias '%s' is not bound to %s", a, addr))
+}
@@ -1,11 +1,22 @@
 package types
 
 const (
-	EventTypeAddAlias    = "add_alias"
-	EventTypeRemoveAlias = "remove_alias"
+	EventTypeAddAlias      = "add_alias"
+	EventTypeRemoveAlias   = "remove_alias"
+	EventTypeRenewAlias    = "renew_alias"
+	EventTypeTransferAlias = "transfer_alias"
+	EventTypeSellAlias     = "sell_alias"
+	EventTypeCancelSale    = "cancel_alias_sale"
+	EventTypeBuyAlias      = "buy_alias"
 
 	AttributeValueCategory = "alias"
 
-	AttributeKeyAlias     = "alias"
-	AttributeKeyAsDefault = "as_default"
+	AttributeKeyAlias      = "alias"
+	AttributeKeyAsDefault  = "as_default"
+	AttributeKeyExpireTime = "expire_time"
+	AttributeKeyNewOwner   = "new_owner"
+	AttributeKeyPrice      = "price"
+
+	// Kafka keys
+	AliasExpiredKey = "alias_expired"
 )
@@ -7,6 +7,7 @@ import (
 // Bankx Keeper will implement the interface
 type ExpectedBankxKeeper interface {
 	DeductInt64CetFee(ctx sdk.Context, addr sdk.AccAddress, amt int64) sdk.Error
+	SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error
 }
 
 // Asset Keeper will implement the interface
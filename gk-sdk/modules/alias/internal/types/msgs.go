@@ -44,3 +44,189 @@ func (msg MsgAliasUpdate) GetSignBytes() []byte {
 func (msg MsgAliasUpdate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
+
+// --------------------------------------------------------
+
+var _ sdk.Msg = MsgAliasRenew{}
+
+// MsgAliasRenew extends the registration of an alias by another RegistrationPeriod
+type MsgAliasRenew struct {
+	Owner sdk.AccAddress `json:"owner"`
+	Alias string         `json:"alias"`
+}
+
+func (msg *MsgAliasRenew) SetAccAddress(addr sdk.AccAddress) {
+	msg.Owner = addr
+}
+
+func (msg MsgAliasRenew) Route() string { return RouterKey }
+
+func (msg MsgAliasRenew) Type() string { return "alias_renew" }
+
+func (msg MsgAliasRenew) ValidateBasic() sdk.Error {
+	return validateOwnerAndAlias(msg.Owner, msg.Alias)
+}
+
+func (msg MsgAliasRenew) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAliasRenew) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// --------------------------------------------------------
+
+var _ sdk.Msg = MsgAliasTransfer{}
+
+type MsgAliasTransfer struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	Alias    string         `json:"alias"`
+	NewOwner sdk.AccAddress `json:"new_owner"`
+}
+
+func (msg *MsgAliasTransfer) SetAccAddress(addr sdk.AccAddress) {
+	msg.Owner = addr
+}
+
+func (msg MsgAliasTransfer) Route() string { return RouterKey }
+
+func (msg MsgAliasTransfer) Type() string { return "alias_transfer" }
+
+func (msg MsgAliasTransfer) ValidateBasic() sdk.Error {
+	if err := validateOwnerAndAlias(msg.Owner, msg.Alias); err != nil {
+		return err
+	}
+	if len(msg.NewOwner) == 0 {
+		return sdk.ErrInvalidAddress("missing new owner address")
+	}
+	if msg.NewOwner.Equals(msg.Owner) {
+		return sdk.ErrInvalidAddress("new owner is the same as the current owner")
+	}
+	return nil
+}
+
+func (msg MsgAliasTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAliasTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// --------------------------------------------------------
+
+var _ sdk.Msg = MsgAliasSell{}
+
+// MsgAliasSell lists an alias for sale at a fixed price in CET, a new listing replaces the old one
+type MsgAliasSell struct {
+	Owner sdk.AccAddress `json:"owner"`
+	Alias string         `json:"alias"`
+	Price int64          `json:"price"`
+}
+
+func (msg *MsgAliasSell) SetAccAddress(addr sdk.AccAddress) {
+	msg.Owner = addr
+}
+
+func (msg MsgAliasSell) Route() string { return RouterKey }
+
+func (msg MsgAliasSell) Type() string { return "alias_sell" }
+
+func (msg MsgAliasSell) ValidateBasic() sdk.Error {
+	if err := validateOwnerAndAlias(msg.Owner, msg.Alias); err != nil {
+		return err
+	}
+	if msg.Price <= 0 {
+		return ErrInvalidAliasPrice(msg.Price)
+	}
+	return nil
+}
+
+func (msg MsgAliasSell) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAliasSell) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// --------------------------------------------------------
+
+var _ sdk.Msg = MsgAliasCancelSale{}
+
+type MsgAliasCancelSale struct {
+	Owner sdk.AccAddress `json:"owner"`
+	Alias string         `json:"alias"`
+}
+
+func (msg *MsgAliasCancelSale) SetAccAddress(addr sdk.AccAddress) {
+	msg.Owner = addr
+}
+
+func (msg MsgAliasCancelSale) Route() string { return RouterKey }
+
+func (msg MsgAliasCancelSale) Type() string { return "alias_cancel_sale" }
+
+func (msg MsgAliasCancelSale) ValidateBasic() sdk.Error {
+	return validateOwnerAndAlias(msg.Owner, msg.Alias)
+}
+
+func (msg MsgAliasCancelSale) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAliasCancelSale) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// --------------------------------------------------------
+
+var _ sdk.Msg = MsgAliasBuy{}
+
+// MsgAliasBuy buys a listed alias. Price must equal the listed price,
+// so that the buyer never pays more than expected if the seller relists it.
+type MsgAliasBuy struct {
+	Buyer sdk.AccAddress `json:"buyer"`
+	Alias string         `json:"alias"`
+	Price int64          `json:"price"`
+}
+
+func (msg *MsgAliasBuy) SetAccAddress(addr sdk.AccAddress) {
+	msg.Buyer = addr
+}
+
+func (msg MsgAliasBuy) Route() string { return RouterKey }
+
+func (msg MsgAliasBuy) Type() string { return "alias_buy" }
+
+func (msg MsgAliasBuy) ValidateBasic() sdk.Error {
+	if err := validateOwnerAndAlias(msg.Buyer, msg.Alias); err != nil {
+		return err
+	}
+	if msg.Price <= 0 {
+		return ErrInvalidAliasPrice(msg.Price)
+	}
+	return nil
+}
+
+func (msg MsgAliasBuy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAliasBuy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Buyer}
+}
+
+func validateOwnerAndAlias(owner sdk.AccAddress, alias string) sdk.Error {
+	if len(owner) == 0 {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if len(alias) == 0 {
+		return ErrEmptyAlias()
+	}
+	if !IsValidAlias(alias) {
+		return ErrInvalidAlias()
+	}
+	return nil
+}
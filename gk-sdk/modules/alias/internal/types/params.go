@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -15,6 +16,7 @@ const (
 	DefaultFeeForAliasLength6         = 100e8
 	DefaultFeeForAliasLength7OrHigher = 10e8
 	DefaultMaxAliasCount              = 5
+	DefaultRegistrationPeriod         = 365 * 24 * 60 * 60 // one year, in seconds
 )
 
 var (
@@ -25,6 +27,7 @@ var (
 	KeyFeeForAliasLength6         = []byte("FeeForAliasLength6")
 	KeyFeeForAliasLength7OrHigher = []byte("FeeForAliasLength7OrHigher")
 	KeyMaxAliasCount              = []byte("MaxAliasCount")
+	KeyRegistrationPeriod         = []byte("RegistrationPeriod")
 )
 
 type Params struct {
@@ -35,6 +38,7 @@ type Params struct {
 	FeeForAliasLength6         int64 `json:"fee_for_alias_length_6"`
 	FeeForAliasLength7OrHigher int64 `json:"fee_for_alias_length_7_or_higher"`
 	MaxAliasCount              int   `json:"max_alias_count"`
+	RegistrationPeriod         int64 `json:"registration_period"` // in seconds, also the length of a renewal
 }
 
 // ParamKeyTable for alias module
@@ -52,6 +56,7 @@ func DefaultParams() Params {
 		DefaultFeeForAliasLength6,
 		DefaultFeeForAliasLength7OrHigher,
 		DefaultMaxAliasCount,
+		DefaultRegistrationPeriod,
 	}
 }
 
@@ -67,6 +72,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyFeeForAliasLength6, Value: &p.FeeForAliasLength6},
 		{Key: KeyFeeForAliasLength7OrHigher, Value: &p.FeeForAliasLength7OrHigher},
 		{Key: KeyMaxAliasCount, Value: &p.MaxAliasCount},
+		{Key: KeyRegistrationPeriod, Value: &p.RegistrationPeriod},
 	}
 }
 
@@ -95,9 +101,19 @@ func (p *Params) ValidateGenesis() error {
 	if p.MaxAliasCount <= 0 {
 		return fmt.Errorf("%s must be a positive number, is %d", KeyMaxAliasCount, p.MaxAliasCount)
 	}
+	if p.RegistrationPeriod <= 0 {
+		return fmt.Errorf("%s must be a positive number, is %d", KeyRegistrationPeriod, p.RegistrationPeriod)
+	}
 	return nil
 }
 
+// ValidateParams validates the alias params in the subspace
+func ValidateParams(ctx sdk.Context, ss params.Subspace) error {
+	var p Params
+	ss.GetParamSet(ctx, &p)
+	return p.ValidateGenesis()
+}
+
 func (p *Params) GetFeeForAlias(alias string) int64 {
 	if n := len(alias); n == 2 {
 		return p.FeeForAliasLength2
@@ -129,12 +145,14 @@ func (p Params) String() string {
   FeeForAliasLength5:         %d
   FeeForAliasLength6:         %d
   FeeForAliasLength7OrHigher: %d
-  MaxAliasCount:              %d`,
+  MaxAliasCount:              %d
+  RegistrationPeriod:         %d`,
 		p.FeeForAliasLength2,
 		p.FeeForAliasLength3,
 		p.FeeForAliasLength4,
 		p.FeeForAliasLength5,
 		p.FeeForAliasLength6,
 		p.FeeForAliasLength7OrHigher,
-		p.MaxAliasCount)
+		p.MaxAliasCount,
+		p.RegistrationPeriod)
 }
@@ -24,6 +24,10 @@ const (
 	DefaultIssue4CharTokenFee = types.DefaultIssue4CharTokenFee
 	DefaultIssue5CharTokenFee = types.DefaultIssue5CharTokenFee
 	DefaultIssue6CharTokenFee = types.DefaultIssue6CharTokenFee
+	EventTypeMintToken        = types.EventTypeMintToken
+	EventTypeBurnToken        = types.EventTypeBurnToken
+	AttributeKeySymbol        = types.AttributeKeySymbol
+	AttributeKeyAmount        = types.AttributeKeyAmount
 )
 
 var (
@@ -51,7 +55,8 @@ var (
 	TestIdentityString         = types.TestIdentityString
 	ValidateTokenSymbol        = types.ValidateTokenSymbol
 
-	DefaultParams = types.DefaultParams
+	DefaultParams  = types.DefaultParams
+	ValidateParams = types.ValidateParams
 
 	// variable aliases
 
@@ -8,7 +8,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	aliascli "github.com/coinexchain/cet-sdk/modules/alias/client/cli"
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func checkFlags(flags []string, help string) error {
@@ -133,12 +135,17 @@ func parseAddWhitelistFlags(owner sdk.AccAddress) (*types.MsgAddTokenWhitelist,
 		return nil, err
 	}
 
+	var aliasRefs []dex.AliasRef
 	str := strings.Split(viper.GetString(flagWhitelist), ",")
 	for _, s := range str {
-		if addr, err = sdk.AccAddressFromBech32(s); err != nil {
+		var alias string
+		if addr, alias, err = aliascli.ResolveAddress(s); err != nil {
 			return nil, err
 		}
 		whitelist = append(whitelist, addr)
+		if len(alias) != 0 {
+			aliasRefs = append(aliasRefs, dex.AliasRef{Alias: alias, Addr: addr})
+		}
 	}
 
 	msg := types.NewMsgAddTokenWhitelist(
@@ -146,6 +153,7 @@ func parseAddWhitelistFlags(owner sdk.AccAddress) (*types.MsgAddTokenWhitelist,
 		owner,
 		whitelist,
 	)
+	msg.AliasRefs = aliasRefs
 
 	return &msg, nil
 }
@@ -159,12 +167,17 @@ func parseRemoveWhitelistFlags(owner sdk.AccAddress) (*types.MsgRemoveTokenWhite
 		return nil, err
 	}
 
+	var aliasRefs []dex.AliasRef
 	str := strings.Split(viper.GetString(flagWhitelist), ",")
 	for _, s := range str {
-		if addr, err = sdk.AccAddressFromBech32(s); err != nil {
+		var alias string
+		if addr, alias, err = aliascli.ResolveAddress(s); err != nil {
 			return nil, err
 		}
 		whitelist = append(whitelist, addr)
+		if len(alias) != 0 {
+			aliasRefs = append(aliasRefs, dex.AliasRef{Alias: alias, Addr: addr})
+		}
 	}
 
 	msg := types.NewMsgRemoveTokenWhitelist(
@@ -172,6 +185,7 @@ func parseRemoveWhitelistFlags(owner sdk.AccAddress) (*types.MsgRemoveTokenWhite
 		owner,
 		whitelist,
 	)
+	msg.AliasRefs = aliasRefs
 
 	return &msg, nil
 }
@@ -289,7 +289,7 @@ func GetCmdAddTokenWhitelist(cdc *codec.Codec) *cobra.Command {
 		Short: "Create and sign a add-whitelist tx",
 		Long: strings.TrimSpace(
 			`Create and sign a add-whitelist tx, broadcast to nodes.
-				Multiple addresses separated by commas.
+				Multiple addresses separated by commas, an address can also be written as @alias.
 
 Example:
 $ cetcli tx asset add-whitelist --symbol="abc" \
@@ -323,7 +323,7 @@ func GetCmdRemoveTokenWhitelist(cdc *codec.Codec) *cobra.Command {
 		Short: "Create and sign a remove-whitelist tx",
 		Long: strings.TrimSpace(
 			`Create and sign a remove-whitelist tx, broadcast to nodes.
-				Multiple addresses separated by commas.
+				Multiple addresses separated by commas, an address can also be written as @alias.
 
 Example:
 $ cetcli tx asset remove-whitelist --symbol="abc" \
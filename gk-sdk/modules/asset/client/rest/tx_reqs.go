@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
 	"github.com/coinexchain/cosmos-utils/client/restutil"
 )
 
@@ -58,10 +59,12 @@ type (
 	addWhiteListReq struct {
 		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
 		Whitelist []sdk.AccAddress `json:"whitelist" yaml:"whitelist"`
+		AliasRefs []dex.AliasRef   `json:"alias_refs,omitempty" yaml:"alias_refs,omitempty"`
 	}
 	removeWhiteListReq struct {
 		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
 		Whitelist []sdk.AccAddress `json:"whitelist" yaml:"whitelist"`
+		AliasRefs []dex.AliasRef   `json:"alias_refs,omitempty" yaml:"alias_refs,omitempty"`
 	}
 	forbidAddrReq struct {
 		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
@@ -177,7 +180,9 @@ func (req *addWhiteListReq) GetBaseReq() *rest.BaseReq {
 }
 func (req *addWhiteListReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
 	symbol := getSymbol(r)
-	return types.NewMsgAddTokenWhitelist(symbol, owner, req.Whitelist), nil
+	msg := types.NewMsgAddTokenWhitelist(symbol, owner, req.Whitelist)
+	msg.AliasRefs = req.AliasRefs
+	return msg, nil
 }
 
 func (req *removeWhiteListReq) New() restutil.RestReq {
@@ -188,7 +193,9 @@ func (req *removeWhiteListReq) GetBaseReq() *rest.BaseReq {
 }
 func (req *removeWhiteListReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
 	symbol := getSymbol(r)
-	return types.NewMsgRemoveTokenWhitelist(symbol, owner, req.Whitelist), nil
+	msg := types.NewMsgRemoveTokenWhitelist(symbol, owner, req.Whitelist)
+	msg.AliasRefs = req.AliasRefs
+	return msg, nil
 }
 
 func (req *forbidAddrReq) New() restutil.RestReq {
@@ -225,6 +225,7 @@ func handleMsgAddTokenWhitelist(ctx sdk.Context, keeper Keeper, msg types.MsgAdd
 			sdk.NewAttribute(types.AttributeKeyAddrList, str),
 		),
 	})
+	ctx.EventManager().EmitEvents(dex.NewResolveAliasEvents(msg.GetAliasRefs()))
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
@@ -252,6 +253,7 @@ func handleMsgRemoveTokenWhitelist(ctx sdk.Context, keeper Keeper, msg types.Msg
 			sdk.NewAttribute(types.AttributeKeyAddrList, str),
 		),
 	})
+	ctx.EventManager().EmitEvents(dex.NewResolveAliasEvents(msg.GetAliasRefs()))
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
@@ -435,6 +435,11 @@ func (keeper BaseKeeper) GetAccTotalToken(ctx sdk.Context, addr sdk.AccAddress)
 	return keeper.bkx.GetTotalCoins(ctx, addr)
 }
 
+// GetSupply - the total supply of all the coins, as recorded by the supply module
+func (keeper BaseKeeper) GetSupply(ctx sdk.Context) sdk.Coins {
+	return keeper.sk.GetSupply(ctx).GetTotal()
+}
+
 func (keeper BaseKeeper) checkPrecondition(ctx sdk.Context, symbol string, owner sdk.AccAddress) (types.Token, sdk.Error) {
 	token := keeper.GetToken(ctx, symbol)
 	if token == nil {
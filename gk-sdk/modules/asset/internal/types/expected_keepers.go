@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
 
 // Bankx Keeper will implement the interface
@@ -21,4 +22,5 @@ type ExpectedSupplyKeeper interface {
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+	GetSupply(ctx sdk.Context) exported.SupplyI
 }
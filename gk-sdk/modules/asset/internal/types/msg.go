@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 // ensure Msg interface compliance at compile time
@@ -20,6 +22,9 @@ var (
 	_ sdk.Msg = &MsgForbidAddr{}
 	_ sdk.Msg = &MsgUnForbidAddr{}
 	_ sdk.Msg = &MsgModifyTokenInfo{}
+
+	_ dex.AliasedMsg = MsgAddTokenWhitelist{}
+	_ dex.AliasedMsg = MsgRemoveTokenWhitelist{}
 )
 
 // MsgIssueToken
@@ -358,13 +363,15 @@ type MsgAddTokenWhitelist struct {
 	Symbol       string           `json:"symbol" yaml:"symbol"`
 	OwnerAddress sdk.AccAddress   `json:"owner_address" yaml:"owner_address"`
 	Whitelist    []sdk.AccAddress `json:"whitelist" yaml:"whitelist"`
+	// the whitelist addresses which were written as aliases
+	AliasRefs []dex.AliasRef `json:"alias_refs,omitempty" yaml:"alias_refs,omitempty"`
 }
 
 func NewMsgAddTokenWhitelist(symbol string, owner sdk.AccAddress, whitelist []sdk.AccAddress) MsgAddTokenWhitelist {
 	return MsgAddTokenWhitelist{
-		symbol,
-		owner,
-		whitelist,
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Whitelist:    whitelist,
 	}
 }
 
@@ -394,6 +401,9 @@ func (msg MsgAddTokenWhitelist) ValidateBasic() sdk.Error {
 		return ErrNilTokenWhitelist()
 	}
 
+	if err := validateAliasRefs(msg.Whitelist, msg.AliasRefs); err != nil {
+		return err
+	}
 	for _, addr := range msg.Whitelist {
 		if !addr.Empty() {
 			return nil
@@ -412,18 +422,24 @@ func (msg MsgAddTokenWhitelist) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.OwnerAddress}
 }
 
+func (msg MsgAddTokenWhitelist) GetAliasRefs() []dex.AliasRef {
+	return msg.AliasRefs
+}
+
 // MsgRemoveWhitelist
 type MsgRemoveTokenWhitelist struct {
 	Symbol       string           `json:"symbol" yaml:"symbol"`
 	OwnerAddress sdk.AccAddress   `json:"owner_address" yaml:"owner_address"`
 	Whitelist    []sdk.AccAddress `json:"whitelist" yaml:"whitelist"`
+	// the whitelist addresses which were written as aliases
+	AliasRefs []dex.AliasRef `json:"alias_refs,omitempty" yaml:"alias_refs,omitempty"`
 }
 
 func NewMsgRemoveTokenWhitelist(symbol string, owner sdk.AccAddress, whitelist []sdk.AccAddress) MsgRemoveTokenWhitelist {
 	return MsgRemoveTokenWhitelist{
-		symbol,
-		owner,
-		whitelist,
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Whitelist:    whitelist,
 	}
 }
 
@@ -452,6 +468,9 @@ func (msg MsgRemoveTokenWhitelist) ValidateBasic() sdk.Error {
 	if len(msg.Whitelist) == 0 {
 		return ErrNilTokenWhitelist()
 	}
+	if err := validateAliasRefs(msg.Whitelist, msg.AliasRefs); err != nil {
+		return err
+	}
 	for _, addr := range msg.Whitelist {
 		if !addr.Empty() {
 			return nil
@@ -470,6 +489,10 @@ func (msg MsgRemoveTokenWhitelist) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.OwnerAddress}
 }
 
+func (msg MsgRemoveTokenWhitelist) GetAliasRefs() []dex.AliasRef {
+	return msg.AliasRefs
+}
+
 // MsgForbidAddr
 type MsgForbidAddr struct {
 	Symbol    string           `json:"symbol" yaml:"symbol"`
@@ -709,3 +732,20 @@ func (msg MsgModifyTokenInfo) GetSignBytes() []byte {
 func (msg MsgModifyTokenInfo) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.OwnerAddress}
 }
+
+// validateAliasRefs makes sure every aliased address is one of addrs
+func validateAliasRefs(addrs []sdk.AccAddress, refs []dex.AliasRef) sdk.Error {
+	for _, ref := range refs {
+		found := false
+		for _, addr := range addrs {
+			if addr.Equals(ref.Addr) {
+				found = true
+				break
+			}
+		}
+		if len(ref.Alias) == 0 || !found {
+			return sdk.ErrInvalidAddress("invalid alias reference: " + ref.Alias)
+		}
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func TestMsgIssueToken_ValidateBasic(t *testing.T) {
@@ -287,6 +289,7 @@ func TestMsgUnForbidToken_ValidateBasic(t *testing.T) {
 
 func TestMsgAddTokenWhitelist_ValidateBasic(t *testing.T) {
 	whitelist := mockAddrList()
+	aliceAddr := sdk.AccAddress([]byte("alice_address_bytes0"))
 	tests := []struct {
 		name string
 		msg  MsgAddTokenWhitelist
@@ -317,6 +320,18 @@ func TestMsgAddTokenWhitelist_ValidateBasic(t *testing.T) {
 			NewMsgAddTokenWhitelist("abc", testAddr, []sdk.AccAddress{nilAddr, nilAddr}),
 			ErrNilTokenWhitelist(),
 		},
+		{
+			"case-aliasRef",
+			MsgAddTokenWhitelist{Symbol: "abc", OwnerAddress: testAddr, Whitelist: []sdk.AccAddress{aliceAddr},
+				AliasRefs: []dex.AliasRef{{Alias: "alice", Addr: aliceAddr}}},
+			nil,
+		},
+		{
+			"case-aliasRefNotInWhitelist",
+			MsgAddTokenWhitelist{Symbol: "abc", OwnerAddress: testAddr, Whitelist: []sdk.AccAddress{aliceAddr},
+				AliasRefs: []dex.AliasRef{{Alias: "alice", Addr: testAddr}}},
+			sdk.ErrInvalidAddress("invalid alias reference: alice"),
+		},
 	}
 
 	for _, tt := range tests {
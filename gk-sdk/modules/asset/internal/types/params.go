@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -77,6 +78,13 @@ func (p *Params) ValidateGenesis() error {
 	return nil
 }
 
+// ValidateParams validates the asset params in the subspace
+func ValidateParams(ctx sdk.Context, ss params.Subspace) error {
+	var p Params
+	ss.GetParamSet(ctx, &p)
+	return p.ValidateGenesis()
+}
+
 func (p Params) GetIssueTokenFee(symbol string) int64 {
 	switch len(symbol) {
 	case 2:
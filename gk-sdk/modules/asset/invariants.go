@@ -0,0 +1,38 @@
+package asset
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// register all the invariants of asset
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "token-supply", TokenSupplyInvariant(k))
+}
+
+// TokenSupplyInvariant checks that the TotalSupply of every token equals its supply recorded by
+// the supply module, and its TotalMint, TotalBurn and SendLock are valid
+func TokenSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		supply := k.GetSupply(ctx)
+		for _, token := range k.GetAllTokens(ctx) {
+			symbol := token.GetSymbol()
+			if !token.GetTotalSupply().Equal(supply.AmountOf(symbol)) {
+				count++
+				msg += fmt.Sprintf("\t%s has total supply %s, but the supply module has %s\n",
+					symbol, token.GetTotalSupply(), supply.AmountOf(symbol))
+			}
+			if err := token.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s is invalid: %s\n", symbol, err.Result().Log)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "token supply",
+			fmt.Sprintf("%d mismatches found\n%s", count, msg)), broken
+	}
+}
@@ -0,0 +1,39 @@
+package asset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+)
+
+func TestTokenSupplyInvariant(t *testing.T) {
+	input := createTestInput()
+	invariant := asset.TokenSupplyInvariant(input.tk)
+
+	err := input.tk.IssueToken(input.ctx, "ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		true, true, false, false, "", "", asset.TestIdentityString)
+	require.NoError(t, err)
+	_, broken := invariant(input.ctx)
+	require.False(t, broken)
+
+	require.NoError(t, input.tk.MintToken(input.ctx, "abc", testAddr, sdk.NewInt(100)))
+	require.NoError(t, input.tk.BurnToken(input.ctx, "abc", testAddr, sdk.NewInt(50)))
+	_, broken = invariant(input.ctx)
+	require.False(t, broken)
+
+	token := input.tk.GetToken(input.ctx, "abc")
+	require.NoError(t, token.SetTotalSupply(sdk.NewInt(2000)))
+	require.NoError(t, input.tk.SetToken(input.ctx, token))
+	_, broken = invariant(input.ctx)
+	require.True(t, broken)
+
+	require.NoError(t, token.SetTotalSupply(sdk.NewInt(2150)))
+	token.SetMintable(false)
+	require.NoError(t, input.tk.SetToken(input.ctx, token))
+	_, broken = invariant(input.ctx)
+	require.True(t, broken)
+}
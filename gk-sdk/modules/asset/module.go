@@ -85,7 +85,9 @@ func (AppModule) Name() string {
 }
 
 // register invariants
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.assetKeeper)
+}
 
 // module message route name
 func (AppModule) Route() string { return RouterKey }
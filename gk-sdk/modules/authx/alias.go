@@ -10,26 +10,38 @@ const (
 	QuerierRoute    = types.QuerierRoute
 	ModuleName      = types.ModuleName
 	QueryAccountMix = types.QueryAccountMix
+	QueryReferrals  = types.QueryReferrals
+	QueryRebates    = types.QueryRebates
 
-	CodeSpaceAuthX           = types.CodeSpaceAuthX
-	CodeGasPriceTooLow       = types.CodeGasPriceTooLow
-	CodeRefereeChangeTooFast = types.CodeRefereeChangeTooFast
+	CodeSpaceAuthX             = types.CodeSpaceAuthX
+	CodeGasPriceTooLow         = types.CodeGasPriceTooLow
+	CodeRefereeChangeTooFast   = types.CodeRefereeChangeTooFast
+	CodeInvalidNonceLanes      = types.CodeInvalidNonceLanes
+	CodeNonceLaneNotRegistered = types.CodeNonceLaneNotRegistered
 
 	DefaultParamspace       = types.DefaultParamspace
 	DefaultMinGasPriceLimit = types.DefaultMinGasPriceLimit
+	MaxRefereeLevel         = types.MaxRefereeLevel
+	MaxNonceLanes           = types.MaxNonceLanes
 )
 
 var (
 	ErrInvalidMinGasPriceLimit = types.ErrInvalidMinGasPriceLimit
 	ErrGasPriceTooLow          = types.ErrGasPriceTooLow
 	ErrRefereeChangeTooFast    = types.ErrRefereeChangeTooFast
+	ErrInvalidNonceLanes       = types.ErrInvalidNonceLanes
+	ErrNonceLaneNotRegistered  = types.ErrNonceLaneNotRegistered
+	NewMsgSetNonceLanes        = types.NewMsgSetNonceLanes
+	NewMsgUseNonceLane         = types.NewMsgUseNonceLane
 	NewLockedCoin              = types.NewLockedCoin
 	NewSupervisedLockedCoin    = types.NewSupervisedLockedCoin
 	NewParams                  = types.NewParams
+	ValidateParams             = types.ValidateParams
 	NewAccountX                = types.NewAccountX
 	DefaultParams              = types.DefaultParams
 	ModuleCdc                  = types.ModuleCdc
 	NewAccountXWithAddress     = types.NewAccountXWithAddress
+	NewRebateTotal             = types.NewRebateTotal
 	NewKeeper                  = keepers.NewKeeper
 )
 
@@ -38,6 +50,11 @@ type (
 	LockedCoin            = types.LockedCoin
 	LockedCoins           = types.LockedCoins
 	MsgSetReferee         = types.MsgSetReferee
+	MsgSetNonceLanes      = types.MsgSetNonceLanes
+	MsgUseNonceLane       = types.MsgUseNonceLane
+	Rebate                = types.Rebate
+	Rebates               = types.Rebates
+	RebateTotal           = types.RebateTotal
 	AccountXKeeper        = keepers.AccountXKeeper
 	ExpectedAccountKeeper = keepers.ExpectedAccountKeeper
 	ExpectedTokenKeeper   = keepers.ExpectedTokenKeeper
@@ -22,7 +22,65 @@ func NewAnteHandler(ak auth.AccountKeeper, supplyKeeper authtypes.SupplyKeeper,
 	axk AccountXKeeper, anteHelper AnteHelper) sdk.AnteHandler {
 
 	ah := auth.NewAnteHandler(ak, supplyKeeper, auth.DefaultSigVerificationGasConsumer)
-	return WrapAnteHandler(ah, axk, anteHelper)
+	return WrapAnteHandler(WrapNonceLaneAnteHandler(ah, ak, axk), axk, anteHelper)
+}
+
+// WrapNonceLaneAnteHandler makes ah check and increment the sequence of the nonce lane used by the tx.
+// The sequence of the lane is put into the account before running ah, which verifies the signature
+// with it, and the account sequence is restored afterwards. Nothing is changed if ah aborts.
+func WrapNonceLaneAnteHandler(ah sdk.AnteHandler, ak auth.AccountKeeper, axk AccountXKeeper) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		stdTx, ok := tx.(auth.StdTx)
+		if !ok {
+			return ah(ctx, tx, simulate)
+		}
+		msg, err := getNonceLaneMsg(stdTx)
+		if err != nil {
+			return ctx, err.Result(), true
+		}
+		if msg == nil {
+			return ah(ctx, tx, simulate)
+		}
+
+		acc := ak.GetAccount(ctx, msg.Sender)
+		accx, _ := axk.GetAccountX(ctx, msg.Sender)
+		laneSeq, ok := accx.GetNonceLaneSequence(msg.Lane)
+		if acc == nil || !ok {
+			return ctx, ErrNonceLaneNotRegistered(msg.Lane).Result(), true
+		}
+		seq := acc.GetSequence()
+		_ = acc.SetSequence(laneSeq)
+		cacheCtx, write := ctx.CacheContext()
+		ak.SetAccount(cacheCtx, acc)
+
+		newCtx, res, abort = ah(cacheCtx, tx, simulate)
+		if abort {
+			return
+		}
+
+		acc = ak.GetAccount(newCtx, msg.Sender)
+		laneSeq = acc.GetSequence()
+		_ = acc.SetSequence(seq)
+		ak.SetAccount(newCtx, acc)
+		accx.SetNonceLaneSequence(msg.Lane, laneSeq)
+		axk.SetAccountX(newCtx, accx)
+		write()
+		newCtx = dex.WithOrderSequence(newCtx, msg.Sender, dex.NonceLaneOrderSequence(msg.Lane, laneSeq))
+		return
+	}
+}
+
+func getNonceLaneMsg(tx auth.StdTx) (*MsgUseNonceLane, sdk.Error) {
+	var res *MsgUseNonceLane
+	for _, msg := range tx.Msgs {
+		if msg, ok := msg.(MsgUseNonceLane); ok {
+			if res != nil {
+				return nil, ErrInvalidNonceLanes("a tx can use only one nonce lane")
+			}
+			res = &msg
+		}
+	}
+	return res, nil
 }
 
 func WrapAnteHandler(ah sdk.AnteHandler,
@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func TestOriginalAnteHandlerError(t *testing.T) {
@@ -58,3 +60,65 @@ func TestAdditionalError(t *testing.T) {
 	require.True(t, abort)
 	require.Equal(t, expectedErr.Result(), res)
 }
+
+func TestNonceLaneAnteHandler(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+	input.ak.SetParams(ctx, auth.DefaultParams())
+	key, _, addr := testutil.KeyPubAddr()
+	acc := input.ak.NewAccountWithAddress(ctx, addr)
+	_ = acc.SetSequence(5)
+	input.ak.SetAccount(ctx, acc)
+	accNum := acc.GetAccountNumber()
+
+	ah := authx.WrapNonceLaneAnteHandler(auth.NewAnteHandler(input.ak, input.sk, auth.DefaultSigVerificationGasConsumer),
+		input.ak, input.axk)
+	signedTx := func(seq uint64, msgs ...sdk.Msg) auth.StdTx {
+		fee := auth.NewStdFee(1000000, nil)
+		sig, err := key.Sign(auth.StdSignBytes(ctx.ChainID(), accNum, seq, fee, msgs, ""))
+		require.NoError(t, err)
+		return auth.NewStdTx(msgs, fee, []auth.StdSignature{{PubKey: key.PubKey(), Signature: sig}}, "")
+	}
+
+	// the lane must be registered
+	tx := signedTx(0, authx.NewMsgUseNonceLane(addr, 1))
+	_, res, abort := ah(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, authx.CodeNonceLaneNotRegistered, res.Code)
+
+	res = authx.NewHandler(input.axk, input.ak)(ctx, authx.NewMsgSetNonceLanes(addr, 2))
+	require.True(t, res.IsOK())
+
+	// the txs of a lane are signed with the sequence of the lane
+	newCtx, res, abort := ah(ctx, tx, false)
+	require.False(t, abort, res.Log)
+	require.EqualValues(t, 5, input.ak.GetAccount(ctx, addr).GetSequence())
+	accx, _ := input.axk.GetAccountX(ctx, addr)
+	require.Equal(t, []uint64{1, 0}, accx.NonceLanes)
+	seq, ok := dex.GetOrderSequence(newCtx, addr)
+	require.True(t, ok)
+	require.Equal(t, dex.NonceLaneOrderSequence(1, 1), seq)
+
+	// a tx can not be replayed in its lane or used in another lane
+	_, _, abort = ah(ctx, tx, false)
+	require.True(t, abort)
+	_, _, abort = ah(ctx, signedTx(0, authx.NewMsgUseNonceLane(addr, 2)), false)
+	require.False(t, abort)
+	_, _, abort = ah(ctx, signedTx(0, authx.NewMsgUseNonceLane(addr, 2)), false)
+	require.True(t, abort)
+
+	// the txs without lanes use the account sequence
+	newCtx, _, abort = ah(ctx, signedTx(5, authx.NewMsgSetNonceLanes(addr, 2)), false)
+	require.False(t, abort)
+	require.EqualValues(t, 6, input.ak.GetAccount(ctx, addr).GetSequence())
+	_, ok = dex.GetOrderSequence(newCtx, addr)
+	require.False(t, ok)
+
+	_, res, abort = ah(ctx, signedTx(2, authx.NewMsgUseNonceLane(addr, 1), authx.NewMsgUseNonceLane(addr, 2)), false)
+	require.True(t, abort)
+	require.Equal(t, authx.CodeInvalidNonceLanes, res.Code)
+
+	// the lanes can not be decreased
+	res = authx.NewHandler(input.axk, input.ak)(ctx, authx.NewMsgSetNonceLanes(addr, 1))
+	require.Equal(t, authx.CodeInvalidNonceLanes, res.Code)
+}
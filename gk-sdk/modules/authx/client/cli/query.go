@@ -26,6 +26,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	assQueryCmd.AddCommand(client.GetCommands(
 		GetQueryParamsCmd(cdc),
+		GetReferralsCmd(cdc),
+		GetRebatesCmd(cdc),
 	)...)
 
 	return assQueryCmd
@@ -60,3 +62,37 @@ func GetAccountXCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	return flags.GetCommands(cmd)[0]
 }
+
+func GetReferralsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "referrals [address]",
+		Short: "Query the addresses which set the given address as referee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryReferrals)
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := auth.NewQueryAccountParams(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func GetRebatesCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rebates [address]",
+		Short: "Query the cumulative rebates earned by a referee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRebates)
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := auth.NewQueryAccountParams(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -31,3 +33,27 @@ func SetRefereeCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func SetNonceLanesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-nonce-lanes <number of lanes>",
+		Short: "Register nonce lanes to send transactions in parallel",
+		Long: `Register nonce lanes to send transactions in parallel. Every lane has its own sequence,
+and a transaction containing a use_nonce_lane message is signed with the sequence of the lane.
+The number of lanes can not be decreased.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			lanes, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetNonceLanes(nil, uint32(lanes))
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
@@ -28,6 +28,8 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec)
 	r.HandleFunc("/auth/sign", SignRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/auth/signTx/{privKey}", SignTxRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/auth/accounts/{address}/referee", setRefereeHandleFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/auth/accounts/{address}/referrals", QueryAccountIndexHandlerFn(cliCtx, cdc, types.QueryReferrals)).Methods("GET")
+	r.HandleFunc("/auth/accounts/{address}/rebates", QueryAccountIndexHandlerFn(cliCtx, cdc, types.QueryRebates)).Methods("GET")
 }
 
 // query accountREST Handler
@@ -46,6 +48,22 @@ func QueryAccountRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) h
 	}
 }
 
+// query the referral list or the rebate totals of an account
+func QueryAccountIndexHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, query string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, query)
+		vars := mux.Vars(r)
+		acc, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := auth.NewQueryAccountParams(acc)
+
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
 // HTTP request handler to query the authx params values
 func QueryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
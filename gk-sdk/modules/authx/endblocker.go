@@ -3,9 +3,15 @@ package authx
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
+func init() {
+	// sent by authx when locked coins are unlocked and by bankx when they are unlocked earlier
+	msgqueue.RegisterSchema("notify_unlock", 1, NotificationUnlock{})
+}
+
 func EndBlocker(ctx sdk.Context, aux AccountXKeeper, keeper ExpectedAccountKeeper, tk ExpectedTokenKeeper) {
 	currentTime := ctx.BlockHeader().Time.Unix()
 	iterator := aux.UnlockedCoinsQueueIterator(ctx, currentTime)
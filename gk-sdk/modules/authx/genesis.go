@@ -9,8 +9,9 @@ import (
 )
 
 type GenesisState struct {
-	Params    types.Params    `json:"params"`
-	AccountXs types.AccountXs `json:"accountxs"`
+	Params       types.Params       `json:"params"`
+	AccountXs    types.AccountXs    `json:"accountxs"`
+	RebateTotals types.RebateTotals `json:"rebate_totals,omitempty"`
 }
 
 func NewGenesisState(params types.Params, accountXs types.AccountXs) GenesisState {
@@ -32,8 +33,16 @@ func InitGenesis(ctx sdk.Context, keeper AccountXKeeper, data GenesisState) {
 	for _, accx := range data.AccountXs {
 		accountX := types.NewAccountX(accx.Address, accx.MemoRequired,
 			accx.LockedCoins, accx.FrozenCoins,
-			accx.Referee, accx.RefereeChangeTime)
-		keeper.SetAccountX(ctx, accountX)
+			nil, 0)
+		accountX.NonceLanes = accx.NonceLanes
+		keeper.UpdateReferee(ctx, accountX, accx.Referee, accx.RefereeChangeTime)
+		for _, c := range accx.LockedCoins {
+			keeper.InsertUnlockedCoinsQueue(ctx, c.UnlockTime, accx.Address)
+		}
+	}
+
+	for _, rt := range data.RebateTotals {
+		keeper.SetRebateTotal(ctx, rt)
 	}
 }
 
@@ -46,7 +55,12 @@ func ExportGenesis(ctx sdk.Context, keeper AccountXKeeper) GenesisState {
 		return false
 	})
 
-	return NewGenesisState(keeper.GetParams(ctx), accountXs)
+	gs := NewGenesisState(keeper.GetParams(ctx), accountXs)
+	keeper.IterateRebateTotals(ctx, func(rt types.RebateTotal) (stop bool) {
+		gs.RebateTotals = append(gs.RebateTotals, rt)
+		return false
+	})
+	return gs
 }
 
 // ValidateGenesis performs basic validation of asset genesis data returning an
@@ -68,6 +82,20 @@ func (data GenesisState) ValidateGenesis() error {
 		}
 
 		addrMap[addrStr] = true
+		if len(accx.NonceLanes) > types.MaxNonceLanes {
+			return fmt.Errorf("too many nonce lanes found in genesis state; address: %s", addrStr)
+		}
+	}
+
+	refereeMap := make(map[string]bool, len(data.RebateTotals))
+	for _, rt := range data.RebateTotals {
+		if rt.Referee.Empty() {
+			return fmt.Errorf("nil referee found in rebate totals")
+		}
+		if refereeMap[rt.Referee.String()] {
+			return fmt.Errorf("duplicate rebate total found in genesis state; referee: %s", rt.Referee)
+		}
+		refereeMap[rt.Referee.String()] = true
 	}
 
 	return nil
@@ -47,3 +47,23 @@ func TestExport(t *testing.T) {
 	require.Equal(t, genState1, genState2)
 	require.True(t, genState2.Params.Equal(genState1.Params))
 }
+
+func TestExportReferralState(t *testing.T) {
+	referee := sdk.AccAddress([]byte("referee"))
+	accx := authx.NewAccountX(sdk.AccAddress([]byte("addr")), false, nil, nil, referee, 100)
+	rt := authx.NewRebateTotal(referee)
+	rt.Add(1, 300)
+
+	testInput := setupTestInput()
+	genState1 := authx.NewGenesisState(authx.DefaultParams(), []authx.AccountX{accx})
+	genState1.RebateTotals = []authx.RebateTotal{rt}
+	require.Nil(t, genState1.ValidateGenesis())
+	authx.InitGenesis(testInput.ctx, testInput.axk, genState1)
+	require.Equal(t, []sdk.AccAddress{accx.Address}, testInput.axk.GetReferrals(testInput.ctx, referee))
+
+	genState2 := authx.ExportGenesis(testInput.ctx, testInput.axk)
+	require.Equal(t, genState1.RebateTotals, genState2.RebateTotals)
+
+	genState2.RebateTotals = append(genState2.RebateTotals, rt)
+	require.NotNil(t, genState2.ValidateGenesis())
+}
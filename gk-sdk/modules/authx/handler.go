@@ -2,6 +2,7 @@ package authx
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,6 +17,10 @@ func NewHandler(k keepers.AccountXKeeper, ak ExpectedAccountKeeper) sdk.Handler
 		switch msg := msg.(type) {
 		case types.MsgSetReferee:
 			return handleMsgSetReferee(ctx, k, ak, msg)
+		case types.MsgSetNonceLanes:
+			return handleMsgSetNonceLanes(ctx, k, msg)
+		case types.MsgUseNonceLane:
+			return handleMsgUseNonceLane(ctx, k, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 
@@ -35,8 +40,7 @@ func handleMsgSetReferee(ctx sdk.Context, k keepers.AccountXKeeper, ak ExpectedA
 		return err.Result()
 	}
 
-	senderAccx.UpdateRefereeAddr(msg.Referee, ctx.BlockTime().UnixNano())
-	k.SetAccountX(ctx, senderAccx)
+	k.UpdateReferee(ctx, senderAccx, msg.Referee, ctx.BlockTime().UnixNano())
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(sdk.EventTypeMessage,
@@ -54,6 +58,60 @@ func handleMsgSetReferee(ctx sdk.Context, k keepers.AccountXKeeper, ak ExpectedA
 		Events: ctx.EventManager().Events(),
 	}
 }
+func handleMsgSetNonceLanes(ctx sdk.Context, k keepers.AccountXKeeper, msg types.MsgSetNonceLanes) sdk.Result {
+	accx := k.GetOrCreateAccountX(ctx, msg.Sender)
+	if int(msg.Lanes) < len(accx.NonceLanes) {
+		return types.ErrInvalidNonceLanes(fmt.Sprintf("can not decrease the number of lanes from %d to %d",
+			len(accx.NonceLanes), msg.Lanes)).Result()
+	}
+	for len(accx.NonceLanes) < int(msg.Lanes) {
+		accx.NonceLanes = append(accx.NonceLanes, 0)
+	}
+	k.SetAccountX(ctx, accx)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeSetNonceLanes,
+			sdk.NewAttribute(types.AttributeNonceLanes, strconv.Itoa(int(msg.Lanes))),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// the sequence of the lane has been checked and increased by the ante handler
+func handleMsgUseNonceLane(ctx sdk.Context, k keepers.AccountXKeeper, msg types.MsgUseNonceLane) sdk.Result {
+	accx, _ := k.GetAccountX(ctx, msg.Sender)
+	seq, ok := accx.GetNonceLaneSequence(msg.Lane)
+	if !ok {
+		return types.ErrNonceLaneNotRegistered(msg.Lane).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeUseNonceLane,
+			sdk.NewAttribute(types.AttributeNonceLane, strconv.Itoa(int(msg.Lane))),
+			sdk.NewAttribute(types.AttributeSequence, strconv.FormatUint(seq, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 func preCheckAddr(ctx sdk.Context, k keepers.AccountXKeeper, ak ExpectedAccountKeeper, msg types.MsgSetReferee) sdk.Error {
 	senderAcc := ak.GetAccount(ctx, msg.Sender)
 	if senderAcc == nil {
@@ -15,6 +15,10 @@ import (
 var (
 	// AddressStoreKeyPrefix prefix for accountx-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
+	// ReferralKeyPrefix prefix for the referee-to-referrals index
+	ReferralKeyPrefix = []byte{0x02}
+	// RebateTotalKeyPrefix prefix for the cumulative rebates earned by referees
+	RebateTotalKeyPrefix = []byte{0x03}
 
 	PrefixUnlockedCoinsQueue = []byte("UnlockedCoinsQueue")
 	KeyDelimiter             = []byte(";")
@@ -129,6 +133,32 @@ func (axk AccountXKeeper) RemoveFromUnlockedCoinsQueueByKey(ctx sdk.Context, key
 	store.Delete(key)
 }
 
+func (axk AccountXKeeper) HasUnlockedCoinsQueue(ctx sdk.Context, unlockedTime int64, address sdk.AccAddress) bool {
+	store := ctx.KVStore(axk.key)
+	return store.Has(KeyUnlockedCoinsQueue(unlockedTime, address))
+}
+
+// IterateUnlockedCoinsQueue visits all the entries in the unlocked coins queue, in the order of unlocked time
+func (axk AccountXKeeper) IterateUnlockedCoinsQueue(ctx sdk.Context, process func(unlockedTime int64, address sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(axk.key)
+	iter := sdk.KVStorePrefixIterator(store, append(append([]byte{}, PrefixUnlockedCoinsQueue...), KeyDelimiter...))
+	defer iter.Close()
+	timeStart := len(PrefixUnlockedCoinsQueue) + len(KeyDelimiter)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) < timeStart+len(sdk.SortableTimeFormat) {
+			continue
+		}
+		t, err := sdk.ParseTimeBytes(key[timeStart : timeStart+len(sdk.SortableTimeFormat)])
+		if err != nil {
+			continue
+		}
+		if process(t.Unix(), iter.Value()) {
+			return
+		}
+	}
+}
+
 func (axk AccountXKeeper) GetRefereeAddr(ctx sdk.Context, addr sdk.AccAddress) sdk.AccAddress {
 	accx, exist := axk.GetAccountX(ctx, addr)
 	if !exist {
@@ -145,6 +175,105 @@ func (axk AccountXKeeper) GetRebateRatioBase(ctx sdk.Context) int64 {
 	return types.RebateRatioBase
 }
 
+// UpdateReferee changes the referee of accx and keeps the referral index in sync
+func (axk AccountXKeeper) UpdateReferee(ctx sdk.Context, accx types.AccountX, referee sdk.AccAddress, changeTime int64) {
+	store := ctx.KVStore(axk.key)
+	if len(accx.Referee) != 0 {
+		store.Delete(ReferralKey(accx.Referee, accx.Address))
+	}
+	accx.UpdateRefereeAddr(referee, changeTime)
+	axk.SetAccountX(ctx, accx)
+	if len(referee) != 0 {
+		store.Set(ReferralKey(referee, accx.Address), []byte{})
+	}
+}
+
+// GetReferrals returns all the addresses whose direct referee is the given address
+func (axk AccountXKeeper) GetReferrals(ctx sdk.Context, referee sdk.AccAddress) []sdk.AccAddress {
+	store := ctx.KVStore(axk.key)
+	prefix := append(ReferralKeyPrefix, referee.Bytes()...)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	res := make([]sdk.AccAddress, 0)
+	for ; iter.Valid(); iter.Next() {
+		res = append(res, sdk.AccAddress(iter.Key()[len(prefix):]))
+	}
+	return res
+}
+
+// GetRefereeChain returns the referees of addr level by level, at most maxLevel levels.
+// The walk stops at the first account without referee or when a referral cycle is found.
+func (axk AccountXKeeper) GetRefereeChain(ctx sdk.Context, addr sdk.AccAddress, maxLevel int) []sdk.AccAddress {
+	chain := make([]sdk.AccAddress, 0, maxLevel)
+	visited := map[string]bool{string(addr): true}
+	curr := addr
+	for len(chain) < maxLevel {
+		referee := axk.GetRefereeAddr(ctx, curr)
+		if len(referee) == 0 || visited[string(referee)] {
+			break
+		}
+		visited[string(referee)] = true
+		chain = append(chain, referee)
+		curr = referee
+	}
+	return chain
+}
+
+// CalcRebates splits the rebates of a fee paid by addr among its referees
+func (axk AccountXKeeper) CalcRebates(ctx sdk.Context, addr sdk.AccAddress, fee int64) types.Rebates {
+	ratios := axk.GetParams(ctx).RebateRatios()
+	chain := axk.GetRefereeChain(ctx, addr, len(ratios))
+	rebates := make(types.Rebates, 0, len(chain))
+	for i, referee := range chain {
+		amount := sdk.NewInt(fee).MulRaw(ratios[i]).QuoRaw(types.RebateRatioBase).Int64()
+		if amount <= 0 {
+			continue
+		}
+		rebates = append(rebates, types.Rebate{Referee: referee, Level: i + 1, Amount: amount})
+	}
+	return rebates
+}
+
+// AddRebates accumulates the paid rebates into the referees' totals
+func (axk AccountXKeeper) AddRebates(ctx sdk.Context, rebates types.Rebates) {
+	for _, r := range rebates {
+		rt, ok := axk.GetRebateTotal(ctx, r.Referee)
+		if !ok {
+			rt = types.NewRebateTotal(r.Referee)
+		}
+		rt.Add(r.Level, r.Amount)
+		axk.SetRebateTotal(ctx, rt)
+	}
+}
+
+func (axk AccountXKeeper) GetRebateTotal(ctx sdk.Context, referee sdk.AccAddress) (rt types.RebateTotal, ok bool) {
+	store := ctx.KVStore(axk.key)
+	bz := store.Get(RebateTotalKey(referee))
+	if bz == nil {
+		return
+	}
+	axk.cdc.MustUnmarshalBinaryBare(bz, &rt)
+	return rt, true
+}
+
+func (axk AccountXKeeper) SetRebateTotal(ctx sdk.Context, rt types.RebateTotal) {
+	store := ctx.KVStore(axk.key)
+	store.Set(RebateTotalKey(rt.Referee), axk.cdc.MustMarshalBinaryBare(rt))
+}
+
+func (axk AccountXKeeper) IterateRebateTotals(ctx sdk.Context, process func(types.RebateTotal) (stop bool)) {
+	store := ctx.KVStore(axk.key)
+	iter := sdk.KVStorePrefixIterator(store, RebateTotalKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rt types.RebateTotal
+		axk.cdc.MustUnmarshalBinaryBare(iter.Value(), &rt)
+		if process(rt) {
+			return
+		}
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -197,6 +326,18 @@ func KeyUnlockedCoinsQueue(unlockedTime int64, address sdk.AccAddress) []byte {
 	}, KeyDelimiter)
 }
 
+func ReferralKey(referee, referral sdk.AccAddress) []byte {
+	return bytes.Join([][]byte{
+		ReferralKeyPrefix,
+		referee,
+		referral,
+	}, nil)
+}
+
+func RebateTotalKey(referee sdk.AccAddress) []byte {
+	return append(RebateTotalKeyPrefix, referee.Bytes()...)
+}
+
 func PrefixUnlockedTimeQueueTime(unlockedTime int64) []byte {
 	return bytes.Join([][]byte{
 		PrefixUnlockedCoinsQueue,
@@ -81,3 +81,63 @@ func TestIteratorAccounts(t *testing.T) {
 
 	require.Equal(t, 4, len(accxs))
 }
+
+func TestUpdateRefereeAndGetReferrals(t *testing.T) {
+	input := setupTestInput()
+	referee1 := sdk.AccAddress([]byte("referee1"))
+	referee2 := sdk.AccAddress([]byte("referee2"))
+
+	accx := input.axk.GetOrCreateAccountX(input.ctx, addr)
+	input.axk.UpdateReferee(input.ctx, accx, referee1, 100)
+	require.Equal(t, []sdk.AccAddress{addr}, input.axk.GetReferrals(input.ctx, referee1))
+
+	accx, _ = input.axk.GetAccountX(input.ctx, addr)
+	require.Equal(t, referee1, accx.Referee)
+	require.Equal(t, int64(100), accx.RefereeChangeTime)
+
+	input.axk.UpdateReferee(input.ctx, accx, referee2, 200)
+	require.Empty(t, input.axk.GetReferrals(input.ctx, referee1))
+	require.Equal(t, []sdk.AccAddress{addr}, input.axk.GetReferrals(input.ctx, referee2))
+}
+
+func TestCalcAndAddRebates(t *testing.T) {
+	input := setupTestInput()
+	params := types.DefaultParams()
+	params.UpperLevelRebateRatios = []int64{1000, 500}
+	input.axk.SetParams(input.ctx, params)
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	input.axk.SetAccountX(input.ctx, types.NewAccountX(addr, false, nil, nil, addr1, 0))
+	input.axk.SetAccountX(input.ctx, types.NewAccountX(addr1, false, nil, nil, addr2, 0))
+	input.axk.SetAccountX(input.ctx, types.NewAccountX(addr2, false, nil, nil, addr3, 0))
+
+	rebates := input.axk.CalcRebates(input.ctx, addr, 10000)
+	require.Equal(t, types.Rebates{
+		{Referee: addr1, Level: 1, Amount: 2000},
+		{Referee: addr2, Level: 2, Amount: 1000},
+		{Referee: addr3, Level: 3, Amount: 500},
+	}, rebates)
+	require.Equal(t, int64(3500), rebates.Total())
+	require.Equal(t, addr1, rebates.DirectReferee())
+
+	// a referral cycle must not pay anybody twice, nor the trader itself
+	input.axk.SetAccountX(input.ctx, types.NewAccountX(addr2, false, nil, nil, addr, 0))
+	rebates = input.axk.CalcRebates(input.ctx, addr, 10000)
+	require.Equal(t, 2, len(rebates))
+
+	input.axk.AddRebates(input.ctx, rebates)
+	input.axk.AddRebates(input.ctx, types.Rebates{{Referee: addr2, Level: 1, Amount: 7}})
+	rt, ok := input.axk.GetRebateTotal(input.ctx, addr2)
+	require.True(t, ok)
+	require.Equal(t, int64(1007), rt.Total)
+	require.Equal(t, []int64{7, 1000, 0}, rt.LevelTotals)
+
+	var totals []types.RebateTotal
+	input.axk.IterateRebateTotals(input.ctx, func(rt types.RebateTotal) bool {
+		totals = append(totals, rt)
+		return false
+	})
+	require.Equal(t, 2, len(totals))
+}
@@ -20,6 +20,10 @@ func NewQuerier(keeper AccountXKeeper) sdk.Querier {
 			return queryParameters(ctx, keeper)
 		case types.QueryAccountMix:
 			return queryAccountMix(ctx, req, keeper)
+		case types.QueryReferrals:
+			return queryReferrals(ctx, req, keeper)
+		case types.QueryRebates:
+			return queryRebates(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown authx query endpoint")
 		}
@@ -51,6 +55,40 @@ func queryAccountMix(ctx sdk.Context, req abci.RequestQuery, keeper AccountXKeep
 
 	return bz, nil
 }
+
+func queryReferrals(ctx sdk.Context, req abci.RequestQuery, keeper AccountXKeeper) ([]byte, sdk.Error) {
+	var params auth.QueryAccountParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	referrals := keeper.GetReferrals(ctx, params.Address)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, referrals)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryRebates(ctx sdk.Context, req abci.RequestQuery, keeper AccountXKeeper) ([]byte, sdk.Error) {
+	var params auth.QueryAccountParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	rt, ok := keeper.GetRebateTotal(ctx, params.Address)
+	if !ok {
+		rt = types.NewRebateTotal(params.Address)
+	}
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, rt)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func queryParameters(ctx sdk.Context, k AccountXKeeper) ([]byte, sdk.Error) {
 	params := k.ak.GetParams(ctx)
 	paramsx := k.GetParams(ctx)
@@ -16,6 +16,7 @@ type AccountX struct {
 	FrozenCoins       sdk.Coins      `json:"frozen_coins"`
 	Referee           sdk.AccAddress `json:"referee,omitempty"`             // DEX2
 	RefereeChangeTime int64          `json:"referee_change_time,omitempty"` // DEX2
+	NonceLanes        []uint64       `json:"nonce_lanes,omitempty"`         // the sequences of the nonce lanes 1~len(NonceLanes)
 }
 
 type AccountXs []AccountX
@@ -87,14 +88,27 @@ func (acc *AccountX) GetAllUnlockedCoinsAtTheTime(time int64) LockedCoins {
 	return coins
 }
 
+// GetNonceLaneSequence returns the sequence of lane, which is false if lane is not registered
+func (acc *AccountX) GetNonceLaneSequence(lane uint32) (uint64, bool) {
+	if lane == 0 || int(lane) > len(acc.NonceLanes) {
+		return 0, false
+	}
+	return acc.NonceLanes[lane-1], true
+}
+
+func (acc *AccountX) SetNonceLaneSequence(lane uint32, seq uint64) {
+	acc.NonceLanes[lane-1] = seq
+}
+
 func (acc AccountX) String() string {
 	return fmt.Sprintf(`
   LockedCoins:       %s
   FrozenCoins:       %s
   MemoRequired:      %t
   Referee:           %s
-  RefereeChangeTime: %d`,
-		acc.LockedCoins, acc.FrozenCoins, acc.MemoRequired, acc.Referee, acc.RefereeChangeTime,
+  RefereeChangeTime: %d
+  NonceLanes:        %v`,
+		acc.LockedCoins, acc.FrozenCoins, acc.MemoRequired, acc.Referee, acc.RefereeChangeTime, acc.NonceLanes,
 	)
 }
 
@@ -129,6 +143,7 @@ type AccountMix struct {
 	MemoRequired      bool           `json:"memo_required"` // if memo is required for receiving coins
 	Referee           sdk.AccAddress `json:"referee"`
 	RefereeChangeTime int64          `json:"referee_change_time"`
+	NonceLanes        []uint64       `json:"nonce_lanes,omitempty"`
 }
 
 func NewAccountMix(acc auth.Account, x AccountX) AccountMix {
@@ -143,5 +158,6 @@ func NewAccountMix(acc auth.Account, x AccountX) AccountMix {
 		x.IsMemoRequired(),
 		x.Referee,
 		x.RefereeChangeTime,
+		x.NonceLanes,
 	}
 }
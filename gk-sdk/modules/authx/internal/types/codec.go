@@ -14,4 +14,7 @@ func init() {
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(AccountX{}, "authx/AccountX", nil)
 	cdc.RegisterConcrete(MsgSetReferee{}, "authx/MsgSetReferee", nil)
+	cdc.RegisterConcrete(RebateTotal{}, "authx/RebateTotal", nil)
+	cdc.RegisterConcrete(MsgSetNonceLanes{}, "authx/MsgSetNonceLanes", nil)
+	cdc.RegisterConcrete(MsgUseNonceLane{}, "authx/MsgUseNonceLane", nil)
 }
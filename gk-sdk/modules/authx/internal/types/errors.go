@@ -13,6 +13,8 @@ const (
 	CodeRefereeChangeTooFast    sdk.CodeType = 203
 	CodeRefereeMemoRequired     sdk.CodeType = 204
 	CodeRefereeCanNotBeYourself sdk.CodeType = 205
+	CodeInvalidNonceLanes       sdk.CodeType = 206
+	CodeNonceLaneNotRegistered  sdk.CodeType = 207
 )
 
 func ErrInvalidMinGasPriceLimit(limit sdk.Dec) sdk.Error {
@@ -33,3 +35,9 @@ func ErrRefereeMemoRequired(referee string) sdk.Error {
 func ErrRefereeCanNotBeYouself(referee string) sdk.Error {
 	return sdk.NewError(CodeSpaceAuthX, CodeRefereeCanNotBeYourself, "referee %s can not be yourself", referee)
 }
+func ErrInvalidNonceLanes(msg string) sdk.Error {
+	return sdk.NewError(CodeSpaceAuthX, CodeInvalidNonceLanes, "invalid nonce lanes: %s", msg)
+}
+func ErrNonceLaneNotRegistered(lane uint32) sdk.Error {
+	return sdk.NewError(CodeSpaceAuthX, CodeNonceLaneNotRegistered, "nonce lane %d is not registered", lane)
+}
@@ -3,8 +3,13 @@ package types
 const (
 	AttributeValueCategory = ModuleName
 
-	EventTypeSetReferee = "set_referee"
+	EventTypeSetReferee    = "set_referee"
+	EventTypeSetNonceLanes = "set_nonce_lanes"
+	EventTypeUseNonceLane  = "use_nonce_lane"
 
 	AttributeReferee           = "referee_addr"
 	AttributeRefereeChangeTime = "referee_change_time"
+	AttributeNonceLanes        = "nonce_lanes"
+	AttributeNonceLane         = "nonce_lane"
+	AttributeSequence          = "sequence"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -43,3 +45,80 @@ func (msg MsgSetReferee) GetSignBytes() []byte {
 func (msg MsgSetReferee) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
+
+// MaxNonceLanes is the max number of the nonce lanes of an account
+const MaxNonceLanes = 16
+
+var _ sdk.Msg = MsgSetNonceLanes{}
+
+// MsgSetNonceLanes registers the nonce lanes 1~Lanes of Sender, each lane has its own sequence
+// which starts from 0. The number of the lanes can not be decreased, so no sequence is reused.
+type MsgSetNonceLanes struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Lanes  uint32         `json:"lanes"`
+}
+
+func NewMsgSetNonceLanes(sender sdk.AccAddress, lanes uint32) MsgSetNonceLanes {
+	return MsgSetNonceLanes{Sender: sender, Lanes: lanes}
+}
+
+func (msg *MsgSetNonceLanes) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+func (msg MsgSetNonceLanes) Route() string { return RouteKey }
+
+func (msg MsgSetNonceLanes) Type() string { return "set_nonce_lanes" }
+
+func (msg MsgSetNonceLanes) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	if msg.Lanes == 0 || msg.Lanes > MaxNonceLanes {
+		return ErrInvalidNonceLanes(fmt.Sprintf("the number of lanes must be 1~%d", MaxNonceLanes))
+	}
+	return nil
+}
+
+func (msg MsgSetNonceLanes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetNonceLanes) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+var _ sdk.Msg = MsgUseNonceLane{}
+
+// MsgUseNonceLane makes its tx signed by Sender with the sequence of Lane instead of the account sequence,
+// the txs of different lanes can be pending at the same time. A tx can contain only one MsgUseNonceLane.
+type MsgUseNonceLane struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Lane   uint32         `json:"lane"`
+}
+
+func NewMsgUseNonceLane(sender sdk.AccAddress, lane uint32) MsgUseNonceLane {
+	return MsgUseNonceLane{Sender: sender, Lane: lane}
+}
+
+func (msg MsgUseNonceLane) Route() string { return RouteKey }
+
+func (msg MsgUseNonceLane) Type() string { return "use_nonce_lane" }
+
+func (msg MsgUseNonceLane) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	if msg.Lane == 0 || msg.Lane > MaxNonceLanes {
+		return ErrInvalidNonceLanes(fmt.Sprintf("the lane must be 1~%d", MaxNonceLanes))
+	}
+	return nil
+}
+
+func (msg MsgUseNonceLane) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUseNonceLane) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
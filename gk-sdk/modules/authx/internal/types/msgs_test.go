@@ -63,3 +63,16 @@ func TestMsgSetReferee_Routesg(t *testing.T) {
 	msg := NewMsgSetReferee(sender, referee)
 	require.Equal(t, msg.Route(), ModuleName)
 }
+
+func TestMsgNonceLanes_ValidateBasic(t *testing.T) {
+	require.Nil(t, NewMsgSetNonceLanes(sender, 1).ValidateBasic())
+	require.Nil(t, NewMsgSetNonceLanes(sender, MaxNonceLanes).ValidateBasic())
+	require.Equal(t, CodeInvalidNonceLanes, NewMsgSetNonceLanes(sender, 0).ValidateBasic().Code())
+	require.Equal(t, CodeInvalidNonceLanes, NewMsgSetNonceLanes(sender, MaxNonceLanes+1).ValidateBasic().Code())
+	require.NotNil(t, NewMsgSetNonceLanes(noneAddr, 1).ValidateBasic())
+
+	require.Nil(t, NewMsgUseNonceLane(sender, 1).ValidateBasic())
+	require.Equal(t, CodeInvalidNonceLanes, NewMsgUseNonceLane(sender, 0).ValidateBasic().Code())
+	require.NotNil(t, NewMsgUseNonceLane(noneAddr, 1).ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{sender}, NewMsgUseNonceLane(sender, 1).GetSigners())
+}
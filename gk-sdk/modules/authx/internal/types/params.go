@@ -27,6 +27,9 @@ const (
 	DefaultRefereeChangeMinInterval = time.Second * 24 * 60 * 60 * 7
 	DefaultRebateRatio              = 2000
 	RebateRatioBase                 = 10000
+
+	// MaxRefereeLevel is the deepest referral level which can earn rebates
+	MaxRefereeLevel = 3
 )
 
 // Parameter keys
@@ -34,6 +37,7 @@ var (
 	KeyMinGasPriceLimit         = []byte("MinGasPriceLimit")
 	KeyRefereeChangeMinInterval = []byte("RefereeChangeMinInterval")
 	KeyRebateRatio              = []byte("RebateRatio")
+	KeyUpperLevelRebateRatios   = []byte("UpperLevelRebateRatios")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -43,6 +47,8 @@ type Params struct {
 	MinGasPriceLimit         sdk.Dec `json:"min_gas_price_limit"`
 	RefereeChangeMinInterval int64   `json:"referee_change_min_interval"` // DEX2
 	RebateRatio              int64   `json:"rebate_ratio"`                // DEX2
+	// rebate ratios for the 2nd, 3rd... level referees, the 1st level uses RebateRatio
+	UpperLevelRebateRatios []int64 `json:"upper_level_rebate_ratios"`
 }
 
 // ParamKeyTable for authx module
@@ -74,6 +80,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMinGasPriceLimit, Value: &p.MinGasPriceLimit},
 		{Key: KeyRefereeChangeMinInterval, Value: &p.RefereeChangeMinInterval},
 		{Key: KeyRebateRatio, Value: &p.RebateRatio},
+		{Key: KeyUpperLevelRebateRatios, Value: &p.UpperLevelRebateRatios},
 	}
 }
 
@@ -99,5 +106,33 @@ func (p *Params) ValidateGenesis() error {
 	if p.RebateRatio <= 0 || p.RebateRatio > 10000 {
 		return fmt.Errorf("RebateRatio must be in range of 1 to 10000, is %d", p.RebateRatio)
 	}
+	if len(p.UpperLevelRebateRatios) >= MaxRefereeLevel {
+		return fmt.Errorf("%s can have at most %d levels, has %d", KeyUpperLevelRebateRatios,
+			MaxRefereeLevel-1, len(p.UpperLevelRebateRatios))
+	}
+	total := p.RebateRatio
+	for _, ratio := range p.UpperLevelRebateRatios {
+		if ratio < 0 {
+			return fmt.Errorf("%s must not be negative, is %d", KeyUpperLevelRebateRatios, ratio)
+		}
+		total += ratio
+	}
+	if total > RebateRatioBase {
+		return fmt.Errorf("sum of all rebate ratios must not exceed %d, is %d", RebateRatioBase, total)
+	}
 	return nil
 }
+
+// ValidateParams validates the authx params in the subspace
+func ValidateParams(ctx sdk.Context, ss params.Subspace) error {
+	var p Params
+	ss.GetParamSet(ctx, &p)
+	return p.ValidateGenesis()
+}
+
+// RebateRatios returns the rebate ratios of all the referral levels, starting from the 1st level
+func (p Params) RebateRatios() []int64 {
+	ratios := make([]int64, 0, 1+len(p.UpperLevelRebateRatios))
+	ratios = append(ratios, p.RebateRatio)
+	return append(ratios, p.UpperLevelRebateRatios...)
+}
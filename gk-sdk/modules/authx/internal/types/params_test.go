@@ -14,3 +14,21 @@ func TestParams_Equal(t *testing.T) {
 	b := param.Equal(param2)
 	require.Equal(t, true, b)
 }
+
+func TestParams_UpperLevelRebateRatios(t *testing.T) {
+	param := DefaultParams()
+	require.Equal(t, []int64{DefaultRebateRatio}, param.RebateRatios())
+
+	param.UpperLevelRebateRatios = []int64{1000, 500}
+	require.Nil(t, param.ValidateGenesis())
+	require.Equal(t, []int64{DefaultRebateRatio, 1000, 500}, param.RebateRatios())
+
+	param.UpperLevelRebateRatios = []int64{1000, 500, 100}
+	require.NotNil(t, param.ValidateGenesis())
+
+	param.UpperLevelRebateRatios = []int64{-1}
+	require.NotNil(t, param.ValidateGenesis())
+
+	param.UpperLevelRebateRatios = []int64{RebateRatioBase - DefaultRebateRatio + 1}
+	require.NotNil(t, param.ValidateGenesis())
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Rebate is the part of a trading fee which is paid to a referee
+type Rebate struct {
+	Referee sdk.AccAddress `json:"referee"`
+	Level   int            `json:"level"` // 1 for the direct referee, 2 for the referee's referee, and so on
+	Amount  int64          `json:"amount"`
+}
+
+type Rebates []Rebate
+
+// Total returns the sum of all the rebate amounts
+func (rs Rebates) Total() int64 {
+	total := int64(0)
+	for _, r := range rs {
+		total += r.Amount
+	}
+	return total
+}
+
+// DirectReferee returns the 1st level referee, or nil if there is none
+func (rs Rebates) DirectReferee() sdk.AccAddress {
+	for _, r := range rs {
+		if r.Level == 1 {
+			return r.Referee
+		}
+	}
+	return nil
+}
+
+// RebateTotal records the cumulative rebates a referee has earned
+type RebateTotal struct {
+	Referee     sdk.AccAddress `json:"referee"`
+	Total       int64          `json:"total"`
+	LevelTotals []int64        `json:"level_totals"` // LevelTotals[i] is earned as the (i+1)-th level referee
+}
+
+type RebateTotals []RebateTotal
+
+func NewRebateTotal(referee sdk.AccAddress) RebateTotal {
+	return RebateTotal{
+		Referee:     referee,
+		LevelTotals: make([]int64, MaxRefereeLevel),
+	}
+}
+
+func (rt *RebateTotal) Add(level int, amount int64) {
+	for len(rt.LevelTotals) < level {
+		rt.LevelTotals = append(rt.LevelTotals, 0)
+	}
+	rt.LevelTotals[level-1] += amount
+	rt.Total += amount
+}
+
+func (rt RebateTotal) String() string {
+	return fmt.Sprintf(`
+  Referee:     %s
+  Total:       %d
+  LevelTotals: %v`,
+		rt.Referee, rt.Total, rt.LevelTotals,
+	)
+}
@@ -1,15 +1,148 @@
 package authx
 
 import (
+	"fmt"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// FrozenCoinsSource returns the coins frozen by a module, indexed by the owners' addresses
+type FrozenCoinsSource func(ctx sdk.Context) map[string]sdk.Coins
+
+// register all the invariants of authx which do not depend on other modules
+func RegisterInvariants(ir sdk.InvariantRegistry, k AccountXKeeper) {
+	ir.RegisterRoute(ModuleName, "locked-coins", LockedCoinsInvariant(k))
+}
+
+// PreTotalSupplyInvariant syncs the authx module account with the coins locked and frozen in
+// all the AccountXs, such that supply's total-supply invariant can take them into account.
+// It must be registered before the invariants of supply.
 func PreTotalSupplyInvariant(k AccountXKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		k.PreTotalSupply(ctx)
 
-		// TODO
+		var msg string
+		count := 0
+		k.IterateAccounts(ctx, func(acc AccountX) bool {
+			for _, c := range acc.LockedCoins {
+				if !c.Coin.IsValid() || !c.Coin.IsPositive() {
+					count++
+					msg += fmt.Sprintf("\t%s has invalid locked coin %s\n", acc.Address, c.Coin)
+				}
+			}
+			if !acc.FrozenCoins.IsValid() {
+				count++
+				msg += fmt.Sprintf("\t%s has invalid frozen coins %s\n", acc.Address, acc.FrozenCoins)
+			}
+			return false
+		})
+
+		broken := count != 0
 		return sdk.FormatInvariant(ModuleName, "total supply",
-			"ok"), false
+			fmt.Sprintf("%d invalid locked or frozen coins found\n%s", count, msg)), broken
+	}
+}
+
+// LockedCoinsInvariant checks that every locked coin has its entry in the unlocked coins queue,
+// and every entry in the queue has locked coins to unlock
+func LockedCoinsInvariant(k AccountXKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		k.IterateAccounts(ctx, func(acc AccountX) bool {
+			for _, c := range acc.LockedCoins {
+				if !k.HasUnlockedCoinsQueue(ctx, c.UnlockTime, acc.Address) {
+					count++
+					msg += fmt.Sprintf("\t%s has locked coin %s unlocked at %d, which is not in the queue\n",
+						acc.Address, c.Coin, c.UnlockTime)
+				}
+			}
+			return false
+		})
+
+		k.IterateUnlockedCoinsQueue(ctx, func(unlockTime int64, addr sdk.AccAddress) bool {
+			if !hasLockedCoinAt(k, ctx, addr, unlockTime) {
+				count++
+				msg += fmt.Sprintf("\t%s has nothing to unlock at %d, but is in the queue\n", addr, unlockTime)
+			}
+			return false
+		})
+
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "locked coins",
+			fmt.Sprintf("%d mismatches between the locked coins and the unlocked coins queue found\n%s", count, msg)), broken
+	}
+}
+
+// FrozenCoinsInvariant checks that the frozen coins of every AccountX equal the sum of the coins
+// frozen on it by the modules, which are given by the sources
+func FrozenCoinsInvariant(k AccountXKeeper, sources ...FrozenCoinsSource) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := make(map[string]sdk.Coins)
+		for _, source := range sources {
+			for addr, coins := range source(ctx) {
+				expected[addr] = expected[addr].Add(coins)
+			}
+		}
+
+		var msg string
+		count := 0
+		k.IterateAccounts(ctx, func(acc AccountX) bool {
+			coins := expected[string(acc.Address)]
+			delete(expected, string(acc.Address))
+			if !equalCoins(coins, acc.FrozenCoins) {
+				count++
+				msg += fmt.Sprintf("\t%s has frozen coins %s, but the modules have frozen %s\n",
+					acc.Address, acc.FrozenCoins, coins)
+			}
+			return false
+		})
+
+		// the coins frozen on addresses which have no AccountX
+		addrs := make([]string, 0, len(expected))
+		for addr, coins := range expected {
+			if !coins.IsZero() {
+				addrs = append(addrs, addr)
+			}
+		}
+		sort.Strings(addrs)
+		for _, addr := range addrs {
+			count++
+			msg += fmt.Sprintf("\t%s has no frozen coins, but the modules have frozen %s\n",
+				sdk.AccAddress(addr), expected[addr])
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "frozen coins",
+			fmt.Sprintf("%d mismatches of frozen coins found\n%s", count, msg)), broken
+	}
+}
+
+func hasLockedCoinAt(k AccountXKeeper, ctx sdk.Context, addr sdk.AccAddress, unlockTime int64) bool {
+	acc, ok := k.GetAccountX(ctx, addr)
+	if !ok {
+		return false
+	}
+	for _, c := range acc.LockedCoins {
+		if c.UnlockTime == unlockTime {
+			return true
+		}
+	}
+	return false
+}
+
+// Coins.IsEqual panics when the denoms are different
+func equalCoins(a, b sdk.Coins) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = a.Sort(), b.Sort()
+	for i := range a {
+		if a[i].Denom != b[i].Denom || !a[i].Amount.Equal(b[i].Amount) {
+			return false
+		}
 	}
+	return true
 }
@@ -0,0 +1,86 @@
+package authx_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+)
+
+func TestLockedCoinsInvariant(t *testing.T) {
+	input := setupTestInput()
+	invariant := authx.LockedCoinsInvariant(input.axk)
+	unlockTime := input.ctx.BlockHeader().Time.Unix() + 100
+
+	addr := sdk.AccAddress("addr")
+	accx := authx.NewAccountXWithAddress(addr)
+	accx.LockedCoins = authx.LockedCoins{authx.NewLockedCoin("abc", sdk.NewInt(10), unlockTime)}
+	input.axk.SetAccountX(input.ctx, accx)
+	_, broken := invariant(input.ctx)
+	require.True(t, broken)
+
+	input.axk.InsertUnlockedCoinsQueue(input.ctx, unlockTime, addr)
+	_, broken = invariant(input.ctx)
+	require.False(t, broken)
+
+	input.axk.InsertUnlockedCoinsQueue(input.ctx, unlockTime+1, addr)
+	_, broken = invariant(input.ctx)
+	require.True(t, broken)
+	input.axk.RemoveFromUnlockedCoinsQueue(input.ctx, unlockTime+1, addr)
+
+	// the queue is rebuilt from the genesis
+	input = setupTestInput()
+	authx.InitGenesis(input.ctx, input.axk, authx.NewGenesisState(authx.DefaultParams(), []authx.AccountX{accx}))
+	_, broken = authx.LockedCoinsInvariant(input.axk)(input.ctx)
+	require.False(t, broken)
+}
+
+func TestFrozenCoinsInvariant(t *testing.T) {
+	input := setupTestInput()
+	addr1 := sdk.AccAddress("addr1")
+	addr2 := sdk.AccAddress("addr2")
+	frozen := make(map[string]sdk.Coins)
+	source := func(ctx sdk.Context) map[string]sdk.Coins { return frozen }
+	invariant := authx.FrozenCoinsInvariant(input.axk, source, source)
+
+	_, broken := invariant(input.ctx)
+	require.False(t, broken)
+
+	accx := authx.NewAccountXWithAddress(addr1)
+	accx.FrozenCoins = sdk.NewCoins(sdk.NewInt64Coin("abc", 20))
+	input.axk.SetAccountX(input.ctx, accx)
+	_, broken = invariant(input.ctx)
+	require.True(t, broken)
+
+	frozen[string(addr1)] = sdk.NewCoins(sdk.NewInt64Coin("abc", 10))
+	_, broken = invariant(input.ctx)
+	require.False(t, broken)
+
+	frozen[string(addr1)] = sdk.NewCoins(sdk.NewInt64Coin("xyz", 10))
+	_, broken = invariant(input.ctx)
+	require.True(t, broken)
+
+	frozen[string(addr1)] = sdk.NewCoins(sdk.NewInt64Coin("abc", 10))
+	frozen[string(addr2)] = sdk.NewCoins(sdk.NewInt64Coin("abc", 1))
+	_, broken = invariant(input.ctx)
+	require.True(t, broken)
+}
+
+func TestPreTotalSupplyInvariant(t *testing.T) {
+	input := setupTestInput()
+	accx := authx.NewAccountXWithAddress(sdk.AccAddress("addr"))
+	accx.FrozenCoins = sdk.NewCoins(sdk.NewInt64Coin("abc", 20))
+	input.axk.SetAccountX(input.ctx, accx)
+
+	_, broken := authx.PreTotalSupplyInvariant(input.axk)(input.ctx)
+	require.False(t, broken)
+	macc := input.sk.GetModuleAccount(input.ctx, authx.ModuleName)
+	require.Equal(t, accx.FrozenCoins, macc.GetCoins())
+
+	accx.LockedCoins = authx.LockedCoins{authx.NewLockedCoin("abc", sdk.ZeroInt(), 100)}
+	input.axk.SetAccountX(input.ctx, accx)
+	_, broken = authx.PreTotalSupplyInvariant(input.axk)(input.ctx)
+	require.True(t, broken)
+}
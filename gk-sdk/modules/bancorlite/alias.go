@@ -6,20 +6,23 @@ import (
 )
 
 const (
-	StoreKey   = types.StoreKey
-	ModuleName = types.ModuleName
+	StoreKey          = types.StoreKey
+	ModuleName        = types.ModuleName
+	DefaultParamspace = types.DefaultParamspace
 )
 
 var (
 	NewBaseKeeper       = keepers.NewKeeper
 	NewBancorInfoKeeper = keepers.NewBancorInfoKeeper
 	DefaultParams       = types.DefaultParams
+	ValidateParams      = types.ValidateParams
 	ModuleCdc           = types.ModuleCdc
 )
 
 type (
 	Keeper                     = keepers.Keeper
 	BancorInfo                 = keepers.BancorInfo
+	BancorInfoDisplay          = keepers.BancorInfoDisplay
 	MsgBancorTradeInfoForKafka = types.MsgBancorTradeInfoForKafka
 	MsgBancorInfoForKafka      = types.MsgBancorInfoForKafka
 	MsgBancorInit              = types.MsgBancorInit
@@ -1,5 +1,10 @@
 package bancorlite
 
+import (
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
+	"github.com/coinexchain/cet-sdk/msgqueue"
+)
+
 // Market module event types
 var (
 	AttributeValueCategory = ModuleName
@@ -25,3 +30,9 @@ var (
 	KafkaBancorCancel = "bancor_cancel"
 	KafkaBancorInfo   = "bancor_info"
 )
+
+func init() {
+	msgqueue.RegisterSchema(KafkaBancorTrade, 1, MsgBancorTradeInfoForKafka{})
+	msgqueue.RegisterSchema(KafkaBancorCreate, 1, keepers.BancorInfoDisplay{})
+	msgqueue.RegisterSchema(KafkaBancorInfo, 1, keepers.BancorInfoDisplay{})
+}
@@ -198,19 +198,18 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 	}
 
 	commission := getTradeFee(ctx, k, msg, diff)
-	rebateAcc, rebate, balance, exist := k.GetRebate(ctx, msg.Sender, commission)
-	if exist {
-		if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, balance))); err != nil {
-			return err.Result()
-		}
-		if err := k.SendCoins(ctx, msg.Sender, rebateAcc, sdk.NewCoins(sdk.NewCoin(dex.CET, rebate))); err != nil {
-			return err.Result()
-		}
-	} else {
-		if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, commission))); err != nil {
+	rebates, balance := k.GetRebates(ctx, msg.Sender, commission)
+	if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, balance))); err != nil {
+		return err.Result()
+	}
+	for _, rebate := range rebates {
+		if err := k.SendCoins(ctx, msg.Sender, rebate.Referee, dex.NewCetCoins(rebate.Amount)); err != nil {
 			return err.Result()
 		}
 	}
+	k.AddRebates(ctx, rebates)
+	rebateAcc := rebates.DirectReferee()
+	rebate := sdk.NewInt(rebates.Total())
 
 	if err := swapStockAndMoney(ctx, k, msg.Sender, bi.Owner, coinsFromPool, coinsToPool); err != nil {
 		return err.Result()
@@ -237,6 +236,7 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 		RebateAmount:      rebate.Int64(),
 		RebateRefereeAddr: rebateAcc,
 		BlockHeight:       ctx.BlockHeight(),
+		Rebates:           rebates,
 	}
 	info := keepers.NewBancorInfoDisplay(&biNew)
 	fillMsgQueue(ctx, k, KafkaBancorTrade, m)
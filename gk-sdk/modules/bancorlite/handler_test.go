@@ -30,6 +30,7 @@ type testInput struct {
 	bik     keepers.Keeper
 	handler sdk.Handler
 	akp     auth.AccountKeeper
+	axk     authx.AccountXKeeper
 	cdc     *codec.Codec // mk.cdc
 }
 
@@ -127,7 +128,7 @@ func prepareMockInput(t *testing.T, addrForbid, tokenForbid bool) testInput {
 	prepareBankx(ctx, testApp.BankxKeeper)
 	prepareMarket(ctx, testApp.MarketKeeper)
 
-	return testInput{ctx: ctx, bik: testApp.BancorKeeper, handler: bancorlite.NewHandler(testApp.BancorKeeper), akp: testApp.AccountKeeper, cdc: testApp.Cdc}
+	return testInput{ctx: ctx, bik: testApp.BancorKeeper, handler: bancorlite.NewHandler(testApp.BancorKeeper), akp: testApp.AccountKeeper, axk: testApp.AccountXKeeper, cdc: testApp.Cdc}
 }
 
 func Test_handleMsgBancorInit(t *testing.T) {
@@ -103,7 +103,7 @@ func (bi *BancorInfo) IsConsistent() bool {
 		MaxMoney:  bi.MaxMoney,
 	}
 	ar, ok := types.CheckAR(biMsg, bi.InitPrice, bi.MaxPrice)
-	if ok || ar != bi.AR {
+	if !ok || ar != bi.AR {
 		return false
 	}
 	biNew := *bi
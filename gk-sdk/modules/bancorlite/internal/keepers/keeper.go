@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
 	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
@@ -200,19 +201,17 @@ func (keeper *Keeper) GetRebateRatioBase(ctx sdk.Context) int64 {
 	return keeper.axk.GetRebateRatioBase(ctx)
 }
 
-func (keeper *Keeper) GetRebate(ctx sdk.Context, address sdk.AccAddress, total sdk.Int) (acc sdk.AccAddress, rebate, balance sdk.Int, exist bool) {
-	acc = keeper.axk.GetRefereeAddr(ctx, address)
-	if len(acc) == 0 {
-		return acc, sdk.ZeroInt(), sdk.ZeroInt(), false
-	}
-	ratio := keeper.GetRebateRatio(ctx)
-	base := keeper.GetRebateRatioBase(ctx)
-	rebate = total.MulRaw(ratio).QuoRaw(base)
-	balance = total.Sub(rebate)
-	exist = true
+// GetRebates returns the rebates paid to the referees of address, and the rest of the total fee
+func (keeper *Keeper) GetRebates(ctx sdk.Context, address sdk.AccAddress, total sdk.Int) (rebates authx.Rebates, balance sdk.Int) {
+	rebates = keeper.axk.CalcRebates(ctx, address, total.Int64())
+	balance = total.SubRaw(rebates.Total())
 	return
 }
 
+func (keeper *Keeper) AddRebates(ctx sdk.Context, rebates authx.Rebates) {
+	keeper.axk.AddRebates(ctx, rebates)
+}
+
 func (keeper *Keeper) IsSubscribed(topic string) bool {
 	return keeper.msgProducer.IsSubscribed(topic)
 }
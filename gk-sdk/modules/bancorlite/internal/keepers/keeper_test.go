@@ -282,11 +282,23 @@ func TestKeeper_GetRebate(t *testing.T) {
 	ctx := sdk.NewContext(app.Cms, abci.Header{}, false, log.NewNopLogger())
 	app.AccountXKeeper.SetParams(ctx, authx.DefaultParams())
 	app.AccountXKeeper.SetAccountX(ctx, authx.NewAccountX(owner, false, nil, nil, referee, 0))
-	acc, rebate, balance, exist := app.BancorKeeper.GetRebate(ctx, owner, sdk.NewInt(100000))
-	require.Equal(t, acc, referee)
-	require.Equal(t, int64(20000), rebate.Int64())
+	rebates, balance := app.BancorKeeper.GetRebates(ctx, owner, sdk.NewInt(100000))
+	require.Equal(t, 1, len(rebates))
+	require.Equal(t, referee, rebates[0].Referee)
+	require.Equal(t, int64(20000), rebates[0].Amount)
 	require.Equal(t, int64(80000), balance.Int64())
-	require.Equal(t, exist, true)
+
+	referee2 := sdk.AccAddress("referee2")
+	params := authx.DefaultParams()
+	params.UpperLevelRebateRatios = []int64{1000}
+	app.AccountXKeeper.SetParams(ctx, params)
+	app.AccountXKeeper.SetAccountX(ctx, authx.NewAccountX(referee, false, nil, nil, referee2, 0))
+	rebates, balance = app.BancorKeeper.GetRebates(ctx, owner, sdk.NewInt(100000))
+	require.Equal(t, 2, len(rebates))
+	require.Equal(t, referee2, rebates[1].Referee)
+	require.Equal(t, 2, rebates[1].Level)
+	require.Equal(t, int64(10000), rebates[1].Amount)
+	require.Equal(t, int64(70000), balance.Int64())
 }
 
 func TestCurrentPriceCalculate(t *testing.T) {
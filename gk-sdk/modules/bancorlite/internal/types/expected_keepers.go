@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
 )
 
 // Bankx Keeper will implement the interface
@@ -31,4 +33,6 @@ type ExpectedAuthXKeeper interface {
 	GetRefereeAddr(ctx sdk.Context, accAddr sdk.AccAddress) sdk.AccAddress
 	GetRebateRatio(ctx sdk.Context) int64
 	GetRebateRatioBase(ctx sdk.Context) int64
+	CalcRebates(ctx sdk.Context, addr sdk.AccAddress, fee int64) authx.Rebates
+	AddRebates(ctx sdk.Context, rebates authx.Rebates)
 }
@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
 )
 
 //kafka msg
@@ -42,6 +44,7 @@ type MsgBancorTradeInfoForKafka struct {
 	RebateAmount      int64          `json:"rebate_amount"`
 	RebateRefereeAddr sdk.AccAddress `json:"rebate_referee_addr"`
 	BlockHeight       int64          `json:"block_height"`
+	Rebates           authx.Rebates  `json:"rebates,omitempty"`
 }
 
 type MsgBancorCancelForKafka struct {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -62,6 +63,13 @@ func (p *Params) ValidateGenesis() error {
 	return nil
 }
 
+// ValidateParams validates the bancorlite params in the subspace
+func ValidateParams(ctx sdk.Context, ss params.Subspace) error {
+	var p Params
+	ss.GetParamSet(ctx, &p)
+	return p.ValidateGenesis()
+}
+
 // Equal returns a boolean determining if two Params types are identical.
 func (p Params) Equal(p2 Params) bool {
 	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
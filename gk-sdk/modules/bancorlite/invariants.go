@@ -0,0 +1,52 @@
+package bancorlite
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/keepers"
+)
+
+// register all the invariants of bancorlite
+func RegisterInvariants(ir sdk.InvariantRegistry, k keepers.Keeper) {
+	ir.RegisterRoute(ModuleName, "pools", PoolsInvariant(k))
+}
+
+// PoolsInvariant checks that the stock and money in every pool are consistent with its bancor curve
+func PoolsInvariant(k keepers.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		k.Iterate(ctx, func(bi *keepers.BancorInfo) {
+			if bi.MoneyInPool.IsNegative() || !bi.IsConsistent() {
+				count++
+				msg += fmt.Sprintf("\t%s is inconsistent: stock in pool %s, money in pool %s\n",
+					bi.GetSymbol(), bi.StockInPool, bi.MoneyInPool)
+			}
+		})
+
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "pools",
+			fmt.Sprintf("%d inconsistent pools found\n%s", count, msg)), broken
+	}
+}
+
+// FrozenCoinsInPools returns the stock and money in all the pools, which are frozen on their owners.
+// The negative amounts are left to PoolsInvariant.
+func FrozenCoinsInPools(k keepers.Keeper) authx.FrozenCoinsSource {
+	return func(ctx sdk.Context) map[string]sdk.Coins {
+		frozen := make(map[string]sdk.Coins)
+		add := func(addr sdk.AccAddress, denom string, amount sdk.Int) {
+			if amount.IsPositive() {
+				frozen[string(addr)] = frozen[string(addr)].Add(sdk.NewCoins(sdk.NewCoin(denom, amount)))
+			}
+		}
+		k.Iterate(ctx, func(bi *keepers.BancorInfo) {
+			add(bi.Owner, bi.Stock, bi.StockInPool)
+			add(bi.Owner, bi.Money, bi.MoneyInPool)
+		})
+		return frozen
+	}
+}
@@ -0,0 +1,83 @@
+package bancorlite_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
+)
+
+func TestInvariants(t *testing.T) {
+	testApp, ctx := prepareApp()
+	prepareSupply(ctx, testApp.SupplyKeeper)
+	prepareAccounts(ctx, testApp.AccountKeeper)
+	prepareBancor(ctx, testApp.BancorKeeper)
+	prepareBank(ctx, testApp.BankKeeper)
+	prepareBankx(ctx, testApp.BankxKeeper)
+	prepareMarket(ctx, testApp.MarketKeeper)
+	testApp.AccountXKeeper.SetParams(ctx, authx.DefaultParams())
+	testApp.AssetKeeper.SetParams(ctx, asset.DefaultParams())
+	for _, msg := range []asset.MsgIssueToken{
+		asset.NewMsgIssueToken(stock, stock, sdk.NewInt(issueAmount), haveCetAddress,
+			false, false, false, false, "", "", asset.TestIdentityString),
+		asset.NewMsgIssueToken(money, money, sdk.NewInt(issueAmount), notHaveCetAddress,
+			false, false, false, false, "", "", asset.TestIdentityString),
+	} {
+		require.True(t, asset.NewHandler(testApp.AssetKeeper)(ctx, msg).IsOK())
+	}
+	input := testInput{ctx: ctx, bik: testApp.BancorKeeper, handler: bancorlite.NewHandler(testApp.BancorKeeper),
+		akp: testApp.AccountKeeper, axk: testApp.AccountXKeeper, cdc: testApp.Cdc}
+
+	poolsInvariant := bancorlite.PoolsInvariant(input.bik)
+	frozenInvariant := authx.FrozenCoinsInvariant(input.axk, bancorlite.FrozenCoinsInPools(input.bik))
+
+	msgInit := types.MsgBancorInit{
+		Owner:              haveCetAddress,
+		Stock:              stock,
+		Money:              money,
+		InitPrice:          "0",
+		MaxSupply:          sdk.NewInt(1000000),
+		MaxMoney:           sdk.NewInt(3000000),
+		MaxPrice:           "10",
+		EarliestCancelTime: 0,
+	}
+	require.True(t, input.handler(ctx, msgInit).IsOK())
+	_, broken := poolsInvariant(input.ctx)
+	require.False(t, broken)
+	_, broken = frozenInvariant(input.ctx)
+	require.False(t, broken)
+
+	msg := types.MsgBancorTrade{
+		Sender:     tradeAddr,
+		Stock:      stock,
+		Money:      money,
+		Amount:     100000,
+		IsBuy:      true,
+		MoneyLimit: 0,
+	}
+	res := input.handler(input.ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	_, broken = poolsInvariant(input.ctx)
+	require.False(t, broken)
+	_, broken = frozenInvariant(input.ctx)
+	require.False(t, broken)
+
+	bi := input.bik.Load(input.ctx, stock+"/"+money)
+	bi.StockInPool = bi.StockInPool.SubRaw(1)
+	input.bik.Save(input.ctx, bi)
+	_, broken = poolsInvariant(input.ctx)
+	require.True(t, broken)
+	_, broken = frozenInvariant(input.ctx)
+	require.True(t, broken)
+
+	bi.StockInPool = sdk.NewInt(-1)
+	input.bik.Save(input.ctx, bi)
+	_, broken = poolsInvariant(input.ctx)
+	require.True(t, broken)
+}
@@ -71,6 +71,7 @@ func NewAppModule(blKeeper keepers.Keeper) AppModule {
 
 // registers
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.blKeeper)
 }
 
 // routes
@@ -14,6 +14,15 @@ const (
 	DefaultParamspace = types.DefaultParamspace
 )
 
+const (
+	SendLockCoinsKey = types.SendLockCoinsKey
+
+	EventTypeTransfer      = types.EventTypeTransfer
+	AttributeKeyRecipient  = types.AttributeKeyRecipient
+	AttributeKeyAmount     = types.AttributeKeyAmount
+	AttributeKeyUnlockTime = types.AttributeKeyUnlockTime
+)
+
 const (
 	Create                    = types.Create
 	Return                    = types.Return
@@ -27,6 +36,7 @@ var (
 	RegisterCodec                      = types.RegisterCodec
 	ParamKeyTable                      = types.ParamKeyTable
 	DefaultParams                      = types.DefaultParams
+	ValidateParams                     = types.ValidateParams
 	NewParams                          = types.NewParams
 	NewKeeper                          = keeper.NewKeeper
 	NewMsgSend                         = types.NewMsgSend
@@ -48,4 +58,5 @@ type (
 	MsgSetMemoRequired = types.MsgSetMemoRequired
 	MsgMultiSend       = types.MsgMultiSend
 	MsgSupervisedSend  = types.MsgSupervisedSend
+	LockedSendMsg      = types.LockedSendMsg
 )
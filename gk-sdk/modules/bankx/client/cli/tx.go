@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	aliascli "github.com/coinexchain/cet-sdk/modules/alias/client/cli"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
 	"github.com/coinexchain/cosmos-utils/client/cliutil"
 )
@@ -29,9 +30,14 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "send [to_address] [amount]",
 		Short: "Create and sign a send tx",
-		Args:  cobra.ExactArgs(2),
+		Long: `Create and sign a send tx. The recipient can be written as a bech32 address or as @alias.
+
+Example:
+    cetcli tx send @alice 1000000000cet --from=sender_user
+`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			to, err := sdk.AccAddressFromBech32(args[0])
+			to, toAlias, err := aliascli.ResolveAddress(args[0])
 			if err != nil {
 				return err
 			}
@@ -54,6 +60,7 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgSend(nil, to, coins, unlockTime)
+			msg.ToAlias = toAlias
 			return cliutil.CliRunCommand(cdc, &msg)
 		},
 	}
@@ -116,7 +123,7 @@ Example:
 
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			toAddr, toAlias, err := aliascli.ResolveAddress(args[0])
 			if err != nil {
 				return err
 			}
@@ -153,6 +160,7 @@ Example:
 			operation := byte(viper.GetInt(FlagOperation))
 
 			msg := types.NewMsgSupervisedSend(fromAddr, supervisorAddr, toAddr, coin, unlockTime, reward, operation)
+			msg.ToAlias = toAlias
 			return cliutil.CliRunCommand(cdc, &msg)
 		},
 	}
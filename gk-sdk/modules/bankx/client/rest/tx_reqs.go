@@ -17,6 +17,7 @@ type (
 		BaseReq    rest.BaseReq `json:"base_req"`
 		Amount     sdk.Coins    `json:"amount"`
 		UnlockTime int64        `json:"unlock_time"`
+		ToAlias    string       `json:"to_alias,omitempty"` // the alias which was resolved to the address in path
 	}
 
 	memoReq struct {
@@ -32,6 +33,7 @@ type (
 		Supervisor string       `json:"supervisor,omitempty"`
 		Reward     int64        `json:"reward,omitempty"`
 		Operation  byte         `json:"operation"`
+		ToAlias    string       `json:"to_alias,omitempty"` // the alias which was resolved to the address in path
 	}
 )
 
@@ -43,7 +45,9 @@ func (req *sendReq) GetBaseReq() *rest.BaseReq {
 }
 func (req *sendReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
 	toAddr := getAddr(r)
-	return types.NewMsgSend(sender, toAddr, req.Amount, req.UnlockTime), nil
+	msg := types.NewMsgSend(sender, toAddr, req.Amount, req.UnlockTime)
+	msg.ToAlias = req.ToAlias
+	return msg, nil
 }
 
 func (req *memoReq) New() restutil.RestReq {
@@ -85,8 +89,10 @@ func (req *sendSupervisedReq) GetMsg(r *http.Request, addr sdk.AccAddress) (sdk.
 	} else {
 		fromAddr = addr
 	}
-	return types.NewMsgSupervisedSend(fromAddr, supervisorAddr, toAddr, req.Amount, req.UnlockTime,
-		req.Reward, req.Operation), nil
+	msg := types.NewMsgSupervisedSend(fromAddr, supervisorAddr, toAddr, req.Amount, req.UnlockTime,
+		req.Reward, req.Operation)
+	msg.ToAlias = req.ToAlias
+	return msg, nil
 }
 
 func getAddr(r *http.Request) sdk.AccAddress {
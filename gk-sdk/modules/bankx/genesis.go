@@ -37,14 +37,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 // ValidateGenesis performs basic validation of asset genesis data returning an
 // error for any failed validation criteria.
 func (data GenesisState) ValidateGenesis() error {
-	if activationFee := data.Params.ActivationFee; activationFee < 0 {
-		return types.ErrInvalidActivatingFee()
-	}
-	if freeTime := data.Params.LockCoinsFreeTime; freeTime < 0 {
-		return types.ErrInvalidLockCoinsFreeTime()
-	}
-	if lockCoinsFee := data.Params.LockCoinsFeePerDay; lockCoinsFee < 0 {
-		return types.ErrInvalidLockCoinsFee()
-	}
-	return nil
+	return data.Params.ValidateGenesis()
 }
@@ -2,6 +2,7 @@ package bankx
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -108,6 +109,7 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg types.MsgSend) sdk.Result {
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+	ctx.EventManager().EmitEvents(dex.NewResolveAliasEvents(msg.GetAliasRefs()))
 
 	time := msg.UnlockTime
 	if time != 0 {
@@ -126,7 +128,7 @@ func lockedSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt
 		return err.Result()
 	}
 
-	fillMsgQueue(ctx, k, "send_lock_coins", types.NewLockedSendMsg(fromAddr, toAddr, amt, unlockTime))
+	fillMsgQueue(ctx, k, types.SendLockCoinsKey, types.NewLockedSendMsg(fromAddr, toAddr, amt, unlockTime))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -134,6 +136,7 @@ func lockedSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt
 			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockTime, strconv.FormatInt(unlockTime, 10)),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -229,7 +232,7 @@ func handleMsgSupervisedSend(ctx sdk.Context, k Keeper, msg types.MsgSupervisedS
 			return err.Result()
 		}
 
-		fillMsgQueue(ctx, k, "send_lock_coins", types.NewSupervisedSendMsg(msg.FromAddress, msg.ToAddress,
+		fillMsgQueue(ctx, k, types.SendLockCoinsKey, types.NewSupervisedSendMsg(msg.FromAddress, msg.ToAddress,
 			msg.Supervisor, msg.Amount, msg.UnlockTime, msg.Reward))
 
 	} else {
@@ -246,18 +249,23 @@ func handleMsgSupervisedSend(ctx sdk.Context, k Keeper, msg types.MsgSupervisedS
 	if msg.Operation == types.Return || msg.Operation == types.EarlierUnlockBySupervisor {
 		sender = msg.Supervisor
 	}
+	transfer := sdk.NewEvent(
+		types.EventTypeTransfer,
+		sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+	)
+	if msg.Operation == types.Create {
+		transfer = transfer.AppendAttributes(sdk.NewAttribute(types.AttributeKeyUnlockTime, strconv.FormatInt(msg.UnlockTime, 10)))
+	}
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
 		),
-		sdk.NewEvent(
-			types.EventTypeTransfer,
-			sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
-		),
+		transfer,
 	})
+	ctx.EventManager().EmitEvents(dex.NewResolveAliasEvents(msg.GetAliasRefs()))
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
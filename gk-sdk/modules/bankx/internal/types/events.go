@@ -7,5 +7,8 @@ const (
 	AttributeKeySender    = "sender"
 	AttributeKeyAmount    = "amount"
 
+	// set on the transfers of locked coins
+	AttributeKeyUnlockTime = "unlock_time"
+
 	AttributeValueCategory = ModuleName
 )
@@ -2,8 +2,18 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/msgqueue"
 )
 
+// SendLockCoinsKey is the msgqueue key of the LockedSendMsg published by both
+// the locked sends and the supervised sends
+const SendLockCoinsKey = "send_lock_coins"
+
+func init() {
+	msgqueue.RegisterSchema(SendLockCoinsKey, 1, LockedSendMsg{})
+}
+
 type LockedSendMsg struct {
 	FromAddress sdk.AccAddress `json:"from_address"`
 	ToAddress   sdk.AccAddress `json:"to_address"`
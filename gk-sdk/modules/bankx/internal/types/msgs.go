@@ -6,6 +6,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 var _ sdk.Msg = MsgSetMemoRequired{}
@@ -46,12 +48,14 @@ func (msg MsgSetMemoRequired) GetSigners() []sdk.AccAddress {
 }
 
 var _ sdk.Msg = MsgSend{}
+var _ dex.AliasedMsg = MsgSend{}
 
 type MsgSend struct {
 	FromAddress sdk.AccAddress `json:"from_address"`
 	ToAddress   sdk.AccAddress `json:"to_address"`
 	Amount      sdk.Coins      `json:"amount"`
 	UnlockTime  int64          `json:"unlock_time"`
+	ToAlias     string         `json:"to_alias,omitempty"` // set if ToAddress was written as an alias
 }
 
 func (msg *MsgSend) SetAccAddress(addr sdk.AccAddress) {
@@ -101,6 +105,13 @@ func (msg MsgSend) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
+func (msg MsgSend) GetAliasRefs() []dex.AliasRef {
+	if len(msg.ToAlias) == 0 {
+		return nil
+	}
+	return []dex.AliasRef{{Alias: msg.ToAlias, Addr: msg.ToAddress}}
+}
+
 // MsgMultiSend - high level transaction of the coin module
 type MsgMultiSend struct {
 	Inputs  []bank.Input  `json:"inputs" yaml:"inputs"`
@@ -176,6 +187,7 @@ func ValidateInputsOutputs(inputs []bank.Input, outputs []bank.Output) sdk.Error
 }
 
 var _ sdk.Msg = MsgSupervisedSend{}
+var _ dex.AliasedMsg = MsgSupervisedSend{}
 
 // MsgSupervisedSend
 type MsgSupervisedSend struct {
@@ -186,6 +198,7 @@ type MsgSupervisedSend struct {
 	UnlockTime  int64          `json:"unlock_time"`
 	Reward      int64          `json:"reward"`
 	Operation   byte           `json:"operation"`
+	ToAlias     string         `json:"to_alias,omitempty"` // set if ToAddress was written as an alias
 }
 
 const (
@@ -271,3 +284,10 @@ func (msg MsgSupervisedSend) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{msg.FromAddress}
 }
+
+func (msg MsgSupervisedSend) GetAliasRefs() []dex.AliasRef {
+	if len(msg.ToAlias) == 0 {
+		return nil
+	}
+	return []dex.AliasRef{{Alias: msg.ToAlias, Addr: msg.ToAddress}}
+}
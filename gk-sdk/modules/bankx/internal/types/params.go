@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -48,6 +49,26 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	}
 }
 
+func (p *Params) ValidateGenesis() error {
+	if p.ActivationFee < 0 {
+		return ErrInvalidActivatingFee()
+	}
+	if p.LockCoinsFreeTime < 0 {
+		return ErrInvalidLockCoinsFreeTime()
+	}
+	if p.LockCoinsFeePerDay < 0 {
+		return ErrInvalidLockCoinsFee()
+	}
+	return nil
+}
+
+// ValidateParams validates the bankx params in the subspace
+func ValidateParams(ctx sdk.Context, ss params.Subspace) error {
+	var p Params
+	ss.GetParamSet(ctx, &p)
+	return p.ValidateGenesis()
+}
+
 func (p Params) String() string {
 	return fmt.Sprintf(`BankX Params:
   ActivationFee:      %d
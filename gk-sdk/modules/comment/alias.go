@@ -6,12 +6,16 @@ import (
 )
 
 const (
-	StoreKey   = types.StoreKey
-	ModuleName = types.ModuleName
+	StoreKey          = types.StoreKey
+	ModuleName        = types.ModuleName
+	DefaultParamspace = types.DefaultParamspace
 )
 
 var (
-	NewBaseKeeper = keepers.NewKeeper
+	NewBaseKeeper  = keepers.NewKeeper
+	DefaultParams  = types.DefaultParams
+	ValidateParams = types.ValidateParams
+	NewQuerier     = keepers.NewQuerier
 )
 
 type (
@@ -19,4 +23,14 @@ type (
 	TokenComment    = types.TokenComment
 	CommentRef      = types.CommentRef
 	MsgCommentToken = types.MsgCommentToken
+	Params          = types.Params
+	IndexedComment  = types.IndexedComment
+	CommentThread   = types.CommentThread
+	TokenModeration = types.TokenModeration
+
+	MsgHideComment    = types.MsgHideComment
+	MsgUnhideComment  = types.MsgUnhideComment
+	MsgBanCommenter   = types.MsgBanCommenter
+	MsgUnbanCommenter = types.MsgUnbanCommenter
+	MsgSetMinDonation = types.MsgSetMinDonation
 )
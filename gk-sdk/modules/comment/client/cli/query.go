@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/comment/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
+	"github.com/coinexchain/cosmos-utils/client/cliutil"
+)
+
+const (
+	FlagFromID = "from-id"
+	FlagLimit  = "limit"
+)
+
+func QueryParamsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query comment params",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryParameters)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+}
+
+func QueryCommentCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "comment [token] [id]",
+		Short: "Query an indexed comment of a token",
+		Long: `Query an indexed comment of a token, with its followup count and reward totals.
+
+Example:
+	cetcli query comment comment cet 42`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			param, err := getCommentParam(args)
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryComment)
+			return cliutil.CliQuery(cdc, route, param)
+		},
+	}
+}
+
+func QueryThreadCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "thread [token] [id]",
+		Short: "Query an indexed comment of a token and its followups",
+		Long: `Query an indexed comment of a token and the indexed comments which reference it.
+
+Example:
+	cetcli query comment thread cet 42`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			param, err := getCommentParam(args)
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryThread)
+			return cliutil.CliQuery(cdc, route, param)
+		},
+	}
+}
+
+func QueryTokenCommentsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "comments [token]",
+		Short: "Query the indexed comments of a token",
+		Long: `Query the indexed comments of a token, ordered by id.
+
+Example:
+	cetcli query comment comments cet --from-id=100 --limit=20`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryTokenComments)
+			param := &keepers.QueryTokenCommentsParam{
+				Token:  args[0],
+				FromID: uint64(viper.GetInt64(FlagFromID)),
+				Limit:  viper.GetInt(FlagLimit),
+			}
+			return cliutil.CliQuery(cdc, route, param)
+		},
+	}
+	cmd.Flags().Int64(FlagFromID, 0, "the smallest id of the returned comments")
+	cmd.Flags().Int(FlagLimit, keepers.DefaultQueryLimit, "the max number of the returned comments")
+	return cmd
+}
+
+func QuerySenderCommentsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "comments-of-address [address]",
+		Short: "Query the indexed comments posted by an address",
+		Long: `Query the indexed comments posted by an address, ordered by token and id.
+
+Example:
+	cetcli query comment comments-of-address coinex1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4 --limit=20`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QuerySenderComments)
+			param := &keepers.QuerySenderCommentsParam{
+				Sender: acc,
+				Limit:  viper.GetInt(FlagLimit),
+			}
+			return cliutil.CliQuery(cdc, route, param)
+		},
+	}
+	cmd.Flags().Int(FlagLimit, keepers.DefaultQueryLimit, "the max number of the returned comments")
+	return cmd
+}
+
+func QueryModerationCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "moderation [token]",
+		Short: "Query how the owner of a token moderates its comments",
+		Long: `Query the minimum donation, the banned addresses and the hidden comments of a token.
+
+Example:
+	cetcli query comment moderation abc`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryModeration)
+			return cliutil.CliQuery(cdc, route, &keepers.QueryModerationParam{Token: args[0]})
+		},
+	}
+}
+
+func getCommentParam(args []string) (*keepers.QueryCommentParam, error) {
+	id, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &keepers.QueryCommentParam{Token: args[0], ID: id}, nil
+}
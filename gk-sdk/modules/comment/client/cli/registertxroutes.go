@@ -10,7 +10,19 @@ import (
 
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	commentQueryCmd := &cobra.Command{
+		Use:   types.StoreKey,
+		Short: "Querying commands for the comment module",
+	}
+	commentQueryCmd.AddCommand(client.GetCommands(
+		QueryParamsCmd(cdc),
+		QueryCommentCmd(cdc),
+		QueryThreadCmd(cdc),
+		QueryTokenCommentsCmd(cdc),
+		QuerySenderCommentsCmd(cdc),
+		QueryModerationCmd(cdc),
+	)...)
+	return commentQueryCmd
 }
 
 // GetTxCmd returns the transaction commands for this module
@@ -24,6 +36,11 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		CreateNewThreadCmd(cdc),
 		CreateFollowupCommentCmd(cdc),
 		RewardCommentsCmd(cdc),
+		HideCommentCmd(cdc),
+		UnhideCommentCmd(cdc),
+		BanCommenterCmd(cdc),
+		UnbanCommenterCmd(cdc),
+		SetMinDonationCmd(cdc),
 	)...)
 
 	return commentTxCmd
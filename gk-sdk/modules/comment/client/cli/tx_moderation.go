@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
+	"github.com/coinexchain/cosmos-utils/client/cliutil"
+)
+
+func HideCommentCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hide [token] [comma-separated-ids]",
+		Short: "Hide some comments of a token you own",
+		Long: `Hide some comments of a token you own. Hidden comments are left out of the comment threads and lists.
+
+Example:
+	 cetcli tx comment hide abc 12,15 --from local_user_1
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := parseIDs(args[1])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgHideComment{Token: args[0], IDs: ids}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func UnhideCommentCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unhide [token] [comma-separated-ids]",
+		Short: "Unhide some hidden comments of a token you own",
+		Long: `Unhide some hidden comments of a token you own.
+
+Example:
+	 cetcli tx comment unhide abc 12,15 --from local_user_1
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := parseIDs(args[1])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgUnhideComment{Token: args[0], IDs: ids}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func BanCommenterCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ban [token] [comma-separated-addresses]",
+		Short: "Ban some addresses from commenting on a token you own",
+		Long: `Ban some addresses from commenting on a token you own.
+
+Example:
+	 cetcli tx comment ban abc coinex1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4 --from local_user_1
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addresses, err := parseAddresses(args[1])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgBanCommenter{Token: args[0], Addresses: addresses}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func UnbanCommenterCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unban [token] [comma-separated-addresses]",
+		Short: "Allow some banned addresses to comment on a token you own again",
+		Long: `Allow some banned addresses to comment on a token you own again.
+
+Example:
+	 cetcli tx comment unban abc coinex1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4 --from local_user_1
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addresses, err := parseAddresses(args[1])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgUnbanCommenter{Token: args[0], Addresses: addresses}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func SetMinDonationCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-min-donation [token] [amount]",
+		Short: "Set the minimum donation of the comments on a token you own",
+		Long: `Set the minimum donation to the community pool, which a comment on a token you own must carry.
+Set it to 0 to remove the requirement.
+
+Example:
+	 cetcli tx comment set-min-donation abc 100000000 --from local_user_1
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amt, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgSetMinDonation{Token: args[0], MinDonation: amt}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+	return cmd
+}
+
+func parseIDs(s string) ([]uint64, error) {
+	var ids []uint64
+	for _, str := range strings.Split(s, ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(str), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func parseAddresses(s string) ([]sdk.AccAddress, error) {
+	var addresses []sdk.AccAddress
+	for _, str := range strings.Split(s, ",") {
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(str))
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, addr)
+	}
+	return addresses, nil
+}
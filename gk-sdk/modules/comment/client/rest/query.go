@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/coinexchain/cet-sdk/modules/comment/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
+	"github.com/coinexchain/cosmos-utils/client/restutil"
+)
+
+const (
+	queryFromID = "from_id"
+	queryLimit  = "limit"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc("/comment/parameters", queryParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/comment/comments/{token}", queryTokenCommentsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/comment/comments/{token}/{id}", queryCommentHandlerFn(cdc, cliCtx, keepers.QueryComment)).Methods("GET")
+	r.HandleFunc("/comment/threads/{token}/{id}", queryCommentHandlerFn(cdc, cliCtx, keepers.QueryThread)).Methods("GET")
+	r.HandleFunc("/comment/comments-of-address/{address}", querySenderCommentsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/comment/moderation/{token}", queryModerationHandlerFn(cdc, cliCtx)).Methods("GET")
+}
+
+// HTTP request handler to query the comment params values
+func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryParameters)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
+	}
+}
+
+// HTTP request handler to query a comment or a thread, depending on queryPath
+func queryCommentHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, queryPath string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		id, err := strconv.ParseUint(vars["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid comment id")
+			return
+		}
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, queryPath)
+		param := &keepers.QueryCommentParam{Token: vars["token"], ID: id}
+		restutil.RestQuery(cdc, cliCtx, w, r, route, param, nil)
+	}
+}
+
+func queryTokenCommentsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		fromID, limit, err := parsePagination(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryTokenComments)
+		param := &keepers.QueryTokenCommentsParam{Token: vars["token"], FromID: fromID, Limit: limit}
+		restutil.RestQuery(cdc, cliCtx, w, r, route, param, nil)
+	}
+}
+
+func querySenderCommentsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		acc, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		_, limit, err := parsePagination(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QuerySenderComments)
+		param := &keepers.QuerySenderCommentsParam{Sender: acc, Limit: limit}
+		restutil.RestQuery(cdc, cliCtx, w, r, route, param, nil)
+	}
+}
+
+// parsePagination reads the optional from_id and limit from the query string
+func parsePagination(r *http.Request) (fromID uint64, limit int, err error) {
+	if s := r.FormValue(queryFromID); s != "" {
+		if fromID, err = strconv.ParseUint(s, 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid %s: %s", queryFromID, s)
+		}
+	}
+	if s := r.FormValue(queryLimit); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil {
+			return 0, 0, fmt.Errorf("invalid %s: %s", queryLimit, s)
+		}
+	}
+	return fromID, limit, nil
+}
+
+func queryModerationHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryModeration)
+		param := &keepers.QueryModerationParam{Token: vars["token"]}
+		restutil.RestQuery(cdc, cliCtx, w, r, route, param, nil)
+	}
+}
@@ -5,14 +5,20 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/coinexchain/cosmos-utils/client/restutil"
 )
 
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	registerTXRoutes(cliCtx, r, cdc)
+	registerQueryRoutes(cliCtx, r, cdc)
 }
 
 func registerTXRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/comment/new-thread", createNewThreadHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/comment/followup-comment", createFollowupCommentHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/comment/reward-comments", createRewardCommentsHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/comment/hide", restutil.NewRestHandler(cdc, cliCtx, new(HideCommentReq))).Methods("POST")
+	r.HandleFunc("/comment/ban", restutil.NewRestHandler(cdc, cliCtx, new(BanCommenterReq))).Methods("POST")
+	r.HandleFunc("/comment/min-donation", restutil.NewRestHandler(cdc, cliCtx, new(SetMinDonationReq))).Methods("POST")
 }
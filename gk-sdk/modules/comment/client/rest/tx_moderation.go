@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
+	"github.com/coinexchain/cosmos-utils/client/restutil"
+)
+
+type HideCommentReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Token   string       `json:"token"`
+	IDs     []uint64     `json:"ids"`
+	Unhide  bool         `json:"unhide"`
+}
+
+type BanCommenterReq struct {
+	BaseReq   rest.BaseReq     `json:"base_req"`
+	Token     string           `json:"token"`
+	Addresses []sdk.AccAddress `json:"addresses"`
+	Unban     bool             `json:"unban"`
+}
+
+type SetMinDonationReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Token       string       `json:"token"`
+	MinDonation int64        `json:"min_donation,string"`
+}
+
+var _ restutil.RestReq = (*HideCommentReq)(nil)
+var _ restutil.RestReq = (*BanCommenterReq)(nil)
+var _ restutil.RestReq = (*SetMinDonationReq)(nil)
+
+func (req *HideCommentReq) New() restutil.RestReq {
+	return new(HideCommentReq)
+}
+func (req *HideCommentReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *HideCommentReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	if req.Unhide {
+		return types.NewMsgUnhideComment(sender, req.Token, req.IDs), nil
+	}
+	return types.NewMsgHideComment(sender, req.Token, req.IDs), nil
+}
+
+func (req *BanCommenterReq) New() restutil.RestReq {
+	return new(BanCommenterReq)
+}
+func (req *BanCommenterReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *BanCommenterReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	if req.Unban {
+		return types.NewMsgUnbanCommenter(sender, req.Token, req.Addresses), nil
+	}
+	return types.NewMsgBanCommenter(sender, req.Token, req.Addresses), nil
+}
+
+func (req *SetMinDonationReq) New() restutil.RestReq {
+	return new(SetMinDonationReq)
+}
+func (req *SetMinDonationReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *SetMinDonationReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgSetMinDonation(sender, req.Token, req.MinDonation), nil
+}
@@ -1,37 +1,85 @@
 package comment
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
 )
 
 type GenesisState struct {
-	CommentCount map[string]uint64 `json:"comment_count"`
+	Params       types.Params            `json:"params"`
+	CommentCount map[string]uint64       `json:"comment_count"`
+	Comments     []types.IndexedComment  `json:"comments,omitempty"`
+	Moderations  []types.TokenModeration `json:"moderations,omitempty"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(c map[string]uint64) GenesisState {
+func NewGenesisState(params types.Params, c map[string]uint64, comments []types.IndexedComment) GenesisState {
 	return GenesisState{
+		Params:       params,
 		CommentCount: c,
+		Comments:     comments,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(make(map[string]uint64))
+	return NewGenesisState(types.DefaultParams(), make(map[string]uint64), nil)
 }
 
 // InitGenesis - Init store state from genesis data
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
 	for denorm, count := range data.CommentCount {
 		keeper.SetCommentCount(ctx, denorm, count)
 	}
+	for _, c := range data.Comments {
+		keeper.SetIndexedComment(ctx, c)
+	}
+	for _, m := range data.Moderations {
+		keeper.SetTokenModeration(ctx, m)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
-	return NewGenesisState(k.GetAllCommentCount(ctx))
+	gs := NewGenesisState(k.GetParams(ctx), k.GetAllCommentCount(ctx), k.GetAllIndexedComments(ctx))
+	gs.Moderations = k.GetAllTokenModerations(ctx)
+	return gs
 }
 
 func (data GenesisState) Validate() error {
+	if err := data.Params.ValidateGenesis(); err != nil {
+		return err
+	}
+	commentMap := make(map[string]bool, len(data.Comments))
+	for _, c := range data.Comments {
+		tc := c.Comment
+		if tc.ID >= data.CommentCount[tc.Token] {
+			return fmt.Errorf("comment %d of %s is beyond the comment count", tc.ID, tc.Token)
+		}
+		key := fmt.Sprintf("%s/%d", tc.Token, tc.ID)
+		if commentMap[key] {
+			return fmt.Errorf("duplicate comment found in genesis state; comment: %s", key)
+		}
+		commentMap[key] = true
+	}
+	tokenMap := make(map[string]bool, len(data.Moderations))
+	for _, m := range data.Moderations {
+		if tokenMap[m.Token] {
+			return fmt.Errorf("duplicate moderation found in genesis state; token: %s", m.Token)
+		}
+		tokenMap[m.Token] = true
+		if m.MinDonation < 0 {
+			return fmt.Errorf("negative min donation of %s: %d", m.Token, m.MinDonation)
+		}
+		for _, addr := range m.BannedAddrs {
+			if addr.Empty() {
+				return fmt.Errorf("empty address is banned from commenting on %s", m.Token)
+			}
+		}
+	}
 	return nil
 }
@@ -14,6 +14,16 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgCommentToken:
 			return handleMsgCommentToken(ctx, k, msg)
+		case types.MsgHideComment:
+			return handleMsgHideComment(ctx, k, msg.Sender, msg.Token, msg.IDs, true)
+		case types.MsgUnhideComment:
+			return handleMsgHideComment(ctx, k, msg.Sender, msg.Token, msg.IDs, false)
+		case types.MsgBanCommenter:
+			return handleMsgBanCommenter(ctx, k, msg.Sender, msg.Token, msg.Addresses, true)
+		case types.MsgUnbanCommenter:
+			return handleMsgBanCommenter(ctx, k, msg.Sender, msg.Token, msg.Addresses, false)
+		case types.MsgSetMinDonation:
+			return handleMsgSetMinDonation(ctx, k, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 		}
@@ -24,6 +34,12 @@ func handleMsgCommentToken(ctx sdk.Context, k Keeper, msg types.MsgCommentToken)
 	if !k.IsTokenExists(ctx, msg.Token) {
 		return types.ErrNoSuchAsset().Result()
 	}
+	if k.IsCommenterBanned(ctx, msg.Token, msg.Sender) {
+		return types.ErrCommenterBanned(msg.Sender.String()).Result()
+	}
+	if minDonation := k.GetMinDonation(ctx, msg.Token); msg.Donation < minDonation {
+		return types.ErrDonationTooSmall(minDonation).Result()
+	}
 	if msg.Donation > 0 {
 		donatedCoin := sdk.Coins{sdk.NewCoin(dex.CET, sdk.NewInt(msg.Donation))}
 		res := k.DonateToCommunityPool(ctx, msg.Sender, donatedCoin)
@@ -48,23 +64,95 @@ func handleMsgCommentToken(ctx sdk.Context, k Keeper, msg types.MsgCommentToken)
 	}
 
 	lastCount := k.IncrCommentCount(ctx, msg.Token)
+	tokenComment := types.NewTokenComment(&msg, lastCount, ctx.BlockHeight())
+
+	if k.GetParams(ctx).IndexComments {
+		k.IndexComment(ctx, *tokenComment)
+	}
+
+	emitMsgQueueEvent(ctx, k, types.TokenCommentKey, tokenComment)
+
+	return emitMessageEvent(ctx, msg.Sender)
+}
+
+func handleMsgHideComment(ctx sdk.Context, k Keeper, sender sdk.AccAddress, token string, ids []uint64, hidden bool) sdk.Result {
+	if !k.IsTokenIssuer(ctx, token, sender) {
+		return types.ErrNotTokenOwner(token).Result()
+	}
+	count := k.GetCommentCount(ctx, token)
+	for _, id := range ids {
+		if id >= count {
+			return types.ErrNoSuchComment(token, id).Result()
+		}
+	}
+	for _, id := range ids {
+		k.SetCommentHidden(ctx, token, id, hidden)
+	}
+
+	emitMsgQueueEvent(ctx, k, types.HideCommentKey, types.HideCommentInfo{
+		Token:  token,
+		IDs:    ids,
+		Hidden: hidden,
+		Sender: sender.String(),
+		Height: ctx.BlockHeight(),
+	})
+	return emitMessageEvent(ctx, sender)
+}
 
-	if len(k.GetEventTypeMsgQueue()) != 0 {
-		tokenComment := types.NewTokenComment(&msg, lastCount, ctx.BlockHeight())
-		bytes := dex.SafeJSONMarshal(tokenComment)
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				k.GetEventTypeMsgQueue(),
-				sdk.NewAttribute(types.TokenCommentKey, string(bytes)),
-			),
-		)
+func handleMsgBanCommenter(ctx sdk.Context, k Keeper, sender sdk.AccAddress, token string, addresses []sdk.AccAddress, banned bool) sdk.Result {
+	if !k.IsTokenIssuer(ctx, token, sender) {
+		return types.ErrNotTokenOwner(token).Result()
 	}
+	addrList := make([]string, len(addresses))
+	for i, addr := range addresses {
+		k.SetCommenterBanned(ctx, token, addr, banned)
+		addrList[i] = addr.String()
+	}
+
+	emitMsgQueueEvent(ctx, k, types.BanCommenterKey, types.BanCommenterInfo{
+		Token:     token,
+		Addresses: addrList,
+		Banned:    banned,
+		Sender:    sender.String(),
+		Height:    ctx.BlockHeight(),
+	})
+	return emitMessageEvent(ctx, sender)
+}
+
+func handleMsgSetMinDonation(ctx sdk.Context, k Keeper, msg types.MsgSetMinDonation) sdk.Result {
+	if !k.IsTokenIssuer(ctx, msg.Token, msg.Sender) {
+		return types.ErrNotTokenOwner(msg.Token).Result()
+	}
+	k.SetMinDonation(ctx, msg.Token, msg.MinDonation)
+
+	emitMsgQueueEvent(ctx, k, types.MinDonationKey, types.MinDonationInfo{
+		Token:       msg.Token,
+		MinDonation: msg.MinDonation,
+		Sender:      msg.Sender.String(),
+		Height:      ctx.BlockHeight(),
+	})
+	return emitMessageEvent(ctx, msg.Sender)
+}
+
+func emitMsgQueueEvent(ctx sdk.Context, k Keeper, key string, info interface{}) {
+	if len(k.GetEventTypeMsgQueue()) == 0 {
+		return
+	}
+	bytes := dex.SafeJSONMarshal(info)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			k.GetEventTypeMsgQueue(),
+			sdk.NewAttribute(key, string(bytes)),
+		),
+	)
+}
 
+func emitMessageEvent(ctx sdk.Context, sender sdk.AccAddress) sdk.Result {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
 		),
 	)
 
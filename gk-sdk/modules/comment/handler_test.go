@@ -11,6 +11,7 @@ import (
 	sdkstore "github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
@@ -83,6 +84,7 @@ func (k *mocAccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth
 
 type mocAssetStatusKeeper struct {
 	assets map[string]bool
+	owner  sdk.AccAddress
 }
 
 func (k *mocAssetStatusKeeper) IsTokenExists(ctx sdk.Context, denom string) bool {
@@ -90,6 +92,10 @@ func (k *mocAssetStatusKeeper) IsTokenExists(ctx sdk.Context, denom string) bool
 	return ok
 }
 
+func (k *mocAssetStatusKeeper) IsTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return k.IsTokenExists(ctx, denom) && bytes.Equal(addr, k.owner)
+}
+
 type mocDistributionxKeeper struct {
 	poolName  string
 	maxAmount sdk.Int
@@ -123,15 +129,21 @@ func newContextAndKeeper(chainid string) (sdk.Context, *Keeper) {
 	ms := sdkstore.NewCommitMultiStore(db)
 
 	key := sdk.NewKVStoreKey(StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.LoadLatestVersion()
+	paramsKeeper := params.NewKeeper(types.ModuleCdc, keyParams, tkeyParams, params.DefaultCodespace)
 
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: chainid, Height: 1000}, false, log.NewNopLogger())
 	k := keepers.NewKeeper(key,
 		&mocBankxKeeper{maxAmount: sdk.NewInt(100)},
-		&mocAssetStatusKeeper{assets: map[string]bool{"usdt": true, "btc": true, "cet": true}},
+		&mocAssetStatusKeeper{assets: map[string]bool{"usdt": true, "btc": true, "cet": true}, owner: simpleAddr("00001")},
 		&mocAccountKeeper{nosuchAcc: simpleAddr("00007")},
 		&mocDistributionxKeeper{poolName: "comPool", maxAmount: sdk.NewInt(100)},
+		paramsKeeper.Subspace(types.DefaultParamspace),
 		"",
 	)
 	return ctx, k
@@ -141,7 +153,7 @@ func testGenesis(t *testing.T, ctx sdk.Context, keeper *Keeper) {
 	InitGenesis(ctx, *keeper, DefaultGenesisState())
 	gns := ExportGenesis(ctx, *keeper)
 	require.Equal(t, "map[]", fmt.Sprintf("%v", gns.CommentCount))
-	gns = NewGenesisState(map[string]uint64{"cet": 100})
+	gns = NewGenesisState(types.DefaultParams(), map[string]uint64{"cet": 100}, nil)
 	InitGenesis(ctx, *keeper, gns)
 	err := gns.Validate()
 	require.Equal(t, nil, err)
@@ -233,3 +245,98 @@ func Test1(t *testing.T) {
 	require.Equal(t, s, res.Log)
 	require.Equal(t, false, res.IsOK())
 }
+
+func TestIndexComments(t *testing.T) {
+	ctx, keeper := newContextAndKeeper("test-2")
+	params := types.DefaultParams()
+	params.IndexComments = true
+	InitGenesis(ctx, *keeper, NewGenesisState(params, map[string]uint64{}, nil))
+
+	msgHandler := NewHandler(*keeper)
+	msg := types.NewMsgCommentToken(simpleAddr("00003"), "usdt", 0, "First Comment", "hello", types.UTF8Text, nil)
+	require.True(t, msgHandler(ctx, *msg).IsOK())
+	refs := []types.CommentRef{{ID: 0, RewardTarget: simpleAddr("00003"), RewardToken: "usdt", RewardAmount: 10}}
+	msg = types.NewMsgCommentToken(simpleAddr("00004"), "usdt", 0, "Reply", "world", types.UTF8Text, refs)
+	require.True(t, msgHandler(ctx, *msg).IsOK())
+
+	querier := keepers.NewQuerier(*keeper)
+	bz := types.ModuleCdc.MustMarshalJSON(keepers.QueryCommentParam{Token: "usdt", ID: 0})
+	res, err := querier(ctx, []string{keepers.QueryThread}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var thread types.CommentThread
+	types.ModuleCdc.MustUnmarshalJSON(res, &thread)
+	require.Equal(t, "hello", thread.Comment.Comment.Content)
+	require.Equal(t, "10usdt", thread.Comment.Rewards.String())
+	require.Equal(t, 1, len(thread.Followups))
+	require.Equal(t, "world", thread.Followups[0].Comment.Content)
+
+	bz = types.ModuleCdc.MustMarshalJSON(keepers.QueryCommentParam{Token: "usdt", ID: 5})
+	_, err = querier(ctx, []string{keepers.QueryComment}, abci.RequestQuery{Data: bz})
+	require.Equal(t, types.CodeNoSuchComment, err.Code())
+
+	gns := ExportGenesis(ctx, *keeper)
+	require.Nil(t, gns.Validate())
+	require.Equal(t, 2, len(gns.Comments))
+	gns.CommentCount["usdt"] = 1
+	require.NotNil(t, gns.Validate())
+
+	ctx2, keeper2 := newContextAndKeeper("test-2")
+	gns.CommentCount["usdt"] = 2
+	InitGenesis(ctx2, *keeper2, gns)
+	thread2, ok := keeper2.GetCommentThread(ctx2, "usdt", 0)
+	require.True(t, ok)
+	require.Equal(t, thread, thread2)
+}
+
+func TestModeration(t *testing.T) {
+	ctx, keeper := newContextAndKeeper("test-3")
+	params := types.DefaultParams()
+	params.IndexComments = true
+	InitGenesis(ctx, *keeper, NewGenesisState(params, map[string]uint64{}, nil))
+	msgHandler := NewHandler(*keeper)
+	owner, spammer := simpleAddr("00001"), simpleAddr("00005")
+
+	for i := 0; i < 3; i++ {
+		msg := types.NewMsgCommentToken(spammer, "usdt", 0, "Spam", "spam", types.UTF8Text, nil)
+		require.True(t, msgHandler(ctx, *msg).IsOK())
+	}
+
+	// only the token owner can moderate
+	res := msgHandler(ctx, types.NewMsgHideComment(spammer, "usdt", []uint64{0}))
+	require.Equal(t, types.CodeNotTokenOwner, res.Code)
+	res = msgHandler(ctx, types.NewMsgHideComment(owner, "usdt", []uint64{0, 3}))
+	require.Equal(t, types.CodeNoSuchComment, res.Code)
+
+	require.True(t, msgHandler(ctx, types.NewMsgHideComment(owner, "usdt", []uint64{0, 2})).IsOK())
+	comments := keeper.GetTokenComments(ctx, "usdt", 0, 100)
+	require.Equal(t, 1, len(comments))
+	require.Equal(t, uint64(1), comments[0].Comment.ID)
+	require.True(t, msgHandler(ctx, types.NewMsgUnhideComment(owner, "usdt", []uint64{2})).IsOK())
+	require.Equal(t, 2, len(keeper.GetTokenComments(ctx, "usdt", 0, 100)))
+
+	require.True(t, msgHandler(ctx, types.NewMsgBanCommenter(owner, "usdt", []sdk.AccAddress{spammer})).IsOK())
+	msg := types.NewMsgCommentToken(spammer, "usdt", 0, "Spam", "spam", types.UTF8Text, nil)
+	require.Equal(t, types.CodeCommenterBanned, msgHandler(ctx, *msg).Code)
+	require.True(t, msgHandler(ctx, types.NewMsgUnbanCommenter(owner, "usdt", []sdk.AccAddress{spammer})).IsOK())
+	require.True(t, msgHandler(ctx, *msg).IsOK())
+
+	require.True(t, msgHandler(ctx, types.NewMsgBanCommenter(owner, "usdt", []sdk.AccAddress{spammer})).IsOK())
+	require.True(t, msgHandler(ctx, types.NewMsgSetMinDonation(owner, "usdt", 10)).IsOK())
+	msg = types.NewMsgCommentToken(owner, "usdt", 5, "Hi", "hi", types.UTF8Text, nil)
+	require.Equal(t, types.CodeDonationTooSmall, msgHandler(ctx, *msg).Code)
+	msg.Donation = 10
+	require.True(t, msgHandler(ctx, *msg).IsOK())
+
+	gns := ExportGenesis(ctx, *keeper)
+	require.Nil(t, gns.Validate())
+	require.Equal(t, []types.TokenModeration{{
+		Token:          "usdt",
+		MinDonation:    10,
+		BannedAddrs:    []sdk.AccAddress{spammer},
+		HiddenComments: []uint64{0},
+	}}, gns.Moderations)
+
+	ctx2, keeper2 := newContextAndKeeper("test-3")
+	InitGenesis(ctx2, *keeper2, gns)
+	require.Equal(t, gns.Moderations, keeper2.GetAllTokenModerations(ctx2))
+}
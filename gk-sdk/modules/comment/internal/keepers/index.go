@@ -0,0 +1,242 @@
+package keepers
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
+)
+
+// The on-chain comment index, which is only written when the IndexComments parameter is true.
+// A token symbol never contains a zero byte, so it is used to terminate the symbol in keys.
+var (
+	IndexedCommentKey  = []byte{0x12} // 0x12 | token | 0x00 | id -> IndexedComment
+	CommentSenderKey   = []byte{0x13} // 0x13 | len(sender) | sender | token | 0x00 | id -> {}
+	CommentFollowupKey = []byte{0x14} // 0x14 | token | 0x00 | id | followup's id -> {}
+)
+
+// At most so many old comments are pruned when a new comment is indexed, which keeps
+// the gas of a comment bounded after the pruning parameters are tightened
+const maxPrunedPerComment = 16
+
+func getTokenPrefix(prefix []byte, token string) []byte {
+	res := make([]byte, 0, len(prefix)+len(token)+1)
+	res = append(res, prefix...)
+	res = append(res, []byte(token)...)
+	return append(res, 0)
+}
+
+func appendID(key []byte, id uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], id)
+	return append(key, b[:]...)
+}
+
+func getIndexedCommentKey(token string, id uint64) []byte {
+	return appendID(getTokenPrefix(IndexedCommentKey, token), id)
+}
+
+func getCommentSenderPrefix(sender sdk.AccAddress) []byte {
+	res := make([]byte, 0, len(CommentSenderKey)+1+len(sender))
+	res = append(res, CommentSenderKey...)
+	res = append(res, byte(len(sender)))
+	return append(res, sender...)
+}
+
+func getCommentSenderKey(sender sdk.AccAddress, token string, id uint64) []byte {
+	return appendID(getTokenPrefix(getCommentSenderPrefix(sender), token), id)
+}
+
+func getCommentFollowupPrefix(token string, id uint64) []byte {
+	return appendID(getTokenPrefix(CommentFollowupKey, token), id)
+}
+
+func getCommentFollowupKey(token string, id, followupID uint64) []byte {
+	return appendID(getCommentFollowupPrefix(token, id), followupID)
+}
+
+// the id is always the last 8 bytes of a key
+func getIDFromKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+func (k *Keeper) GetIndexedComment(ctx sdk.Context, token string, id uint64) (types.IndexedComment, bool) {
+	var c types.IndexedComment
+	bz := ctx.KVStore(k.key).Get(getIndexedCommentKey(token, id))
+	if bz == nil {
+		return c, false
+	}
+	types.ModuleCdc.MustUnmarshalBinaryBare(bz, &c)
+	return c, true
+}
+
+func (k *Keeper) setIndexedComment(ctx sdk.Context, c types.IndexedComment) {
+	bz := types.ModuleCdc.MustMarshalBinaryBare(c)
+	ctx.KVStore(k.key).Set(getIndexedCommentKey(c.Comment.Token, c.Comment.ID), bz)
+}
+
+// SetIndexedComment stores a comment and its sender and followup index entries, without
+// changing the statistics of the comments it references
+func (k *Keeper) SetIndexedComment(ctx sdk.Context, c types.IndexedComment) {
+	store := ctx.KVStore(k.key)
+	k.setIndexedComment(ctx, c)
+	tc := c.Comment
+	store.Set(getCommentSenderKey(tc.Sender, tc.Token, tc.ID), []byte{})
+	for _, ref := range tc.References {
+		if ref.ID != tc.ID {
+			store.Set(getCommentFollowupKey(tc.Token, ref.ID, tc.ID), []byte{})
+		}
+	}
+}
+
+// IndexComment adds a new comment into the index, prunes the old comments of the same token,
+// and updates the followup counts and reward totals of the comments it references
+func (k *Keeper) IndexComment(ctx sdk.Context, tc types.TokenComment) {
+	k.pruneComments(ctx, tc.Token, tc.ID, tc.Height)
+
+	counted := make(map[uint64]bool, len(tc.References))
+	for _, ref := range tc.References {
+		if ref.ID == tc.ID {
+			continue
+		}
+		parent, ok := k.GetIndexedComment(ctx, tc.Token, ref.ID)
+		if !ok {
+			continue
+		}
+		if !counted[ref.ID] {
+			parent.FollowupCount++
+			counted[ref.ID] = true
+		}
+		parent.AddReward(ref)
+		k.setIndexedComment(ctx, parent)
+	}
+
+	k.SetIndexedComment(ctx, types.IndexedComment{Comment: tc})
+}
+
+// DeleteIndexedComment removes a comment and all the index entries pointing to it
+func (k *Keeper) DeleteIndexedComment(ctx sdk.Context, token string, id uint64) {
+	c, ok := k.GetIndexedComment(ctx, token, id)
+	if !ok {
+		return
+	}
+	store := ctx.KVStore(k.key)
+	store.Delete(getIndexedCommentKey(token, id))
+	store.Delete(getCommentSenderKey(c.Comment.Sender, token, id))
+	for _, ref := range c.Comment.References {
+		store.Delete(getCommentFollowupKey(token, ref.ID, id))
+	}
+
+	var followupKeys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, getCommentFollowupPrefix(token, id))
+	for ; iter.Valid(); iter.Next() {
+		followupKeys = append(followupKeys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range followupKeys {
+		store.Delete(key)
+	}
+}
+
+// pruneComments removes the oldest comments of a token, which fall out of MaxCommentsPerToken
+// or are older than MaxCommentAge, when the comment newID is added at height
+func (k *Keeper) pruneComments(ctx sdk.Context, token string, newID uint64, height int64) {
+	params := k.GetParams(ctx)
+	if params.MaxCommentsPerToken == 0 && params.MaxCommentAge == 0 {
+		return
+	}
+
+	var pruned []uint64
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, getTokenPrefix(IndexedCommentKey, token))
+	for ; iter.Valid() && len(pruned) < maxPrunedPerComment; iter.Next() {
+		id := getIDFromKey(iter.Key())
+		tooMany := params.MaxCommentsPerToken != 0 && id+params.MaxCommentsPerToken <= newID
+		tooOld := false
+		if params.MaxCommentAge != 0 {
+			var c types.IndexedComment
+			types.ModuleCdc.MustUnmarshalBinaryBare(iter.Value(), &c)
+			tooOld = c.Comment.Height+params.MaxCommentAge <= height
+		}
+		if !tooMany && !tooOld {
+			break
+		}
+		pruned = append(pruned, id)
+	}
+	iter.Close()
+	for _, id := range pruned {
+		k.DeleteIndexedComment(ctx, token, id)
+	}
+}
+
+// GetCommentThread returns a comment with its direct followups, leaving out the hidden followups
+func (k *Keeper) GetCommentThread(ctx sdk.Context, token string, id uint64) (types.CommentThread, bool) {
+	c, ok := k.GetIndexedComment(ctx, token, id)
+	if !ok {
+		return types.CommentThread{}, false
+	}
+	thread := types.CommentThread{Comment: c, Followups: []types.IndexedComment{}}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), getCommentFollowupPrefix(token, id))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		id := getIDFromKey(iter.Key())
+		if k.IsCommentHidden(ctx, token, id) {
+			continue
+		}
+		if followup, ok := k.GetIndexedComment(ctx, token, id); ok {
+			thread.Followups = append(thread.Followups, followup)
+		}
+	}
+	return thread, true
+}
+
+// GetTokenComments returns at most limit unhidden comments of a token whose ids are no less than fromID
+func (k *Keeper) GetTokenComments(ctx sdk.Context, token string, fromID uint64, limit int) []types.IndexedComment {
+	res := []types.IndexedComment{}
+	store := ctx.KVStore(k.key)
+	prefix := getTokenPrefix(IndexedCommentKey, token)
+	iter := store.Iterator(appendID(prefix, fromID), sdk.PrefixEndBytes(prefix))
+	defer iter.Close()
+	for ; iter.Valid() && len(res) < limit; iter.Next() {
+		if k.IsCommentHidden(ctx, token, getIDFromKey(iter.Key())) {
+			continue
+		}
+		var c types.IndexedComment
+		types.ModuleCdc.MustUnmarshalBinaryBare(iter.Value(), &c)
+		res = append(res, c)
+	}
+	return res
+}
+
+// GetSenderComments returns at most limit unhidden comments posted by sender, ordered by token and id
+func (k *Keeper) GetSenderComments(ctx sdk.Context, sender sdk.AccAddress, limit int) []types.IndexedComment {
+	res := []types.IndexedComment{}
+	prefix := getCommentSenderPrefix(sender)
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), prefix)
+	defer iter.Close()
+	for ; iter.Valid() && len(res) < limit; iter.Next() {
+		key := iter.Key()
+		token := string(key[len(prefix) : len(key)-9])
+		id := getIDFromKey(key)
+		if k.IsCommentHidden(ctx, token, id) {
+			continue
+		}
+		if c, ok := k.GetIndexedComment(ctx, token, id); ok {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func (k *Keeper) GetAllIndexedComments(ctx sdk.Context) []types.IndexedComment {
+	var res []types.IndexedComment
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), IndexedCommentKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var c types.IndexedComment
+		types.ModuleCdc.MustUnmarshalBinaryBare(iter.Value(), &c)
+		res = append(res, c)
+	}
+	return res
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
 )
@@ -74,11 +75,13 @@ func (keeper *CommentCountKeeper) SetCommentCount(ctx sdk.Context, denom string,
 }
 
 type Keeper struct {
+	key               sdk.StoreKey
 	cck               *CommentCountKeeper
 	bxk               types.ExpectedBankxKeeper
 	axk               types.ExpectedAssetStatusKeeper
 	ak                types.ExpectedAccountKeeper
 	dk                types.ExpectedDistributionxKeeper
+	paramSubspace     params.Subspace
 	eventTypeMsgQueue string
 }
 
@@ -87,13 +90,16 @@ func NewKeeper(key sdk.StoreKey,
 	axk types.ExpectedAssetStatusKeeper,
 	ak types.ExpectedAccountKeeper,
 	dk types.ExpectedDistributionxKeeper,
+	paramstore params.Subspace,
 	et string) *Keeper {
 	return &Keeper{
+		key:               key,
 		cck:               NewCommentCountKeeper(key),
 		bxk:               bxk,
 		axk:               axk,
 		ak:                ak,
 		dk:                dk,
+		paramSubspace:     paramstore.WithKeyTable(types.ParamKeyTable()),
 		eventTypeMsgQueue: et,
 	}
 }
@@ -102,6 +108,17 @@ func (k *Keeper) GetEventTypeMsgQueue() string {
 	return k.eventTypeMsgQueue
 }
 
+// SetParams sets the comment module's parameters.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the comment module's parameters.
+func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSubspace.GetParamSet(ctx, &params)
+	return
+}
+
 func (k *Keeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	return k.bxk.SendCoins(ctx, from, to, amt)
 }
@@ -11,6 +11,7 @@ import (
 
 	sdkstore "github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
 )
@@ -20,11 +21,16 @@ func newContextAndKeeper(chainid string) (sdk.Context, *Keeper) {
 	ms := sdkstore.NewCommitMultiStore(db)
 
 	key := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.LoadLatestVersion()
 
-	keeper := NewKeeper(key, nil, nil, nil, nil, "")
+	paramsKeeper := params.NewKeeper(types.ModuleCdc, keyParams, tkeyParams, params.DefaultCodespace)
+	keeper := NewKeeper(key, nil, nil, nil, nil, paramsKeeper.Subspace(types.DefaultParamspace), "")
 
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: chainid, Height: 1000}, false, log.NewNopLogger())
 
@@ -44,3 +50,85 @@ func Test1(t *testing.T) {
 	require.Equal(t, uint64(3), m["btc"])
 	require.Equal(t, uint64(1), m["cet"])
 }
+
+func newTokenComment(id uint64, height int64, sender sdk.AccAddress, refs ...types.CommentRef) types.TokenComment {
+	return types.TokenComment{
+		ID:          id,
+		Height:      height,
+		Sender:      sender,
+		Token:       "cet",
+		Title:       "title",
+		Content:     "content",
+		ContentType: types.UTF8Text,
+		References:  refs,
+	}
+}
+
+func TestCommentIndex(t *testing.T) {
+	ctx, keeper := newContextAndKeeper("Test-2")
+	alice := sdk.AccAddress([]byte("alice"))
+	bob := sdk.AccAddress([]byte("bob"))
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	keeper.IndexComment(ctx, newTokenComment(0, 10, alice))
+	keeper.IndexComment(ctx, newTokenComment(1, 11, bob,
+		types.CommentRef{ID: 0, RewardToken: "cet", RewardAmount: 5},
+		types.CommentRef{ID: 0, RewardToken: "usdt", RewardAmount: 2}))
+	keeper.IndexComment(ctx, newTokenComment(2, 12, bob,
+		types.CommentRef{ID: 0, RewardToken: "cet", RewardAmount: 3},
+		types.CommentRef{ID: 9, RewardToken: "cet", RewardAmount: 3}))
+	// a comment of another token, whose symbol shares a prefix with "cet"
+	other := newTokenComment(0, 12, alice)
+	other.Token = "cett"
+	keeper.IndexComment(ctx, other)
+
+	c, ok := keeper.GetIndexedComment(ctx, "cet", 0)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), c.FollowupCount)
+	require.Equal(t, "8cet,2usdt", c.Rewards.String())
+
+	thread, ok := keeper.GetCommentThread(ctx, "cet", 0)
+	require.True(t, ok)
+	require.Equal(t, 2, len(thread.Followups))
+	require.Equal(t, uint64(1), thread.Followups[0].Comment.ID)
+	require.Equal(t, uint64(2), thread.Followups[1].Comment.ID)
+	_, ok = keeper.GetCommentThread(ctx, "cet", 9)
+	require.False(t, ok)
+
+	require.Equal(t, 3, len(keeper.GetTokenComments(ctx, "cet", 0, 100)))
+	require.Equal(t, 1, len(keeper.GetTokenComments(ctx, "cet", 1, 1)))
+	require.Equal(t, 2, len(keeper.GetSenderComments(ctx, alice, 100)))
+	require.Equal(t, 2, len(keeper.GetSenderComments(ctx, bob, 100)))
+	require.Equal(t, 4, len(keeper.GetAllIndexedComments(ctx)))
+
+	keeper.DeleteIndexedComment(ctx, "cet", 0)
+	_, ok = keeper.GetIndexedComment(ctx, "cet", 0)
+	require.False(t, ok)
+	require.Equal(t, 1, len(keeper.GetSenderComments(ctx, alice, 100)))
+	thread, _ = keeper.GetCommentThread(ctx, "cet", 1)
+	require.Equal(t, 0, len(thread.Followups))
+}
+
+func TestCommentPruning(t *testing.T) {
+	ctx, keeper := newContextAndKeeper("Test-3")
+	alice := sdk.AccAddress([]byte("alice"))
+	params := types.DefaultParams()
+	params.MaxCommentsPerToken = 3
+	keeper.SetParams(ctx, params)
+
+	for i := 0; i < 5; i++ {
+		keeper.IndexComment(ctx, newTokenComment(uint64(i), int64(10+i), alice))
+	}
+	comments := keeper.GetTokenComments(ctx, "cet", 0, 100)
+	require.Equal(t, 3, len(comments))
+	require.Equal(t, uint64(2), comments[0].Comment.ID)
+	require.Equal(t, 3, len(keeper.GetSenderComments(ctx, alice, 100)))
+
+	params.MaxCommentsPerToken = 0
+	params.MaxCommentAge = 6
+	keeper.SetParams(ctx, params)
+	keeper.IndexComment(ctx, newTokenComment(5, 18, alice))
+	comments = keeper.GetTokenComments(ctx, "cet", 0, 100)
+	require.Equal(t, 3, len(comments))
+	require.Equal(t, uint64(3), comments[0].Comment.ID)
+}
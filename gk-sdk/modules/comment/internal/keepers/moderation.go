@@ -0,0 +1,140 @@
+package keepers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
+)
+
+// The moderation settings of the token owners
+var (
+	HiddenCommentKey   = []byte{0x15} // 0x15 | token | 0x00 | id -> {}
+	BannedCommenterKey = []byte{0x16} // 0x16 | token | 0x00 | address -> {}
+	MinDonationKey     = []byte{0x17} // 0x17 | token -> int64
+)
+
+func getHiddenCommentKey(token string, id uint64) []byte {
+	return appendID(getTokenPrefix(HiddenCommentKey, token), id)
+}
+
+func getBannedCommenterKey(token string, addr sdk.AccAddress) []byte {
+	return append(getTokenPrefix(BannedCommenterKey, token), addr...)
+}
+
+func getMinDonationKey(token string) []byte {
+	return append(append([]byte{}, MinDonationKey...), []byte(token)...)
+}
+
+func (k *Keeper) IsTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return k.axk.IsTokenIssuer(ctx, denom, addr)
+}
+
+func (k *Keeper) IsCommentHidden(ctx sdk.Context, token string, id uint64) bool {
+	return ctx.KVStore(k.key).Has(getHiddenCommentKey(token, id))
+}
+
+func (k *Keeper) SetCommentHidden(ctx sdk.Context, token string, id uint64, hidden bool) {
+	store := ctx.KVStore(k.key)
+	if hidden {
+		store.Set(getHiddenCommentKey(token, id), []byte{})
+	} else {
+		store.Delete(getHiddenCommentKey(token, id))
+	}
+}
+
+func (k *Keeper) IsCommenterBanned(ctx sdk.Context, token string, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.key).Has(getBannedCommenterKey(token, addr))
+}
+
+func (k *Keeper) SetCommenterBanned(ctx sdk.Context, token string, addr sdk.AccAddress, banned bool) {
+	store := ctx.KVStore(k.key)
+	if banned {
+		store.Set(getBannedCommenterKey(token, addr), []byte{})
+	} else {
+		store.Delete(getBannedCommenterKey(token, addr))
+	}
+}
+
+func (k *Keeper) GetMinDonation(ctx sdk.Context, token string) int64 {
+	bz := ctx.KVStore(k.key).Get(getMinDonationKey(token))
+	if len(bz) == 0 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (k *Keeper) SetMinDonation(ctx sdk.Context, token string, minDonation int64) {
+	store := ctx.KVStore(k.key)
+	if minDonation == 0 {
+		store.Delete(getMinDonationKey(token))
+		return
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(minDonation))
+	store.Set(getMinDonationKey(token), b[:])
+}
+
+// GetTokenModeration returns the moderation settings of a token
+func (k *Keeper) GetTokenModeration(ctx sdk.Context, token string) types.TokenModeration {
+	m := types.TokenModeration{
+		Token:          token,
+		MinDonation:    k.GetMinDonation(ctx, token),
+		BannedAddrs:    []sdk.AccAddress{},
+		HiddenComments: []uint64{},
+	}
+	store := ctx.KVStore(k.key)
+	prefix := getTokenPrefix(BannedCommenterKey, token)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	for ; iter.Valid(); iter.Next() {
+		m.BannedAddrs = append(m.BannedAddrs, sdk.AccAddress(iter.Key()[len(prefix):]))
+	}
+	iter.Close()
+	iter = sdk.KVStorePrefixIterator(store, getTokenPrefix(HiddenCommentKey, token))
+	for ; iter.Valid(); iter.Next() {
+		m.HiddenComments = append(m.HiddenComments, getIDFromKey(iter.Key()))
+	}
+	iter.Close()
+	return m
+}
+
+func (k *Keeper) SetTokenModeration(ctx sdk.Context, m types.TokenModeration) {
+	k.SetMinDonation(ctx, m.Token, m.MinDonation)
+	for _, addr := range m.BannedAddrs {
+		k.SetCommenterBanned(ctx, m.Token, addr, true)
+	}
+	for _, id := range m.HiddenComments {
+		k.SetCommentHidden(ctx, m.Token, id, true)
+	}
+}
+
+// GetAllTokenModerations returns the moderation settings of all the tokens which have any
+func (k *Keeper) GetAllTokenModerations(ctx sdk.Context) []types.TokenModeration {
+	tokens := make(map[string]bool)
+	store := ctx.KVStore(k.key)
+	for _, prefix := range [][]byte{HiddenCommentKey, BannedCommenterKey, MinDonationKey} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			key := iter.Key()[len(prefix):]
+			if i := bytes.IndexByte(key, 0); i >= 0 && !bytes.Equal(prefix, MinDonationKey) {
+				key = key[:i]
+			}
+			tokens[string(key)] = true
+		}
+		iter.Close()
+	}
+	tokenList := make([]string, 0, len(tokens))
+	for token := range tokens {
+		tokenList = append(tokenList, token)
+	}
+	sort.Strings(tokenList)
+
+	var res []types.TokenModeration
+	for _, token := range tokenList {
+		res = append(res, k.GetTokenModeration(ctx, token))
+	}
+	return res
+}
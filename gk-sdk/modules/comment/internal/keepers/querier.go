@@ -0,0 +1,141 @@
+package keepers
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
+)
+
+const (
+	QueryParameters     = "parameters"
+	QueryComment        = "comment"
+	QueryThread         = "thread"
+	QueryTokenComments  = "token-comments"
+	QuerySenderComments = "sender-comments"
+	QueryModeration     = "moderation"
+
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+)
+
+// creates a querier for comment REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryParameters:
+			return queryParameters(ctx, keeper)
+		case QueryComment:
+			return queryComment(ctx, req, keeper)
+		case QueryThread:
+			return queryThread(ctx, req, keeper)
+		case QueryTokenComments:
+			return queryTokenComments(ctx, req, keeper)
+		case QuerySenderComments:
+			return querySenderComments(ctx, req, keeper)
+		case QueryModeration:
+			return queryModeration(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("query symbol : " + path[0])
+		}
+	}
+}
+
+type QueryCommentParam struct {
+	Token string `json:"token"`
+	ID    uint64 `json:"id"`
+}
+
+type QueryTokenCommentsParam struct {
+	Token  string `json:"token"`
+	FromID uint64 `json:"from_id"`
+	Limit  int    `json:"limit"`
+}
+
+type QueryModerationParam struct {
+	Token string `json:"token"`
+}
+
+type QuerySenderCommentsParam struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Limit  int            `json:"limit"`
+}
+
+func getLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultQueryLimit
+	}
+	if limit > MaxQueryLimit {
+		return MaxQueryLimit
+	}
+	return limit
+}
+
+func unmarshalParam(req abci.RequestQuery, param interface{}) sdk.Error {
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, param); err != nil {
+		return sdk.NewError(types.CodeSpaceComment, types.CodeUnMarshalFailed, "failed to parse param")
+	}
+	return nil
+}
+
+func marshalResult(res interface{}) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdk.NewError(types.CodeSpaceComment, types.CodeMarshalFailed, "could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	return marshalResult(k.GetParams(ctx))
+}
+
+func queryComment(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var param QueryCommentParam
+	if err := unmarshalParam(req, &param); err != nil {
+		return nil, err
+	}
+	c, ok := k.GetIndexedComment(ctx, param.Token, param.ID)
+	if !ok {
+		return nil, types.ErrNoSuchComment(param.Token, param.ID)
+	}
+	return marshalResult(c)
+}
+
+func queryThread(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var param QueryCommentParam
+	if err := unmarshalParam(req, &param); err != nil {
+		return nil, err
+	}
+	thread, ok := k.GetCommentThread(ctx, param.Token, param.ID)
+	if !ok {
+		return nil, types.ErrNoSuchComment(param.Token, param.ID)
+	}
+	return marshalResult(thread)
+}
+
+func queryTokenComments(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var param QueryTokenCommentsParam
+	if err := unmarshalParam(req, &param); err != nil {
+		return nil, err
+	}
+	return marshalResult(k.GetTokenComments(ctx, param.Token, param.FromID, getLimit(param.Limit)))
+}
+
+func querySenderComments(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var param QuerySenderCommentsParam
+	if err := unmarshalParam(req, &param); err != nil {
+		return nil, err
+	}
+	return marshalResult(k.GetSenderComments(ctx, param.Sender, getLimit(param.Limit)))
+}
+
+func queryModeration(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var param QueryModerationParam
+	if err := unmarshalParam(req, &param); err != nil {
+		return nil, err
+	}
+	return marshalResult(k.GetTokenModeration(ctx, param.Token))
+}
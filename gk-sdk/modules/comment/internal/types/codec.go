@@ -14,4 +14,9 @@ func init() {
 
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCommentToken{}, "comment/MsgCommentToken", nil)
+	cdc.RegisterConcrete(MsgHideComment{}, "comment/MsgHideComment", nil)
+	cdc.RegisterConcrete(MsgUnhideComment{}, "comment/MsgUnhideComment", nil)
+	cdc.RegisterConcrete(MsgBanCommenter{}, "comment/MsgBanCommenter", nil)
+	cdc.RegisterConcrete(MsgUnbanCommenter{}, "comment/MsgUnbanCommenter", nil)
+	cdc.RegisterConcrete(MsgSetMinDonation{}, "comment/MsgSetMinDonation", nil)
 }
@@ -21,7 +21,13 @@ const (
 	CodeNoSuchAsset        sdk.CodeType = 909
 	CodeTitleTooLarge      sdk.CodeType = 910
 	CodeNoSuchAccount      sdk.CodeType = 911
+	CodeNoSuchComment      sdk.CodeType = 912
+	CodeUnMarshalFailed    sdk.CodeType = 913
 	CodeMarshalFailed      sdk.CodeType = 914
+	CodeNotTokenOwner      sdk.CodeType = 915
+	CodeCommenterBanned    sdk.CodeType = 916
+	CodeDonationTooSmall   sdk.CodeType = 917
+	CodeNoCommentID        sdk.CodeType = 918
 )
 
 func ErrInvalidSymbol() sdk.Error {
@@ -69,3 +75,23 @@ func ErrNoSuchAsset() sdk.Error {
 func ErrNoSuchAccount(acc string) sdk.Error {
 	return sdk.NewError(CodeSpaceComment, CodeNoSuchAccount, fmt.Sprintf("No such account: %s", acc))
 }
+
+func ErrNoSuchComment(token string, id uint64) sdk.Error {
+	return sdk.NewError(CodeSpaceComment, CodeNoSuchComment, fmt.Sprintf("No such comment: %d of %s", id, token))
+}
+
+func ErrNotTokenOwner(token string) sdk.Error {
+	return sdk.NewError(CodeSpaceComment, CodeNotTokenOwner, fmt.Sprintf("Only the owner of %s can moderate its comments", token))
+}
+
+func ErrCommenterBanned(addr string) sdk.Error {
+	return sdk.NewError(CodeSpaceComment, CodeCommenterBanned, fmt.Sprintf("%s is banned from commenting on this token", addr))
+}
+
+func ErrDonationTooSmall(minDonation int64) sdk.Error {
+	return sdk.NewError(CodeSpaceComment, CodeDonationTooSmall, fmt.Sprintf("Donation must be at least %d", minDonation))
+}
+
+func ErrNoCommentID() sdk.Error {
+	return sdk.NewError(CodeSpaceComment, CodeNoCommentID, "No comment id is provided")
+}
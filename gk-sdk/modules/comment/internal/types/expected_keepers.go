@@ -12,7 +12,8 @@ type ExpectedBankxKeeper interface {
 
 // Asset Keeper will implement the interface
 type ExpectedAssetStatusKeeper interface {
-	IsTokenExists(ctx sdk.Context, denom string) bool // check whether there is a coin named "denom"
+	IsTokenExists(ctx sdk.Context, denom string) bool                      // check whether there is a coin named "denom"
+	IsTokenIssuer(ctx sdk.Context, denom string, addr sdk.AccAddress) bool // check whether addr is the owner of "denom"
 }
 
 type ExpectedDistributionxKeeper interface {
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/msgqueue"
+)
+
+// The keys of the moderation notifications sent over msgqueue
+const (
+	HideCommentKey  = "hide_comment"
+	BanCommenterKey = "ban_commenter"
+	MinDonationKey  = "set_min_donation"
+)
+
+func init() {
+	msgqueue.RegisterSchema(TokenCommentKey, 1, TokenComment{})
+	msgqueue.RegisterSchema(HideCommentKey, 1, HideCommentInfo{})
+	msgqueue.RegisterSchema(BanCommenterKey, 1, BanCommenterInfo{})
+	msgqueue.RegisterSchema(MinDonationKey, 1, MinDonationInfo{})
+}
+
+// TokenModeration is how the owner of a token moderates its comments
+type TokenModeration struct {
+	Token          string           `json:"token"`
+	MinDonation    int64            `json:"min_donation"`
+	BannedAddrs    []sdk.AccAddress `json:"banned_addresses"`
+	HiddenComments []uint64         `json:"hidden_comments"`
+}
+
+type HideCommentInfo struct {
+	Token  string   `json:"token"`
+	IDs    []uint64 `json:"ids"`
+	Hidden bool     `json:"hidden"` // false when the comments are unhidden
+	Sender string   `json:"sender"`
+	Height int64    `json:"height"`
+}
+
+type BanCommenterInfo struct {
+	Token     string   `json:"token"`
+	Addresses []string `json:"addresses"`
+	Banned    bool     `json:"banned"` // false when the addresses are unbanned
+	Sender    string   `json:"sender"`
+	Height    int64    `json:"height"`
+}
+
+type MinDonationInfo struct {
+	Token       string `json:"token"`
+	MinDonation int64  `json:"min_donation"`
+	Sender      string `json:"sender"`
+	Height      int64  `json:"height"`
+}
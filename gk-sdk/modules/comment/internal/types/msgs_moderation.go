@@ -0,0 +1,197 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	asset "github.com/coinexchain/cet-sdk/modules/asset"
+)
+
+// The messages below can only be sent by the owner of Token, to moderate its comments
+
+var _ sdk.Msg = MsgHideComment{}
+var _ sdk.Msg = MsgUnhideComment{}
+var _ sdk.Msg = MsgBanCommenter{}
+var _ sdk.Msg = MsgUnbanCommenter{}
+var _ sdk.Msg = MsgSetMinDonation{}
+
+type MsgHideComment struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Token  string         `json:"token"`
+	IDs    []uint64       `json:"ids"`
+}
+
+type MsgUnhideComment MsgHideComment
+
+type MsgBanCommenter struct {
+	Sender    sdk.AccAddress   `json:"sender"`
+	Token     string           `json:"token"`
+	Addresses []sdk.AccAddress `json:"addresses"`
+}
+
+type MsgUnbanCommenter MsgBanCommenter
+
+type MsgSetMinDonation struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	Token       string         `json:"token"`
+	MinDonation int64          `json:"min_donation"`
+}
+
+func NewMsgHideComment(sender sdk.AccAddress, token string, ids []uint64) MsgHideComment {
+	return MsgHideComment{Sender: sender, Token: token, IDs: ids}
+}
+
+func NewMsgUnhideComment(sender sdk.AccAddress, token string, ids []uint64) MsgUnhideComment {
+	return MsgUnhideComment{Sender: sender, Token: token, IDs: ids}
+}
+
+func NewMsgBanCommenter(sender sdk.AccAddress, token string, addresses []sdk.AccAddress) MsgBanCommenter {
+	return MsgBanCommenter{Sender: sender, Token: token, Addresses: addresses}
+}
+
+func NewMsgUnbanCommenter(sender sdk.AccAddress, token string, addresses []sdk.AccAddress) MsgUnbanCommenter {
+	return MsgUnbanCommenter{Sender: sender, Token: token, Addresses: addresses}
+}
+
+func NewMsgSetMinDonation(sender sdk.AccAddress, token string, minDonation int64) MsgSetMinDonation {
+	return MsgSetMinDonation{Sender: sender, Token: token, MinDonation: minDonation}
+}
+
+func validateSenderAndToken(sender sdk.AccAddress, token string) sdk.Error {
+	if len(sender) == 0 {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if err := asset.ValidateTokenSymbol(token); err != nil {
+		return ErrInvalidSymbol()
+	}
+	return nil
+}
+
+func validateIDs(ids []uint64) sdk.Error {
+	if len(ids) == 0 {
+		return ErrNoCommentID()
+	}
+	return nil
+}
+
+func validateAddresses(addresses []sdk.AccAddress) sdk.Error {
+	if len(addresses) == 0 {
+		return sdk.ErrInvalidAddress("missing addresses")
+	}
+	for _, addr := range addresses {
+		if addr.Empty() {
+			return sdk.ErrInvalidAddress("empty address")
+		}
+	}
+	return nil
+}
+
+// --------------------------------------------------------
+// sdk.Msg Implementation
+
+func (msg *MsgHideComment) SetAccAddress(addr sdk.AccAddress) { msg.Sender = addr }
+
+func (msg MsgHideComment) Route() string { return RouterKey }
+
+func (msg MsgHideComment) Type() string { return "hide_comment" }
+
+func (msg MsgHideComment) ValidateBasic() sdk.Error {
+	if err := validateSenderAndToken(msg.Sender, msg.Token); err != nil {
+		return err
+	}
+	return validateIDs(msg.IDs)
+}
+
+func (msg MsgHideComment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgHideComment) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg *MsgUnhideComment) SetAccAddress(addr sdk.AccAddress) { msg.Sender = addr }
+
+func (msg MsgUnhideComment) Route() string { return RouterKey }
+
+func (msg MsgUnhideComment) Type() string { return "unhide_comment" }
+
+func (msg MsgUnhideComment) ValidateBasic() sdk.Error {
+	if err := validateSenderAndToken(msg.Sender, msg.Token); err != nil {
+		return err
+	}
+	return validateIDs(msg.IDs)
+}
+
+func (msg MsgUnhideComment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUnhideComment) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg *MsgBanCommenter) SetAccAddress(addr sdk.AccAddress) { msg.Sender = addr }
+
+func (msg MsgBanCommenter) Route() string { return RouterKey }
+
+func (msg MsgBanCommenter) Type() string { return "ban_commenter" }
+
+func (msg MsgBanCommenter) ValidateBasic() sdk.Error {
+	if err := validateSenderAndToken(msg.Sender, msg.Token); err != nil {
+		return err
+	}
+	return validateAddresses(msg.Addresses)
+}
+
+func (msg MsgBanCommenter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgBanCommenter) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg *MsgUnbanCommenter) SetAccAddress(addr sdk.AccAddress) { msg.Sender = addr }
+
+func (msg MsgUnbanCommenter) Route() string { return RouterKey }
+
+func (msg MsgUnbanCommenter) Type() string { return "unban_commenter" }
+
+func (msg MsgUnbanCommenter) ValidateBasic() sdk.Error {
+	if err := validateSenderAndToken(msg.Sender, msg.Token); err != nil {
+		return err
+	}
+	return validateAddresses(msg.Addresses)
+}
+
+func (msg MsgUnbanCommenter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUnbanCommenter) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg *MsgSetMinDonation) SetAccAddress(addr sdk.AccAddress) { msg.Sender = addr }
+
+func (msg MsgSetMinDonation) Route() string { return RouterKey }
+
+func (msg MsgSetMinDonation) Type() string { return "set_min_donation" }
+
+func (msg MsgSetMinDonation) ValidateBasic() sdk.Error {
+	if err := validateSenderAndToken(msg.Sender, msg.Token); err != nil {
+		return err
+	}
+	if msg.MinDonation < 0 {
+		return ErrNegativeDonation()
+	}
+	return nil
+}
+
+func (msg MsgSetMinDonation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetMinDonation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -309,3 +309,25 @@ const Text3 = `
 
 而对于在中国影视圈从业多年，实现中产生活的三木（化名）来说，曾经微信号被封的经历已经让他不敢再轻易谈论国家大事。但他还是表示，其实"真实的心里话就是心里有一万只草泥马"，现在的他和他的许多朋友都是属于"贫贱不能移"的状态，没有积累够足够的财富移居海外。当如今中国政府做出"不惜一切代价"的表态时，他知道自己就是那个"代价"。
 `
+
+func TestModerationMsgs(t *testing.T) {
+	owner := simpleAddr("00001")
+	if err := NewMsgHideComment(owner, "cet", []uint64{1}).ValidateBasic(); err != nil {
+		t.Errorf("This should be a valid Msg!")
+	}
+	if err := NewMsgUnhideComment(owner, "cet", nil).ValidateBasic(); err == nil || err.Code() != CodeNoCommentID {
+		t.Errorf("Msg without ids should be invalid!")
+	}
+	if err := NewMsgBanCommenter(nil, "cet", []sdk.AccAddress{owner}).ValidateBasic(); err == nil {
+		t.Errorf("Msg without sender should be invalid!")
+	}
+	if err := NewMsgUnbanCommenter(owner, "cet", []sdk.AccAddress{nil}).ValidateBasic(); err == nil {
+		t.Errorf("Msg with empty address should be invalid!")
+	}
+	if err := NewMsgSetMinDonation(owner, "C-E-T", 1).ValidateBasic(); err == nil || err.Code() != CodeInvalidSymbol {
+		t.Errorf("Msg with invalid token should be invalid!")
+	}
+	if err := NewMsgSetMinDonation(owner, "cet", -1).ValidateBasic(); err == nil || err.Code() != CodeNegativeDonation {
+		t.Errorf("Msg with negative donation should be invalid!")
+	}
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	DefaultIndexComments       = false
+	DefaultMaxCommentsPerToken = 10000
+	DefaultMaxCommentAge       = 0
+)
+
+var (
+	KeyIndexComments       = []byte("IndexComments")
+	KeyMaxCommentsPerToken = []byte("MaxCommentsPerToken")
+	KeyMaxCommentAge       = []byte("MaxCommentAge")
+)
+
+type Params struct {
+	// whether the comments are kept in the on-chain index, or only sent out over msgqueue
+	IndexComments bool `json:"index_comments"`
+	// how many of the latest comments of a token are kept in the index, 0 means no limit
+	MaxCommentsPerToken uint64 `json:"max_comments_per_token"`
+	// how many blocks an indexed comment is kept, 0 means no limit
+	MaxCommentAge int64 `json:"max_comment_age"`
+}
+
+// ParamKeyTable for comment module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		IndexComments:       DefaultIndexComments,
+		MaxCommentsPerToken: DefaultMaxCommentsPerToken,
+		MaxCommentAge:       DefaultMaxCommentAge,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of comment module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyIndexComments, Value: &p.IndexComments},
+		{Key: KeyMaxCommentsPerToken, Value: &p.MaxCommentsPerToken},
+		{Key: KeyMaxCommentAge, Value: &p.MaxCommentAge},
+	}
+}
+
+func (p *Params) ValidateGenesis() error {
+	if p.MaxCommentAge < 0 {
+		return fmt.Errorf("%s must not be negative, is %d", KeyMaxCommentAge, p.MaxCommentAge)
+	}
+	return nil
+}
+
+// ValidateParams validates the comment params in the subspace
+func ValidateParams(ctx sdk.Context, ss params.Subspace) error {
+	var p Params
+	ss.GetParamSet(ctx, &p)
+	return p.ValidateGenesis()
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Comment Params:
+  IndexComments:       %t
+  MaxCommentsPerToken: %d
+  MaxCommentAge:       %d`,
+		p.IndexComments,
+		p.MaxCommentsPerToken,
+		p.MaxCommentAge)
+}
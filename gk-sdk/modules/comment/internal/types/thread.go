@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IndexedComment is a TokenComment kept in the on-chain comment index, together with
+// the statistics of the followups which reference it
+type IndexedComment struct {
+	Comment       TokenComment `json:"comment"`
+	FollowupCount uint64       `json:"followup_count"`
+	Rewards       sdk.Coins    `json:"rewards"` // the sum of the rewards paid by the followups
+}
+
+// CommentThread is a comment and its direct followups, oldest first
+type CommentThread struct {
+	Comment   IndexedComment   `json:"comment"`
+	Followups []IndexedComment `json:"followups"`
+}
+
+// AddReward records the reward paid by a followup which references this comment with ref
+func (c *IndexedComment) AddReward(ref CommentRef) {
+	if ref.RewardAmount > 0 {
+		c.Rewards = c.Rewards.Add(sdk.Coins{sdk.NewCoin(ref.RewardToken, sdk.NewInt(ref.RewardAmount))})
+	}
+}
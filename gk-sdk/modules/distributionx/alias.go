@@ -5,13 +5,28 @@ import (
 )
 
 const (
-	ModuleName = types.ModuleName
+	ModuleName                      = types.ModuleName
+	StoreKey                        = types.StoreKey
+	QuerierRoute                    = types.QuerierRoute
+	RouterKey                       = types.RouterKey
+	StreamPoolName                  = types.StreamPoolName
+	ProposalTypeCommunityPoolStream = types.ProposalTypeCommunityPoolStream
+	ProposalTypeCancelStream        = types.ProposalTypeCancelStream
 )
 
 type (
-	MsgDonateToCommunityPool = types.MsgDonateToCommunityPool
+	MsgDonateToCommunityPool    = types.MsgDonateToCommunityPool
+	MsgClaimStream              = types.MsgClaimStream
+	Stream                      = types.Stream
+	StreamStatus                = types.StreamStatus
+	CommunityPoolStreamProposal = types.CommunityPoolStreamProposal
+	CancelStreamProposal        = types.CancelStreamProposal
 )
 
 var (
-	ErrMemoRequiredWithdrawAddr = types.ErrMemoRequiredWithdrawAddr
+	ErrMemoRequiredWithdrawAddr    = types.ErrMemoRequiredWithdrawAddr
+	NewMsgClaimStream              = types.NewMsgClaimStream
+	NewCommunityPoolStreamProposal = types.NewCommunityPoolStreamProposal
+	NewCancelStreamProposal        = types.NewCancelStreamProposal
+	ModuleCdc                      = types.ModuleCdc
 )
@@ -0,0 +1,165 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
+)
+
+// CommunityPoolStreamProposalJSON defines a CommunityPoolStreamProposal with a deposit
+type CommunityPoolStreamProposalJSON struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Amount      sdk.Coins      `json:"amount"`
+	Blocks      int64          `json:"blocks"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// CancelStreamProposalJSON defines a CancelStreamProposal with a deposit
+type CancelStreamProposalJSON struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	StreamID    uint64    `json:"stream_id"`
+	Deposit     sdk.Coins `json:"deposit"`
+}
+
+// GetCmdSubmitStreamProposal implements the command to submit a community pool stream proposal
+func GetCmdSubmitStreamProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to pay from the community pool linearly over a number of blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool stream proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Once the proposal passes, the amount
+is moved out of the community pool and released to the recipient block by block, and the
+recipient claims the released coins with "tx claim-stream".
+
+Example:
+$ %s tx gov submit-proposal community-pool-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Block Explorer Grant",
+  "description": "Fund the block explorer for one year",
+  "recipient": "gkex1s5afhd6gxevu37mkqcvvsj8qeylhn0rz95pysw",
+  "amount": [
+    {
+      "denom": "gkex",
+      "amount": "100000000000"
+    }
+  ],
+  "blocks": "6307200",
+  "deposit": [
+    {
+      "denom": "gkex",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var proposal CommunityPoolStreamProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCommunityPoolStreamProposal(proposal.Title, proposal.Description,
+				proposal.Recipient, proposal.Amount, proposal.Blocks)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelStreamProposal implements the command to submit a cancel stream proposal
+func GetCmdSubmitCancelStreamProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-community-pool-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a community pool stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a cancel community pool stream proposal along with an initial deposit.
+Once the proposal passes, the coins already released are paid to the recipient and the rest
+is returned to the community pool.
+
+Example:
+$ %s tx gov submit-proposal cancel-community-pool-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Stop Block Explorer Grant",
+  "description": "The block explorer is no longer maintained",
+  "stream_id": "1",
+  "deposit": [
+    {
+      "denom": "gkex",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var proposal CancelStreamProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCancelStreamProposal(proposal.Title, proposal.Description, proposal.StreamID)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func parseProposalJSON(cdc *codec.Codec, proposalFile string, proposal interface{}) error {
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(contents, proposal)
+}
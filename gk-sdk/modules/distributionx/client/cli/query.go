@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
+	"github.com/coinexchain/cosmos-utils/client/cliutil"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	distrxQueryCmd := &cobra.Command{
+		Use:   types.StoreKey,
+		Short: "Querying commands for the distrx module",
+	}
+	distrxQueryCmd.AddCommand(client.GetCommands(
+		QueryStreamsCmd(cdc),
+	)...)
+	return distrxQueryCmd
+}
+
+func QueryStreamsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "streams",
+		Args:  cobra.NoArgs,
+		Short: "Query the active community pool streams and their remaining balances",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStreams)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,3 +38,27 @@ func DonateTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// ClaimStreamTxCmd will create a ClaimStream tx and sign it with the given key.
+func ClaimStreamTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-stream [stream-id]",
+		Short: "Claim the released coins of a community pool stream",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimStream(nil, id)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+	cmd.MarkFlagRequired(client.FlagFrom)
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
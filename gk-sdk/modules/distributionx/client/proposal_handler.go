@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/coinexchain/cet-sdk/modules/distributionx/client/cli"
+	"github.com/coinexchain/cet-sdk/modules/distributionx/client/rest"
+)
+
+// community pool stream proposal handlers
+var (
+	StreamProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitStreamProposal, rest.StreamProposalRESTHandler)
+	CancelStreamProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelStreamProposal, rest.CancelStreamProposalRESTHandler)
+)
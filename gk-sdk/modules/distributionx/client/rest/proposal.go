@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
+)
+
+// StreamProposalReq defines a community pool stream proposal request body
+type StreamProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Amount      sdk.Coins      `json:"amount"`
+	Blocks      int64          `json:"blocks"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// CancelStreamProposalReq defines a cancel stream proposal request body
+type CancelStreamProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	StreamID    uint64         `json:"stream_id"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// StreamProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool stream REST handler
+func StreamProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_stream",
+		Handler:  postStreamProposalHandlerFn(cliCtx),
+	}
+}
+
+// CancelStreamProposalRESTHandler returns a ProposalRESTHandler that exposes the cancel stream REST handler
+func CancelStreamProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_community_pool_stream",
+		Handler:  postCancelStreamProposalHandlerFn(cliCtx),
+	}
+}
+
+func postStreamProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req StreamProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		content := types.NewCommunityPoolStreamProposal(req.Title, req.Description, req.Recipient, req.Amount, req.Blocks)
+		writeProposal(w, cliCtx, req.BaseReq, gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer))
+	}
+}
+
+func postCancelStreamProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelStreamProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		content := types.NewCancelStreamProposal(req.Title, req.Description, req.StreamID)
+		writeProposal(w, cliCtx, req.BaseReq, gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer))
+	}
+}
+
+func writeProposal(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq, msg gov.MsgSubmitProposal) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+}
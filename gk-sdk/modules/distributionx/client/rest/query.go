@@ -0,0 +1,19 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
+	"github.com/coinexchain/cosmos-utils/client/restutil"
+)
+
+// QueryStreamsHandlerFn - http request handler to query the active community pool streams.
+func QueryStreamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStreams)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
+	}
+}
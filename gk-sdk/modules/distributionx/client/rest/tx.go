@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/coinexchain/cosmos-utils/client/restutil"
 
@@ -18,6 +19,8 @@ import (
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/distribution/{address}/donates", DonateTxRequestHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/distribution/streams", QueryStreamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/distribution/streams/{id}/claims", ClaimStreamTxRequestHandlerFn(cdc, cliCtx)).Methods("POST")
 }
 
 // SendReq defines the properties of a send request's body.
@@ -45,3 +48,26 @@ func (sr SendReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error
 func DonateTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return restutil.NewRestHandler(cdc, cliCtx, new(SendReq))
 }
+
+type ClaimStreamReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func (req *ClaimStreamReq) New() restutil.RestReq {
+	return new(ClaimStreamReq)
+}
+func (req *ClaimStreamReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *ClaimStreamReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgClaimStream(sender, id), nil
+}
+
+// ClaimStreamTxRequestHandlerFn - http request handler to claim the released coins of a stream.
+func ClaimStreamTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(ClaimStreamReq))
+}
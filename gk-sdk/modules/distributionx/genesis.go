@@ -0,0 +1,61 @@
+package distributionx
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
+)
+
+type GenesisState struct {
+	NextStreamID uint64         `json:"next_stream_id"`
+	Streams      []types.Stream `json:"streams"`
+}
+
+func NewGenesisState(nextStreamID uint64, streams []types.Stream) GenesisState {
+	return GenesisState{
+		NextStreamID: nextStreamID,
+		Streams:      streams,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(1, nil)
+}
+
+// InitGenesis - Init store state from genesis data
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetNextStreamID(ctx, data.NextStreamID)
+	for _, stream := range data.Streams {
+		keeper.SetStream(ctx, stream)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return NewGenesisState(keeper.GetNextStreamID(ctx), keeper.GetAllStreams(ctx))
+}
+
+// ValidateGenesis performs basic validation of distrx genesis data returning an
+// error for any failed validation criteria.
+func (data GenesisState) ValidateGenesis() error {
+	if data.NextStreamID == 0 {
+		return fmt.Errorf("next stream id must be positive")
+	}
+	ids := make(map[uint64]bool, len(data.Streams))
+	for _, stream := range data.Streams {
+		if stream.ID == 0 || stream.ID >= data.NextStreamID {
+			return fmt.Errorf("invalid stream id %d, next stream id is %d", stream.ID, data.NextStreamID)
+		}
+		if ids[stream.ID] {
+			return fmt.Errorf("duplicated stream id %d", stream.ID)
+		}
+		ids[stream.ID] = true
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
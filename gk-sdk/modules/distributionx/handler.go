@@ -1,6 +1,8 @@
 package distributionx
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
@@ -14,6 +16,8 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgDonateToCommunityPool:
 			return handleMsgDonateToCommunityPool(ctx, k, msg)
+		case types.MsgClaimStream:
+			return handleMsgClaimStream(ctx, k, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 		}
@@ -35,3 +39,23 @@ func handleMsgDonateToCommunityPool(ctx sdk.Context, k Keeper, msg types.MsgDona
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+func handleMsgClaimStream(ctx sdk.Context, k Keeper, msg types.MsgClaimStream) sdk.Result {
+	claimed, err := k.ClaimStream(ctx, msg.Recipient, msg.StreamID)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeClaimStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", msg.StreamID)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimed.String()),
+		),
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Recipient.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
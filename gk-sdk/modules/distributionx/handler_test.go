@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	types2 "github.com/coinexchain/cet-sdk/modules/distributionx/types"
@@ -24,6 +25,7 @@ type testInput struct {
 	ctx sdk.Context
 	ak  auth.AccountKeeper
 	dk  distribution.Keeper
+	sk  supply.Keeper
 }
 
 func setupTestInput() testInput {
@@ -37,6 +39,7 @@ func setupTestInput() testInput {
 		k:   testApp.DistrxKeeper,
 		ak:  testApp.AccountKeeper,
 		dk:  testApp.DistrKeeper,
+		sk:  testApp.SupplyKeeper,
 	}
 }
 func TestDonateToCommunityPool(t *testing.T) {
@@ -0,0 +1,28 @@
+package distributionx
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "stream-pool", StreamPoolInvariant(k))
+}
+
+// StreamPoolInvariant checks that the unclaimed coins of all streams are covered by the stream pool
+func StreamPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expected sdk.Coins
+		for _, stream := range k.GetAllStreams(ctx) {
+			expected = expected.Add(stream.Remaining())
+		}
+
+		actual := k.supplyKeeper.GetModuleAccount(ctx, types.StreamPoolName).GetCoins()
+		broken := !actual.IsAllGTE(expected)
+		return sdk.FormatInvariant(types.ModuleName, "stream pool",
+			fmt.Sprintf("\tunclaimed coins of streams: %s\n\tstream pool balance: %s\n", expected, actual)), broken
+	}
+}
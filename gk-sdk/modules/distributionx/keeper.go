@@ -1,21 +1,32 @@
 package distributionx
 
 import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/coinexchain/cet-sdk/modules/bankx"
+	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
 )
 
 type Keeper struct {
-	bxk bankx.Keeper
-	dk  distribution.Keeper
+	cdc          *codec.Codec
+	key          sdk.StoreKey
+	bxk          bankx.Keeper
+	dk           distribution.Keeper
+	supplyKeeper supply.Keeper
 }
 
-func NewKeeper(bxk bankx.Keeper, dk distribution.Keeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, bxk bankx.Keeper, dk distribution.Keeper, supplyKeeper supply.Keeper) Keeper {
 	return Keeper{
-		bxk,
-		dk,
+		cdc:          cdc,
+		key:          key,
+		bxk:          bxk,
+		dk:           dk,
+		supplyKeeper: supplyKeeper,
 	}
 }
 
@@ -33,3 +44,139 @@ func (keeper Keeper) DonateToCommunityPool(ctx sdk.Context, fromAddr sdk.AccAddr
 	keeper.AddCoinsToFeePool(ctx, amt)
 	return nil
 }
+
+// -----------------------------------------------------------------------------
+// Streams
+
+// CreateStream moves amount from the community pool into the stream pool and
+// releases it to recipient over the next blocks
+func (keeper Keeper) CreateStream(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, blocks int64) (types.Stream, sdk.Error) {
+	feePool := keeper.dk.GetFeePool(ctx)
+	communityPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoins(amount))
+	if negative {
+		return types.Stream{}, types.ErrInsufficientCommunityPool(amount.String())
+	}
+	if err := keeper.supplyKeeper.SendCoinsFromModuleToModule(ctx, distribution.ModuleName, types.StreamPoolName, amount); err != nil {
+		return types.Stream{}, err
+	}
+	feePool.CommunityPool = communityPool
+	keeper.dk.SetFeePool(ctx, feePool)
+
+	stream := types.NewStream(keeper.nextStreamID(ctx), recipient, amount, ctx.BlockHeight(), blocks)
+	keeper.SetStream(ctx, stream)
+	return stream, nil
+}
+
+// ClaimStream pays the released but unclaimed coins of a stream to its recipient
+func (keeper Keeper) ClaimStream(ctx sdk.Context, recipient sdk.AccAddress, id uint64) (sdk.Coins, sdk.Error) {
+	stream, found := keeper.GetStream(ctx, id)
+	if !found {
+		return nil, types.ErrStreamNotFound(id)
+	}
+	if !stream.Recipient.Equals(recipient) {
+		return nil, types.ErrNotStreamRecipient(id, recipient.String())
+	}
+
+	claimable := stream.Claimable(ctx.BlockHeight())
+	if claimable.Empty() {
+		return nil, types.ErrNothingToClaim(id)
+	}
+	if err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.StreamPoolName, recipient, claimable); err != nil {
+		return nil, err
+	}
+
+	stream.Claimed = stream.Claimed.Add(claimable)
+	if stream.Remaining().Empty() {
+		keeper.removeStream(ctx, id)
+	} else {
+		keeper.SetStream(ctx, stream)
+	}
+	return claimable, nil
+}
+
+// CancelStream pays out what has been released and returns the rest to the community pool
+func (keeper Keeper) CancelStream(ctx sdk.Context, id uint64) sdk.Error {
+	stream, found := keeper.GetStream(ctx, id)
+	if !found {
+		return types.ErrStreamNotFound(id)
+	}
+
+	claimable := stream.Claimable(ctx.BlockHeight())
+	if !claimable.Empty() {
+		if err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.StreamPoolName, stream.Recipient, claimable); err != nil {
+			return err
+		}
+	}
+
+	unreleased := stream.Remaining().Sub(claimable)
+	if !unreleased.Empty() {
+		if err := keeper.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.StreamPoolName, distribution.ModuleName, unreleased); err != nil {
+			return err
+		}
+		keeper.AddCoinsToFeePool(ctx, unreleased)
+	}
+
+	keeper.removeStream(ctx, id)
+	return nil
+}
+
+func (keeper Keeper) GetStream(ctx sdk.Context, id uint64) (stream types.Stream, found bool) {
+	bz := ctx.KVStore(keeper.key).Get(getStreamKey(id))
+	if bz == nil {
+		return
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &stream)
+	return stream, true
+}
+
+func (keeper Keeper) SetStream(ctx sdk.Context, stream types.Stream) {
+	bz := keeper.cdc.MustMarshalBinaryBare(stream)
+	ctx.KVStore(keeper.key).Set(getStreamKey(stream.ID), bz)
+}
+
+func (keeper Keeper) removeStream(ctx sdk.Context, id uint64) {
+	ctx.KVStore(keeper.key).Delete(getStreamKey(id))
+}
+
+func (keeper Keeper) GetAllStreams(ctx sdk.Context) []types.Stream {
+	var streams []types.Stream
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.key), types.StreamKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.Stream
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &stream)
+		streams = append(streams, stream)
+	}
+	return streams
+}
+
+// RebaseHeights moves the heights of the streams back by height, for a genesis which restarts from zero height
+func (keeper Keeper) RebaseHeights(ctx sdk.Context, height int64) {
+	for _, stream := range keeper.GetAllStreams(ctx) {
+		stream.StartHeight -= height
+		stream.EndHeight -= height
+		keeper.SetStream(ctx, stream)
+	}
+}
+
+func (keeper Keeper) GetNextStreamID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(keeper.key).Get(types.NextStreamIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (keeper Keeper) SetNextStreamID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(keeper.key).Set(types.NextStreamIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (keeper Keeper) nextStreamID(ctx sdk.Context) uint64 {
+	id := keeper.GetNextStreamID(ctx)
+	keeper.SetNextStreamID(ctx, id+1)
+	return id
+}
+
+func getStreamKey(id uint64) []byte {
+	return append(types.StreamKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
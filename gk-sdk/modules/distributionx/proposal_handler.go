@@ -0,0 +1,27 @@
+package distributionx
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
+)
+
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.CommunityPoolStreamProposal:
+			_, err := k.CreateStream(ctx, c.Recipient, c.Amount, c.Blocks)
+			return err
+
+		case types.CancelStreamProposal:
+			return k.CancelStream(ctx, c.StreamID)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized distrx proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package distributionx
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryStreams:
+			return queryStreams(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown distrx query endpoint")
+		}
+	}
+}
+
+func queryStreams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	streams := k.GetAllStreams(ctx)
+	statuses := make([]types.StreamStatus, 0, len(streams))
+	for _, stream := range streams {
+		statuses = append(statuses, types.NewStreamStatus(stream, ctx.BlockHeight()))
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, statuses)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
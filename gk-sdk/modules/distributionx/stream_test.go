@@ -0,0 +1,137 @@
+package distributionx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+
+	"github.com/coinexchain/cet-sdk/modules/distributionx"
+	"github.com/coinexchain/cet-sdk/modules/distributionx/types"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func setupCommunityPool(input testInput, amt int64) {
+	coins := dex.NewCetCoins(amt)
+	distrAcc := input.sk.GetModuleAccount(input.ctx, distribution.ModuleName)
+	_ = distrAcc.SetCoins(coins)
+	input.ak.SetAccount(input.ctx, distrAcc)
+	input.k.AddCoinsToFeePool(input.ctx, coins)
+}
+
+func communityPoolAmount(input testInput) sdk.Dec {
+	return input.dk.GetFeePool(input.ctx).CommunityPool.AmountOf(dex.CET)
+}
+
+func cetAmount(input testInput, addr sdk.AccAddress) int64 {
+	return input.ak.GetAccount(input.ctx, addr).GetCoins().AmountOf(dex.CET).Int64()
+}
+
+func TestStreamProposalAndClaim(t *testing.T) {
+	input := setupTestInput()
+	setupCommunityPool(input, 1000)
+	recipient := testutil.ToAccAddress("recipient___________")
+	input.ak.SetAccount(input.ctx, input.ak.NewAccountWithAddress(input.ctx, recipient))
+
+	proposalHandler := distributionx.NewProposalHandler(input.k)
+	ctx := input.ctx.WithBlockHeight(100)
+	proposal := types.NewCommunityPoolStreamProposal("grant", "grant", recipient, dex.NewCetCoins(400), 100)
+	require.Nil(t, proposalHandler(ctx, proposal))
+	require.Equal(t, sdk.NewDec(600), communityPoolAmount(input))
+
+	stream, found := input.k.GetStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, int64(200), stream.EndHeight)
+
+	// too much for the community pool
+	proposal = types.NewCommunityPoolStreamProposal("grant", "grant", recipient, dex.NewCetCoins(601), 100)
+	require.Equal(t, types.CodeInsufficientCommunityPool, proposalHandler(ctx, proposal).Code())
+
+	handler := distributionx.NewHandler(input.k)
+	res := handler(ctx, types.NewMsgClaimStream(recipient, 1))
+	require.Equal(t, types.CodeNothingToClaim, res.Code)
+
+	ctx = input.ctx.WithBlockHeight(125)
+	res = handler(ctx, types.NewMsgClaimStream(testutil.ToAccAddress("someone_____________"), 1))
+	require.Equal(t, types.CodeNotStreamRecipient, res.Code)
+	res = handler(ctx, types.NewMsgClaimStream(recipient, 1))
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(100), cetAmount(input, recipient))
+
+	ctx = input.ctx.WithBlockHeight(300)
+	res = handler(ctx, types.NewMsgClaimStream(recipient, 1))
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(400), cetAmount(input, recipient))
+
+	// fully claimed streams are removed
+	_, found = input.k.GetStream(ctx, 1)
+	require.False(t, found)
+	res = handler(ctx, types.NewMsgClaimStream(recipient, 1))
+	require.Equal(t, types.CodeStreamNotFound, res.Code)
+}
+
+func TestCancelStream(t *testing.T) {
+	input := setupTestInput()
+	setupCommunityPool(input, 1000)
+	recipient := testutil.ToAccAddress("recipient___________")
+	input.ak.SetAccount(input.ctx, input.ak.NewAccountWithAddress(input.ctx, recipient))
+
+	proposalHandler := distributionx.NewProposalHandler(input.k)
+	ctx := input.ctx.WithBlockHeight(10)
+	require.Nil(t, proposalHandler(ctx, types.NewCommunityPoolStreamProposal("grant", "grant", recipient, dex.NewCetCoins(1000), 10)))
+	require.True(t, communityPoolAmount(input).IsZero())
+
+	ctx = input.ctx.WithBlockHeight(13)
+	res := distributionx.NewHandler(input.k)(ctx, types.NewMsgClaimStream(recipient, 1))
+	require.True(t, res.IsOK())
+
+	ctx = input.ctx.WithBlockHeight(16)
+	require.Nil(t, proposalHandler(ctx, types.NewCancelStreamProposal("stop", "stop", 1)))
+	require.Equal(t, int64(600), cetAmount(input, recipient))
+	require.Equal(t, sdk.NewDec(400), communityPoolAmount(input))
+	require.True(t, input.sk.GetModuleAccount(ctx, types.StreamPoolName).GetCoins().Empty())
+
+	require.Equal(t, types.CodeStreamNotFound, proposalHandler(ctx, types.NewCancelStreamProposal("stop", "stop", 1)).Code())
+}
+
+func TestQueryStreamsAndGenesis(t *testing.T) {
+	input := setupTestInput()
+	setupCommunityPool(input, 1000)
+	recipient := testutil.ToAccAddress("recipient___________")
+
+	ctx := input.ctx.WithBlockHeight(1)
+	_, err := input.k.CreateStream(ctx, recipient, dex.NewCetCoins(100), 10)
+	require.Nil(t, err)
+	_, err = input.k.CreateStream(ctx, recipient, dex.NewCetCoins(200), 20)
+	require.Nil(t, err)
+
+	ctx = input.ctx.WithBlockHeight(6)
+	querier := distributionx.NewQuerier(input.k)
+	res, err := querier(ctx, []string{types.QueryStreams}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var statuses []types.StreamStatus
+	types.ModuleCdc.MustUnmarshalJSON(res, &statuses)
+	require.Len(t, statuses, 2)
+	require.Equal(t, dex.NewCetCoins(50), statuses[0].Claimable)
+	require.Equal(t, dex.NewCetCoins(100), statuses[0].Remaining)
+	require.Equal(t, dex.NewCetCoins(50), statuses[1].Claimable)
+	require.Equal(t, dex.NewCetCoins(200), statuses[1].Remaining)
+
+	_, broken := distributionx.StreamPoolInvariant(input.k)(ctx)
+	require.False(t, broken)
+
+	genesis := distributionx.ExportGenesis(ctx, input.k)
+	require.Nil(t, genesis.ValidateGenesis())
+	require.Equal(t, uint64(3), genesis.NextStreamID)
+
+	input2 := setupTestInput()
+	distributionx.InitGenesis(input2.ctx, input2.k, genesis)
+	require.Equal(t, genesis, distributionx.ExportGenesis(input2.ctx, input2.k))
+
+	genesis.NextStreamID = 2
+	require.NotNil(t, genesis.ValidateGenesis())
+}
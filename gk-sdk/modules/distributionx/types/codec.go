@@ -13,4 +13,7 @@ func init() {
 // RegisterCodec registers concrete types on the codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgDonateToCommunityPool{}, "distrx/MsgDonateToCommunityPool", nil)
+	cdc.RegisterConcrete(MsgClaimStream{}, "distrx/MsgClaimStream", nil)
+	cdc.RegisterConcrete(CommunityPoolStreamProposal{}, "distrx/CommunityPoolStreamProposal", nil)
+	cdc.RegisterConcrete(CancelStreamProposal{}, "distrx/CancelStreamProposal", nil)
 }
@@ -9,9 +9,14 @@ import (
 const (
 	CodespaceDistrx sdk.CodespaceType = "distrx"
 
-	CodeInvalidFromAddr          sdk.CodeType = 801
-	CodeInvalidDonation          sdk.CodeType = 802
-	CodeMemoRequiredWithdrawAddr sdk.CodeType = 803
+	CodeInvalidFromAddr           sdk.CodeType = 801
+	CodeInvalidDonation           sdk.CodeType = 802
+	CodeMemoRequiredWithdrawAddr  sdk.CodeType = 803
+	CodeInvalidStream             sdk.CodeType = 804
+	CodeStreamNotFound            sdk.CodeType = 805
+	CodeNotStreamRecipient        sdk.CodeType = 806
+	CodeNothingToClaim            sdk.CodeType = 807
+	CodeInsufficientCommunityPool sdk.CodeType = 808
 )
 
 func ErrorInvalidFromAddr() sdk.Error {
@@ -25,3 +30,23 @@ func ErrorInvalidDonation(format string) sdk.Error {
 func ErrMemoRequiredWithdrawAddr(address string) sdk.Error {
 	return sdk.NewError(CodespaceDistrx, CodeMemoRequiredWithdrawAddr, fmt.Sprintf("cannot set memo-required address %s be withdraw address", address))
 }
+
+func ErrInvalidStream(reason string) sdk.Error {
+	return sdk.NewError(CodespaceDistrx, CodeInvalidStream, fmt.Sprintf("invalid stream: %s", reason))
+}
+
+func ErrStreamNotFound(id uint64) sdk.Error {
+	return sdk.NewError(CodespaceDistrx, CodeStreamNotFound, fmt.Sprintf("stream %d not found", id))
+}
+
+func ErrNotStreamRecipient(id uint64, addr string) sdk.Error {
+	return sdk.NewError(CodespaceDistrx, CodeNotStreamRecipient, fmt.Sprintf("%s is not the recipient of stream %d", addr, id))
+}
+
+func ErrNothingToClaim(id uint64) sdk.Error {
+	return sdk.NewError(CodespaceDistrx, CodeNothingToClaim, fmt.Sprintf("nothing to claim from stream %d", id))
+}
+
+func ErrInsufficientCommunityPool(amount string) sdk.Error {
+	return sdk.NewError(CodespaceDistrx, CodeInsufficientCommunityPool, fmt.Sprintf("community pool does not have %s", amount))
+}
@@ -60,3 +60,38 @@ func (msg MsgDonateToCommunityPool) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = MsgClaimStream{}
+
+// MsgClaimStream withdraws the released coins of a stream to its recipient
+type MsgClaimStream struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	StreamID  uint64         `json:"stream_id"`
+}
+
+func NewMsgClaimStream(recipient sdk.AccAddress, id uint64) MsgClaimStream {
+	return MsgClaimStream{
+		Recipient: recipient,
+		StreamID:  id,
+	}
+}
+func (msg *MsgClaimStream) SetAccAddress(address sdk.AccAddress) {
+	msg.Recipient = address
+}
+func (msg MsgClaimStream) Route() string { return ModuleName }
+func (msg MsgClaimStream) Type() string  { return "claim_stream" }
+
+func (msg MsgClaimStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Recipient}
+}
+
+func (msg MsgClaimStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgClaimStream) ValidateBasic() sdk.Error {
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	return nil
+}
@@ -62,3 +62,35 @@ func TestDonateToCommunityPoolGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(signers))
 	require.Equal(t, addr, signers[0])
 }
+
+func TestClaimStreamValidation(t *testing.T) {
+	var emptyAddr sdk.AccAddress
+	addr := sdk.AccAddress([]byte("addr"))
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgClaimStream(addr, 1)},
+		{Valid: false, Msg: NewMsgClaimStream(emptyAddr, 1)},
+	})
+	require.Equal(t, []sdk.AccAddress{addr}, NewMsgClaimStream(addr, 1).GetSigners())
+}
+
+func TestStreamVested(t *testing.T) {
+	stream := NewStream(1, sdk.AccAddress([]byte("addr")), dex.NewCetCoins(100), 10, 3)
+	require.True(t, stream.Vested(10).Empty())
+	require.Equal(t, dex.NewCetCoins(33), stream.Vested(11))
+	require.Equal(t, dex.NewCetCoins(66), stream.Vested(12))
+	require.Equal(t, dex.NewCetCoins(100), stream.Vested(13))
+
+	stream.Claimed = dex.NewCetCoins(33)
+	require.Equal(t, dex.NewCetCoins(33), stream.Claimable(12))
+	require.Equal(t, dex.NewCetCoins(67), stream.Remaining())
+}
+
+func TestStreamProposalValidation(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr"))
+	require.Nil(t, NewCommunityPoolStreamProposal("t", "d", addr, validCoins, 10).ValidateBasic())
+	require.NotNil(t, NewCommunityPoolStreamProposal("t", "d", nil, validCoins, 10).ValidateBasic())
+	require.NotNil(t, NewCommunityPoolStreamProposal("t", "d", addr, sdk.Coins{}, 10).ValidateBasic())
+	require.NotNil(t, NewCommunityPoolStreamProposal("t", "d", addr, validCoins, 0).ValidateBasic())
+	require.NotNil(t, NewCancelStreamProposal("", "d", 1).ValidateBasic())
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeCommunityPoolStream defines the type for a CommunityPoolStreamProposal
+	ProposalTypeCommunityPoolStream = "CommunityPoolStream"
+	// ProposalTypeCancelStream defines the type for a CancelStreamProposal
+	ProposalTypeCancelStream = "CancelCommunityPoolStream"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = CommunityPoolStreamProposal{}
+	_ govtypes.Content = CancelStreamProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolStream)
+	govtypes.RegisterProposalTypeCodec(CommunityPoolStreamProposal{}, "distrx/CommunityPoolStreamProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelStream)
+	govtypes.RegisterProposalTypeCodec(CancelStreamProposal{}, "distrx/CancelStreamProposal")
+}
+
+// CommunityPoolStreamProposal pays Amount from the community pool to Recipient
+// linearly over Blocks blocks
+type CommunityPoolStreamProposal struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Amount      sdk.Coins      `json:"amount"`
+	Blocks      int64          `json:"blocks"`
+}
+
+func NewCommunityPoolStreamProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins, blocks int64) CommunityPoolStreamProposal {
+	return CommunityPoolStreamProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient,
+		Amount:      amount,
+		Blocks:      blocks,
+	}
+}
+
+// GetTitle returns the title of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) ProposalType() string { return ProposalTypeCommunityPoolStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp CommunityPoolStreamProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(CodespaceDistrx, csp); err != nil {
+		return err
+	}
+	if csp.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !csp.Amount.IsValid() || csp.Amount.Empty() {
+		return ErrInvalidStream(fmt.Sprintf("invalid amount %s", csp.Amount))
+	}
+	if csp.Blocks <= 0 {
+		return ErrInvalidStream(fmt.Sprintf("invalid blocks %d", csp.Blocks))
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (csp CommunityPoolStreamProposal) String() string {
+	return fmt.Sprintf(`Community Pool Stream Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Blocks:      %d
+`, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.Blocks)
+}
+
+// CancelStreamProposal stops a stream, paying out what has been released
+// and returning the rest to the community pool
+type CancelStreamProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	StreamID    uint64 `json:"stream_id"`
+}
+
+func NewCancelStreamProposal(title, description string, id uint64) CancelStreamProposal {
+	return CancelStreamProposal{Title: title, Description: description, StreamID: id}
+}
+
+// GetTitle returns the title of a cancel stream proposal.
+func (csp CancelStreamProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a cancel stream proposal.
+func (csp CancelStreamProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a cancel stream proposal.
+func (csp CancelStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel stream proposal.
+func (csp CancelStreamProposal) ProposalType() string { return ProposalTypeCancelStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp CancelStreamProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(CodespaceDistrx, csp)
+}
+
+// String implements the Stringer interface.
+func (csp CancelStreamProposal) String() string {
+	return fmt.Sprintf(`Cancel Community Pool Stream Proposal:
+  Title:       %s
+  Description: %s
+  Stream:      %d
+`, csp.Title, csp.Description, csp.StreamID)
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	StoreKey     = ModuleName
+	QuerierRoute = StoreKey
+
+	// StreamPoolName is the module account holding the funds of active streams
+	StreamPoolName = "distrx_streams"
+
+	QueryStreams = "streams"
+
+	EventTypeClaimStream = "claim_stream"
+	AttributeKeyStreamID = "stream_id"
+)
+
+var (
+	StreamKeyPrefix = []byte{0x01}
+	NextStreamIDKey = []byte{0x02}
+)
+
+// Stream releases Amount from the community pool to Recipient linearly
+// between StartHeight and EndHeight
+type Stream struct {
+	ID          uint64         `json:"id"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Amount      sdk.Coins      `json:"amount"`
+	Claimed     sdk.Coins      `json:"claimed"`
+	StartHeight int64          `json:"start_height"`
+	EndHeight   int64          `json:"end_height"`
+}
+
+func NewStream(id uint64, recipient sdk.AccAddress, amount sdk.Coins, startHeight, blocks int64) Stream {
+	return Stream{
+		ID:          id,
+		Recipient:   recipient,
+		Amount:      amount,
+		StartHeight: startHeight,
+		EndHeight:   startHeight + blocks,
+	}
+}
+
+// Vested returns the part of Amount released at height
+func (s Stream) Vested(height int64) sdk.Coins {
+	if height >= s.EndHeight {
+		return s.Amount
+	}
+	if height <= s.StartHeight {
+		return sdk.Coins{}
+	}
+
+	elapsed, duration := height-s.StartHeight, s.EndHeight-s.StartHeight
+	vested := sdk.Coins{}
+	for _, coin := range s.Amount {
+		amt := coin.Amount.MulRaw(elapsed).QuoRaw(duration)
+		if amt.IsPositive() {
+			vested = append(vested, sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return vested
+}
+
+// Claimable returns the released coins not yet claimed at height
+func (s Stream) Claimable(height int64) sdk.Coins {
+	return s.Vested(height).Sub(s.Claimed)
+}
+
+// Remaining returns the coins not yet claimed
+func (s Stream) Remaining() sdk.Coins {
+	return s.Amount.Sub(s.Claimed)
+}
+
+func (s Stream) Validate() error {
+	if s.Recipient.Empty() {
+		return ErrInvalidStream("empty recipient")
+	}
+	if !s.Amount.IsValid() || s.Amount.Empty() {
+		return ErrInvalidStream(fmt.Sprintf("invalid amount %s", s.Amount))
+	}
+	if !s.Claimed.IsValid() || !s.Amount.IsAllGTE(s.Claimed) {
+		return ErrInvalidStream(fmt.Sprintf("invalid claimed amount %s", s.Claimed))
+	}
+	// a stream started before a zero height restart has a negative StartHeight
+	if s.EndHeight <= s.StartHeight {
+		return ErrInvalidStream(fmt.Sprintf("invalid heights %d to %d", s.StartHeight, s.EndHeight))
+	}
+	return nil
+}
+
+func (s Stream) String() string {
+	return fmt.Sprintf(`Stream %d:
+  Recipient:    %s
+  Amount:       %s
+  Claimed:      %s
+  Start Height: %d
+  End Height:   %d`,
+		s.ID, s.Recipient, s.Amount, s.Claimed, s.StartHeight, s.EndHeight)
+}
+
+// StreamStatus is the query result of an active stream
+type StreamStatus struct {
+	Stream    Stream    `json:"stream"`
+	Claimable sdk.Coins `json:"claimable"`
+	Remaining sdk.Coins `json:"remaining"`
+}
+
+func NewStreamStatus(s Stream, height int64) StreamStatus {
+	return StreamStatus{
+		Stream:    s,
+		Claimable: s.Claimable(height),
+		Remaining: s.Remaining(),
+	}
+}
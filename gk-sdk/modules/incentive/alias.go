@@ -11,12 +11,24 @@ type (
 	Params       = types.Params
 	Plan         = types.Plan
 	Keeper       = keepers.Keeper
+
+	RewardTarget       = types.RewardTarget
+	PlanChange         = types.PlanChange
+	PlanChangeProposal = types.PlanChangeProposal
+	Projection         = types.Projection
+	PlanProjection     = types.PlanProjection
 )
 
 const (
 	ModuleName        = types.ModuleName
 	StoreKey          = types.StoreKey
 	DefaultParamspace = types.DefaultParamspace
+	RouterKey         = types.RouterKey
+
+	CommunityPoolTarget    = types.CommunityPoolTarget
+	ShareBase              = types.ShareBase
+	AppendPlanIndex        = types.AppendPlanIndex
+	ProposalTypePlanChange = types.ProposalTypePlanChange
 )
 
 var (
@@ -24,4 +36,7 @@ var (
 	DefaultGenesisState = types.DefaultGenesisState
 	DefaultParams       = types.DefaultParams
 	NewKeeper           = keepers.NewKeeper
+
+	NewPlanChangeProposal = types.NewPlanChangeProposal
+	NewPlanProjection     = types.NewPlanProjection
 )
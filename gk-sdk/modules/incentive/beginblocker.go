@@ -1,37 +1,56 @@
 package incentive
 
 import (
-	"github.com/tendermint/tendermint/crypto"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 
 	"github.com/coinexchain/cet-sdk/modules/incentive/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/incentive/internal/types"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 var (
-	PoolAddr = sdk.AccAddress(crypto.AddressHash([]byte("incentive_pool")))
+	PoolAddr = types.PoolAddr
 )
 
+// targetReward is the reward paid to a RewardTarget in the current block
+type targetReward struct {
+	target types.RewardTarget
+	amount int64
+}
+
 func BeginBlocker(ctx sdk.Context, k keepers.Keeper) {
-	blockRewards := calcRewards(ctx, k)
+	blockRewards, targetRewards := calcRewards(ctx, k)
 	if k.HasCoins(ctx, PoolAddr, blockRewards) {
-		if err := collectRewardsFromPool(k, ctx, blockRewards); err != nil {
+		if err := collectRewardsFromPool(k, ctx, blockRewards, targetRewards); err != nil {
 			panic(err)
 		}
 	}
 }
 
-func collectRewardsFromPool(k keepers.Keeper, ctx sdk.Context, blockRewards sdk.Coins) sdk.Error {
-	//transfer rewards into collected_fees for further distribution
+func collectRewardsFromPool(k keepers.Keeper, ctx sdk.Context, blockRewards sdk.Coins, targetRewards []targetReward) sdk.Error {
+	for _, tr := range targetRewards {
+		rewards := sdk.NewCoins(sdk.NewInt64Coin(dex.DefaultBondDenom, tr.amount))
+		// a target which can not be paid must not halt the chain, its share goes to collected_fees with the rest
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.SendRewards(cacheCtx, tr.target, rewards); err != nil {
+			ctx.Logger().Error("failed to pay the incentive rewards", "target", tr.target.String(), "error", err.Error())
+			continue
+		}
+		write()
+		blockRewards = blockRewards.Sub(rewards)
+	}
+	if blockRewards.IsZero() {
+		return nil
+	}
+	//transfer the rest rewards into collected_fees for further distribution
 	if err := k.SendCoinsFromAccountToModule(ctx, PoolAddr, auth.FeeCollectorName, blockRewards); err != nil {
 		return err
 	}
 	return nil
 }
 
-func calcRewards(ctx sdk.Context, k keepers.Keeper) sdk.Coins {
+func calcRewards(ctx sdk.Context, k keepers.Keeper) (sdk.Coins, []targetReward) {
 	height := ctx.BlockHeader().Height
 	adjustmentHeight := k.GetState(ctx).HeightAdjustment
 	height += adjustmentHeight
@@ -39,12 +58,18 @@ func calcRewards(ctx sdk.Context, k keepers.Keeper) sdk.Coins {
 	rewardAmount := int64(0)
 	inPlan := false
 	plans := k.GetParams(ctx).Plans
+	var targetRewards []targetReward
 
 	for _, plan := range plans {
 		if height > plan.StartHeight && height <= plan.EndHeight {
 			// the height may be in different plans, do not break
 			rewardAmount += plan.RewardPerBlock
 			inPlan = true
+			for _, target := range plan.Targets {
+				if amount := plan.GetTargetReward(target); amount > 0 {
+					targetRewards = append(targetRewards, targetReward{target: target, amount: amount})
+				}
+			}
 		}
 	}
 
@@ -53,5 +78,5 @@ func calcRewards(ctx sdk.Context, k keepers.Keeper) sdk.Coins {
 	}
 
 	blockRewardsCoins := sdk.NewCoins(sdk.NewInt64Coin(dex.DefaultBondDenom, rewardAmount))
-	return blockRewardsCoins
+	return blockRewardsCoins, targetRewards
 }
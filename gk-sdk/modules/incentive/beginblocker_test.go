@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/coinexchain/cet-sdk/modules/incentive"
@@ -25,12 +27,13 @@ type TestInput struct {
 	keeper incentive.Keeper
 	ak     auth.AccountKeeper
 	sk     supply.Keeper
+	dk     distribution.Keeper
 }
 
 func SetupTestInput() TestInput {
 	app := testapp.NewTestApp()
 	ctx := sdk.NewContext(app.Cms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
-	return TestInput{ctx: ctx, cdc: app.Cdc, keeper: app.IncentiveKeeper, ak: app.AccountKeeper, sk: app.SupplyKeeper}
+	return TestInput{ctx: ctx, cdc: app.Cdc, keeper: app.IncentiveKeeper, ak: app.AccountKeeper, sk: app.SupplyKeeper, dk: app.DistrKeeper}
 }
 
 func TestBeginBlockerInvalidCoin(t *testing.T) {
@@ -110,6 +113,80 @@ func TestBeginBlockerNotInPlan(t *testing.T) {
 	require.Equal(t, reward, feeBalanceAfter-feeBalanceBefore)
 }
 
+func TestBeginBlockerRewardTargets(t *testing.T) {
+	input := SetupTestInput()
+	maker := sdk.AccAddress("market_maker_pool___")
+	plans := types.Params{
+		DefaultRewardPerBlock: 2e8,
+		Plans: []types.Plan{
+			{
+				StartHeight:    0,
+				EndHeight:      10,
+				RewardPerBlock: 10e8,
+				TotalIncentive: 100e8,
+				Targets: []types.RewardTarget{
+					{Address: maker, Share: 2000},
+					{ModuleName: incentive.CommunityPoolTarget, Share: 500},
+				},
+			},
+		},
+	}
+	_ = input.keeper.SetState(input.ctx, incentive.State{HeightAdjustment: 10})
+	input.keeper.SetParams(input.ctx, plans)
+	input.dk.SetFeePool(input.ctx, distribution.InitialFeePool())
+	acc := input.ak.NewAccountWithAddress(input.ctx, incentive.PoolAddr)
+	_ = acc.SetCoins(dex.NewCetCoins(10000 * 1e8))
+	input.ak.SetAccount(input.ctx, acc)
+
+	feeBalanceBefore := input.sk.GetModuleAccount(input.ctx, auth.FeeCollectorName).GetCoins().AmountOf(dex.CET).Int64()
+
+	incentive.BeginBlocker(input.ctx, input.keeper)
+
+	poolBalanceAfter := input.ak.GetAccount(input.ctx, incentive.PoolAddr).GetCoins().AmountOf(dex.CET).Int64()
+	feeBalanceAfter := input.sk.GetModuleAccount(input.ctx, auth.FeeCollectorName).GetCoins().AmountOf(dex.CET).Int64()
+	makerBalance := input.ak.GetAccount(input.ctx, maker).GetCoins().AmountOf(dex.CET).Int64()
+	communityPool := input.dk.GetFeePool(input.ctx).CommunityPool.AmountOf(dex.CET)
+
+	require.Equal(t, int64(10000*1e8-10e8), poolBalanceAfter)
+	require.Equal(t, int64(2e8), makerBalance)
+	require.Equal(t, sdk.NewDec(5e7), communityPool)
+	require.Equal(t, int64(75e7), feeBalanceAfter-feeBalanceBefore)
+}
+
+func TestBeginBlockerUnpaidTarget(t *testing.T) {
+	input := SetupTestInput()
+	// the params are set directly, so the target is not checked
+	plans := types.Params{
+		DefaultRewardPerBlock: 2e8,
+		Plans: []types.Plan{
+			{
+				StartHeight:    0,
+				EndHeight:      10,
+				RewardPerBlock: 10e8,
+				TotalIncentive: 100e8,
+				Targets:        []types.RewardTarget{{ModuleName: staking.BondedPoolName, Share: 2000}},
+			},
+		},
+	}
+	_ = input.keeper.SetState(input.ctx, incentive.State{HeightAdjustment: 10})
+	input.keeper.SetParams(input.ctx, plans)
+	acc := input.ak.NewAccountWithAddress(input.ctx, incentive.PoolAddr)
+	_ = acc.SetCoins(dex.NewCetCoins(10000 * 1e8))
+	input.ak.SetAccount(input.ctx, acc)
+
+	bondedBalanceBefore := input.sk.GetModuleAccount(input.ctx, staking.BondedPoolName).GetCoins().AmountOf(dex.CET).Int64()
+	feeBalanceBefore := input.sk.GetModuleAccount(input.ctx, auth.FeeCollectorName).GetCoins().AmountOf(dex.CET).Int64()
+
+	require.NotPanics(t, func() { incentive.BeginBlocker(input.ctx, input.keeper) })
+
+	bondedBalanceAfter := input.sk.GetModuleAccount(input.ctx, staking.BondedPoolName).GetCoins().AmountOf(dex.CET).Int64()
+	feeBalanceAfter := input.sk.GetModuleAccount(input.ctx, auth.FeeCollectorName).GetCoins().AmountOf(dex.CET).Int64()
+
+	// the share of the target goes to the fee collector
+	require.Equal(t, int64(0), bondedBalanceAfter-bondedBalanceBefore)
+	require.Equal(t, int64(10e8), feeBalanceAfter-feeBalanceBefore)
+}
+
 func TestIncentiveCoinsAddress(t *testing.T) {
 	require.Equal(t, "coinex1gc5t98jap4zyhmhmyq5af5s7pyv57w5694el97", incentive.PoolAddr.String())
 }
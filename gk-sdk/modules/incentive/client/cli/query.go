@@ -22,6 +22,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	aliasQueryCmd.AddCommand(client.GetCommands(
 		QueryParamsCmd(cdc),
+		QueryProjectionCmd(cdc),
 	)...)
 	return aliasQueryCmd
 }
@@ -37,3 +38,15 @@ func QueryParamsCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func QueryProjectionCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "projection",
+		Args:  cobra.NoArgs,
+		Short: "Query the remaining incentive of every plan",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryProjection)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/coinexchain/cet-sdk/modules/incentive/internal/types"
+)
+
+// PlanChangeProposalJSON defines a PlanChangeProposal with a deposit
+type PlanChangeProposalJSON struct {
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	Changes     []types.PlanChange `json:"changes"`
+	Deposit     sdk.Coins          `json:"deposit"`
+}
+
+// GetCmdSubmitProposal implements the command to submit an incentive plan change proposal
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentive-plan-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to append or adjust incentive plans",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an incentive plan change proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. A change with index -1 appends a new plan,
+otherwise it replaces the plan at the index. Each plan may direct shares (in 1/%d) of its rewards
+to module accounts, normal accounts, or "%s", and the rest is paid into the fee collector.
+
+Example:
+$ %s tx gov submit-proposal incentive-plan-change <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Market Maker Incentive",
+  "description": "Pay 20%% of the rewards to the market makers",
+  "changes": [
+    {
+      "index": "-1",
+      "plan": {
+        "start_height": "52560000",
+        "end_height": "63072000",
+        "reward_per_block": "100000000",
+        "total_incentive": "1051200000000000",
+        "targets": [
+          {
+            "address": "gkex1s5afhd6gxevu37mkqcvvsj8qeylhn0rz95pysw",
+            "share": "2000"
+          }
+        ]
+      }
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "gkex",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				types.ShareBase, types.CommunityPoolTarget, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := parsePlanChangeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewPlanChangeProposal(proposal.Title, proposal.Description, proposal.Changes)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func parsePlanChangeProposalJSON(cdc *codec.Codec, proposalFile string) (PlanChangeProposalJSON, error) {
+	proposal := PlanChangeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
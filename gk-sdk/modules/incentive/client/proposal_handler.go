@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/coinexchain/cet-sdk/modules/incentive/client/cli"
+	"github.com/coinexchain/cet-sdk/modules/incentive/client/rest"
+)
+
+// incentive plan change proposal handler
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+)
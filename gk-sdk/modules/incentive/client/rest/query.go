@@ -15,6 +15,7 @@ import (
 
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/incentive/parameters", queryParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/incentive/projection", queryProjectionHandlerFn(cliCtx)).Methods("GET")
 }
 
 // HTTP request handler to query the alias params values
@@ -24,3 +25,11 @@ func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
 	}
 }
+
+// HTTP request handler to query the remaining incentive of every plan
+func queryProjectionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryProjection)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
+	}
+}
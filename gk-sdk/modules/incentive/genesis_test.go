@@ -20,7 +20,7 @@ func TestGenesisState_Validate(t *testing.T) {
 	param1 := incentive.Params{
 		DefaultRewardPerBlock: 1,
 		Plans: []incentive.Plan{
-			{-1, 2, 1, 10}}}
+			{StartHeight: -1, EndHeight: 2, RewardPerBlock: 1, TotalIncentive: 10}}}
 
 	param2 := incentive.Params{
 		DefaultRewardPerBlock: 1,
@@ -30,17 +30,17 @@ func TestGenesisState_Validate(t *testing.T) {
 	param3 := incentive.Params{
 		DefaultRewardPerBlock: 1,
 		Plans: []incentive.Plan{
-			{2, 20, 0, 10}}}
+			{StartHeight: 2, EndHeight: 20, RewardPerBlock: 0, TotalIncentive: 10}}}
 
 	param4 := incentive.Params{
 		DefaultRewardPerBlock: 1,
 		Plans: []incentive.Plan{
-			{0, 10, 1, 0}}}
+			{StartHeight: 0, EndHeight: 10, RewardPerBlock: 1, TotalIncentive: 0}}}
 
 	param5 := incentive.Params{
 		DefaultRewardPerBlock: 1,
 		Plans: []incentive.Plan{
-			{0, 10, 1, 9}}}
+			{StartHeight: 0, EndHeight: 10, RewardPerBlock: 1, TotalIncentive: 9}}}
 
 	field1 := fields{State: incentive.State{1}, Param: param1}
 	field2 := fields{State: incentive.State{1}, Param: param2}
@@ -1,9 +1,12 @@
 package keepers
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/coinexchain/cet-sdk/modules/incentive/internal/types"
@@ -18,18 +21,20 @@ type Keeper struct {
 	key              sdk.StoreKey
 	paramSubspace    params.Subspace
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
 	supplyKeeper     authtypes.SupplyKeeper
 	feeCollectorName string
 }
 
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSubspace params.Subspace,
-	bk types.BankKeeper, supplyKeeper authtypes.SupplyKeeper, feeCollectorName string) Keeper {
+	bk types.BankKeeper, dk types.DistributionKeeper, supplyKeeper authtypes.SupplyKeeper, feeCollectorName string) Keeper {
 
 	return Keeper{
 		cdc:              cdc,
 		key:              key,
 		paramSubspace:    paramSubspace.WithKeyTable(types.ParamKeyTable()),
 		bankKeeper:       bk,
+		distrKeeper:      dk,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
 	}
@@ -81,3 +86,105 @@ func (k Keeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.Acc
 func (k Keeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool {
 	return k.bankKeeper.HasCoins(ctx, addr, amt)
 }
+
+// ApplyPlanChanges appends or replaces the plans one by one, and then checks all the plans as a whole.
+// The plans which have finished at the adjusted height can not be replaced, and no finished plan can be added.
+func (k Keeper) ApplyPlanChanges(ctx sdk.Context, changes []types.PlanChange) sdk.Error {
+	height := ctx.BlockHeight() + k.GetState(ctx).HeightAdjustment
+	param := k.GetParams(ctx)
+	for _, change := range changes {
+		if change.Plan.EndHeight <= height {
+			return sdk.NewError(types.CodeSpaceIncentive, types.CodePlanFinished,
+				fmt.Sprintf("the new plan ends at %d, which is not after the adjusted height %d", change.Plan.EndHeight, height))
+		}
+		if change.Index == types.AppendPlanIndex {
+			param.Plans = append(param.Plans, change.Plan)
+			continue
+		}
+		if change.Index < 0 || change.Index >= int64(len(param.Plans)) {
+			return sdk.NewError(types.CodeSpaceIncentive, types.CodeInvalidPlanIndex,
+				fmt.Sprintf("plan index %d is out of range", change.Index))
+		}
+		if param.Plans[change.Index].EndHeight <= height {
+			return sdk.NewError(types.CodeSpaceIncentive, types.CodePlanFinished,
+				fmt.Sprintf("plan %d has finished at the adjusted height %d", change.Index, height))
+		}
+		param.Plans[change.Index] = change.Plan
+	}
+	if err := types.CheckPlans(param.Plans); err != nil {
+		return err
+	}
+	if err := k.checkTargetModules(param.Plans); err != nil {
+		return err
+	}
+	k.SetParams(ctx, param)
+	return nil
+}
+
+// ValidateParams validates the incentive params in the subspace, including the module accounts of the reward targets
+func (k Keeper) ValidateParams(ctx sdk.Context, ss params.Subspace) error {
+	var param types.Params
+	ss.GetParamSet(ctx, &param)
+	if param.DefaultRewardPerBlock < 0 {
+		return sdk.NewError(types.CodeSpaceIncentive, types.CodeInvalidDefaultRewardPerBlock, "invalid default reward per block")
+	}
+	if err := types.CheckPlans(param.Plans); err != nil {
+		return err
+	}
+	if err := k.checkTargetModules(param.Plans); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (k Keeper) checkTargetModules(plans []types.Plan) sdk.Error {
+	for _, plan := range plans {
+		for _, target := range plan.Targets {
+			if len(target.ModuleName) != 0 && target.ModuleName != types.CommunityPoolTarget &&
+				k.supplyKeeper.GetModuleAddress(target.ModuleName) == nil {
+				return sdk.NewError(types.CodeSpaceIncentive, types.CodeInvalidRewardTarget,
+					fmt.Sprintf("module account %s does not exist", target.ModuleName))
+			}
+		}
+	}
+	return nil
+}
+
+// SendRewards pays the rewards from the incentive pool to the target
+func (k Keeper) SendRewards(ctx sdk.Context, target types.RewardTarget, rewards sdk.Coins) sdk.Error {
+	switch target.ModuleName {
+	case "":
+		return k.bankKeeper.SendCoins(ctx, types.PoolAddr, target.Address, rewards)
+	case types.CommunityPoolTarget:
+		if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, types.PoolAddr, distr.ModuleName, rewards); err != nil {
+			return err
+		}
+		feePool := k.distrKeeper.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(rewards))
+		k.distrKeeper.SetFeePool(ctx, feePool)
+		return nil
+	default:
+		if !types.IsRewardModule(target.ModuleName) || k.supplyKeeper.GetModuleAddress(target.ModuleName) == nil {
+			return sdk.NewError(types.CodeSpaceIncentive, types.CodeInvalidRewardTarget,
+				fmt.Sprintf("module %s can not receive rewards", target.ModuleName))
+		}
+		return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, types.PoolAddr, target.ModuleName, rewards)
+	}
+}
+
+// GetProjection projects the remaining incentive of every plan at the adjusted height
+func (k Keeper) GetProjection(ctx sdk.Context) types.Projection {
+	adjustment := k.GetState(ctx).HeightAdjustment
+	projection := types.Projection{
+		Height:           ctx.BlockHeight(),
+		HeightAdjustment: adjustment,
+		AdjustedHeight:   ctx.BlockHeight() + adjustment,
+		PoolBalance:      k.bankKeeper.GetCoins(ctx, types.PoolAddr),
+	}
+	for _, plan := range k.GetParams(ctx).Plans {
+		p := types.NewPlanProjection(plan, projection.AdjustedHeight)
+		projection.RemainingIncentive += p.RemainingIncentive
+		projection.Plans = append(projection.Plans, p)
+	}
+	return projection
+}
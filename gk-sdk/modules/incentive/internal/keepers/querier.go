@@ -11,6 +11,7 @@ import (
 
 const (
 	QueryParameters = "parameters"
+	QueryProjection = "projection"
 )
 
 // creates a querier for incentive REST endpoints
@@ -19,6 +20,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryParameters:
 			return queryParameters(ctx, keeper)
+		case QueryProjection:
+			return queryProjection(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("query symbol : " + path[0])
 		}
@@ -35,3 +38,14 @@ func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 
 	return res, nil
 }
+
+func queryProjection(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	projection := k.GetProjection(ctx)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, projection)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -25,3 +25,31 @@ func TestQueryParams(t *testing.T) {
 	testApp.Cdc.MustUnmarshalJSON(res, &params2)
 	require.Equal(t, params, params2)
 }
+
+func TestQueryProjection(t *testing.T) {
+	testApp := testapp.NewTestApp()
+	ctx := testApp.NewCtx().WithBlockHeight(40)
+	_ = testApp.IncentiveKeeper.SetState(ctx, types.State{HeightAdjustment: 10})
+	testApp.IncentiveKeeper.SetParams(ctx, types.Params{
+		DefaultRewardPerBlock: 2,
+		Plans: []types.Plan{
+			{StartHeight: 0, EndHeight: 20, RewardPerBlock: 10, TotalIncentive: 200},
+			{StartHeight: 20, EndHeight: 120, RewardPerBlock: 5, TotalIncentive: 500},
+			{StartHeight: 120, EndHeight: 130, RewardPerBlock: 1, TotalIncentive: 10},
+		},
+	})
+
+	querier := keepers.NewQuerier(testApp.IncentiveKeeper)
+	res, err := querier(ctx, []string{keepers.QueryProjection}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var projection types.Projection
+	testApp.Cdc.MustUnmarshalJSON(res, &projection)
+	require.Equal(t, int64(50), projection.AdjustedHeight)
+	require.Equal(t, int64(0), projection.Plans[0].RemainingIncentive)
+	require.Equal(t, int64(30), projection.Plans[1].PaidBlocks)
+	require.Equal(t, int64(70), projection.Plans[1].RemainingBlocks)
+	require.Equal(t, int64(350), projection.Plans[1].RemainingIncentive)
+	require.Equal(t, int64(10), projection.Plans[2].RemainingIncentive)
+	require.Equal(t, int64(360), projection.RemainingIncentive)
+}
@@ -13,4 +13,5 @@ func init() {
 
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(State{}, "incentive/state", nil)
+	cdc.RegisterConcrete(PlanChangeProposal{}, "incentive/PlanChangeProposal", nil)
 }
@@ -14,4 +14,8 @@ const (
 	CodeInvalidRewardPerBlock        sdk.CodeType = 704
 	CodeInvalidTotalIncentive        sdk.CodeType = 705
 	CodeInvalidPlanToAdd             sdk.CodeType = 706
+	CodeInvalidRewardTarget          sdk.CodeType = 707
+	CodeInvalidPlanIndex             sdk.CodeType = 708
+	CodePlanFinished                 sdk.CodeType = 709
+	CodeNoPlanChange                 sdk.CodeType = 710
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 //expected fee collection keeper
@@ -13,6 +14,14 @@ type FeeCollectionKeeper interface {
 type BankKeeper interface {
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error)
 	HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+}
+
+// expected distribution keeper, to pay rewards into the community pool
+type DistributionKeeper interface {
+	GetFeePool(ctx sdk.Context) (feePool distr.FeePool)
+	SetFeePool(ctx sdk.Context, feePool distr.FeePool)
 }
 
 // SupplyKeeper defines the expected supply keeper (noalias)
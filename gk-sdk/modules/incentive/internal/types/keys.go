@@ -1,5 +1,12 @@
 package types
 
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
 const (
 	ModuleName        = "incentive"
 	QuerierRoute      = ModuleName
@@ -7,3 +14,29 @@ const (
 	RouterKey         = ModuleName
 	StoreKey          = ModuleName
 )
+
+const (
+	// CommunityPoolTarget is the module name of RewardTarget for the community pool
+	CommunityPoolTarget = "community_pool"
+	// ShareBase is the denominator of the shares of RewardTarget
+	ShareBase = 10000
+)
+
+var (
+	PoolAddr = sdk.AccAddress(crypto.AddressHash([]byte("incentive_pool")))
+
+	// RewardModules are the module names a RewardTarget may pay to. The other module accounts,
+	// such as the staking pools, the stream pool and the market mining pool, hold coins which
+	// their modules keep account of, and must not be paid directly.
+	RewardModules = []string{CommunityPoolTarget, authtypes.FeeCollectorName}
+)
+
+// IsRewardModule returns whether the rewards can be paid to the module
+func IsRewardModule(name string) bool {
+	for _, m := range RewardModules {
+		if m == name {
+			return true
+		}
+	}
+	return false
+}
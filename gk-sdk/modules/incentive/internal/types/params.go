@@ -24,17 +24,39 @@ type Plan struct {
 	EndHeight      int64 `json:"end_height"`
 	RewardPerBlock int64 `json:"reward_per_block"`
 	TotalIncentive int64 `json:"total_incentive"`
+	// the shares of the rewards which are not paid into the fee collector
+	Targets []RewardTarget `json:"targets,omitempty"`
+}
+
+// RewardTarget directs a share of the rewards of a plan to a module account, the community pool,
+// or a normal account such as the reward pool of market makers
+type RewardTarget struct {
+	ModuleName string         `json:"module_name,omitempty"`
+	Address    sdk.AccAddress `json:"address,omitempty"`
+	Share      int64          `json:"share"` // in 1/ShareBase of RewardPerBlock
+}
+
+func (t RewardTarget) String() string {
+	if len(t.ModuleName) != 0 {
+		return fmt.Sprintf("%s:%d", t.ModuleName, t.Share)
+	}
+	return fmt.Sprintf("%s:%d", t.Address, t.Share)
+}
+
+// GetTargetReward returns the reward paid to the target in each block of the plan
+func (plan Plan) GetTargetReward(target RewardTarget) int64 {
+	return sdk.NewInt(plan.RewardPerBlock).MulRaw(target.Share).QuoRaw(ShareBase).Int64()
 }
 
 func DefaultParams() Params {
 	return Params{
 		DefaultRewardPerBlock: 2e8,
 		Plans: []Plan{
-			{0, 10512000, 10e8, 105120000e8},
-			{10512000, 21024000, 8e8, 84096000e8},
-			{21024000, 31536000, 6e8, 63072000e8},
-			{31536000, 42048000, 4e8, 42048000e8},
-			{42048000, 52560000, 2e8, 21024000e8},
+			{StartHeight: 0, EndHeight: 10512000, RewardPerBlock: 10e8, TotalIncentive: 105120000e8},
+			{StartHeight: 10512000, EndHeight: 21024000, RewardPerBlock: 8e8, TotalIncentive: 84096000e8},
+			{StartHeight: 21024000, EndHeight: 31536000, RewardPerBlock: 6e8, TotalIncentive: 63072000e8},
+			{StartHeight: 31536000, EndHeight: 42048000, RewardPerBlock: 4e8, TotalIncentive: 42048000e8},
+			{StartHeight: 42048000, EndHeight: 52560000, RewardPerBlock: 2e8, TotalIncentive: 21024000e8},
 		},
 	}
 }
@@ -59,6 +81,9 @@ func (p Params) String() string {
 	for _, p := range p.Plans {
 		s += fmt.Sprintf("\n  Plan: StartHeight=%d EndHeight=%d RewardPerBlock=%d TotalIncentive=%d",
 			p.StartHeight, p.EndHeight, p.RewardPerBlock, p.TotalIncentive)
+		if len(p.Targets) != 0 {
+			s += fmt.Sprintf(" Targets=%v", p.Targets)
+		}
 	}
 
 	return s
@@ -82,6 +107,30 @@ func CheckPlans(plans []Plan) sdk.Error {
 		if (plan.EndHeight-plan.StartHeight)*plan.RewardPerBlock != plan.TotalIncentive {
 			return sdk.NewError(CodeSpaceIncentive, CodeInvalidTotalIncentive, "invalid incentive plan")
 		}
+		if err := checkTargets(plan.Targets); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkTargets(targets []RewardTarget) sdk.Error {
+	totalShare := int64(0)
+	for _, target := range targets {
+		if (len(target.ModuleName) == 0) == target.Address.Empty() {
+			return sdk.NewError(CodeSpaceIncentive, CodeInvalidRewardTarget, "reward target must be either a module or an address")
+		}
+		if len(target.ModuleName) != 0 && !IsRewardModule(target.ModuleName) {
+			return sdk.NewError(CodeSpaceIncentive, CodeInvalidRewardTarget,
+				fmt.Sprintf("module %s can not receive rewards", target.ModuleName))
+		}
+		if target.Share <= 0 || target.Share > ShareBase {
+			return sdk.NewError(CodeSpaceIncentive, CodeInvalidRewardTarget, "invalid share of reward target")
+		}
+		totalShare += target.Share
+	}
+	if totalShare > ShareBase {
+		return sdk.NewError(CodeSpaceIncentive, CodeInvalidRewardTarget, "the total share of reward targets is too large")
 	}
 	return nil
 }
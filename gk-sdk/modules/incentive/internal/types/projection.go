@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PlanProjection is how much of a plan has been paid, and how much remains
+type PlanProjection struct {
+	Plan               Plan  `json:"plan"`
+	PaidBlocks         int64 `json:"paid_blocks"`
+	RemainingBlocks    int64 `json:"remaining_blocks"`
+	RemainingIncentive int64 `json:"remaining_incentive"`
+}
+
+// Projection projects the remaining incentive of all the plans at the adjusted height,
+// which is the block height plus State.HeightAdjustment
+type Projection struct {
+	Height             int64            `json:"height"`
+	HeightAdjustment   int64            `json:"height_adjustment"`
+	AdjustedHeight     int64            `json:"adjusted_height"`
+	PoolBalance        sdk.Coins        `json:"pool_balance"`
+	RemainingIncentive int64            `json:"remaining_incentive"`
+	Plans              []PlanProjection `json:"plans"`
+}
+
+// NewPlanProjection projects the plan at the adjusted height. The plan pays at the heights in (StartHeight, EndHeight].
+func NewPlanProjection(plan Plan, adjustedHeight int64) PlanProjection {
+	totalBlocks := plan.EndHeight - plan.StartHeight
+	paidBlocks := adjustedHeight - plan.StartHeight
+	if paidBlocks < 0 {
+		paidBlocks = 0
+	}
+	if paidBlocks > totalBlocks {
+		paidBlocks = totalBlocks
+	}
+	return PlanProjection{
+		Plan:               plan,
+		PaidBlocks:         paidBlocks,
+		RemainingBlocks:    totalBlocks - paidBlocks,
+		RemainingIncentive: plan.TotalIncentive - paidBlocks*plan.RewardPerBlock,
+	}
+}
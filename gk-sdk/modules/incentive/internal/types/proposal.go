@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypePlanChange defines the type for a PlanChangeProposal
+	ProposalTypePlanChange = "IncentivePlanChange"
+
+	// AppendPlanIndex is the index of PlanChange which appends a new plan
+	AppendPlanIndex = -1
+)
+
+// Assert PlanChangeProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = PlanChangeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypePlanChange)
+	govtypes.RegisterProposalTypeCodec(PlanChangeProposal{}, "incentive/PlanChangeProposal")
+}
+
+// PlanChange replaces the plan at Index, or appends a new plan if Index is AppendPlanIndex
+type PlanChange struct {
+	Index int64 `json:"index"`
+	Plan  Plan  `json:"plan"`
+}
+
+// PlanChangeProposal appends or adjusts individual incentive plans
+type PlanChangeProposal struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Changes     []PlanChange `json:"changes"`
+}
+
+func NewPlanChangeProposal(title, description string, changes []PlanChange) PlanChangeProposal {
+	return PlanChangeProposal{Title: title, Description: description, Changes: changes}
+}
+
+// GetTitle returns the title of a plan change proposal.
+func (pcp PlanChangeProposal) GetTitle() string { return pcp.Title }
+
+// GetDescription returns the description of a plan change proposal.
+func (pcp PlanChangeProposal) GetDescription() string { return pcp.Description }
+
+// ProposalRoute returns the routing key of a plan change proposal.
+func (pcp PlanChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a plan change proposal.
+func (pcp PlanChangeProposal) ProposalType() string { return ProposalTypePlanChange }
+
+// ValidateBasic runs basic stateless validity checks
+func (pcp PlanChangeProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(CodeSpaceIncentive, pcp); err != nil {
+		return err
+	}
+	if len(pcp.Changes) == 0 {
+		return sdk.NewError(CodeSpaceIncentive, CodeNoPlanChange, "no plan is changed")
+	}
+	for _, change := range pcp.Changes {
+		if change.Index < AppendPlanIndex {
+			return sdk.NewError(CodeSpaceIncentive, CodeInvalidPlanIndex, fmt.Sprintf("invalid plan index %d", change.Index))
+		}
+		if err := CheckPlans([]Plan{change.Plan}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (pcp PlanChangeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Incentive Plan Change Proposal:
+  Title:       %s
+  Description: %s
+  Changes:
+`, pcp.Title, pcp.Description))
+	for _, change := range pcp.Changes {
+		index := fmt.Sprintf("%d", change.Index)
+		if change.Index == AppendPlanIndex {
+			index = "new"
+		}
+		b.WriteString(fmt.Sprintf("    %s: StartHeight=%d EndHeight=%d RewardPerBlock=%d TotalIncentive=%d Targets=%v\n",
+			index, change.Plan.StartHeight, change.Plan.EndHeight, change.Plan.RewardPerBlock,
+			change.Plan.TotalIncentive, change.Plan.Targets))
+	}
+	return b.String()
+}
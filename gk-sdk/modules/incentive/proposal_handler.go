@@ -0,0 +1,24 @@
+package incentive
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/coinexchain/cet-sdk/modules/incentive/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/incentive/internal/types"
+)
+
+func NewProposalHandler(k keepers.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.PlanChangeProposal:
+			return k.ApplyPlanChanges(ctx, c.Changes)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized incentive proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package incentive_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/coinexchain/cet-sdk/modules/incentive"
+	"github.com/coinexchain/cet-sdk/modules/incentive/internal/types"
+)
+
+func newPlan(start, end, reward int64, targets ...types.RewardTarget) types.Plan {
+	return types.Plan{
+		StartHeight:    start,
+		EndHeight:      end,
+		RewardPerBlock: reward,
+		TotalIncentive: (end - start) * reward,
+		Targets:        targets,
+	}
+}
+
+func TestPlanChangeProposal(t *testing.T) {
+	input := SetupTestInput()
+	handler := incentive.NewProposalHandler(input.keeper)
+	ctx := input.ctx.WithBlockHeight(100)
+	_ = input.keeper.SetState(ctx, incentive.State{HeightAdjustment: 10})
+	input.keeper.SetParams(ctx, types.Params{
+		DefaultRewardPerBlock: 2e8,
+		Plans:                 []types.Plan{newPlan(0, 100, 10), newPlan(100, 200, 8)},
+	})
+
+	// append a new plan paying the fee collector module account
+	target := types.RewardTarget{ModuleName: auth.FeeCollectorName, Share: 5000}
+	proposal := incentive.NewPlanChangeProposal("title", "desc",
+		[]types.PlanChange{{Index: incentive.AppendPlanIndex, Plan: newPlan(200, 300, 6, target)}})
+	require.Nil(t, proposal.ValidateBasic())
+	require.Nil(t, handler(ctx, proposal))
+	require.Equal(t, 3, len(input.keeper.GetParams(ctx).Plans))
+
+	// adjust a running plan
+	proposal.Changes = []types.PlanChange{{Index: 1, Plan: newPlan(100, 150, 16)}}
+	require.Nil(t, handler(ctx, proposal))
+	require.Equal(t, newPlan(100, 150, 16), input.keeper.GetParams(ctx).Plans[1])
+
+	// a finished plan can not be adjusted
+	proposal.Changes = []types.PlanChange{{Index: 0, Plan: newPlan(0, 200, 10)}}
+	require.Equal(t, types.CodePlanFinished, handler(ctx, proposal).Code())
+
+	// a new plan must end after the adjusted height
+	proposal.Changes = []types.PlanChange{{Index: incentive.AppendPlanIndex, Plan: newPlan(10, 110, 10)}}
+	require.Equal(t, types.CodePlanFinished, handler(ctx, proposal).Code())
+
+	proposal.Changes = []types.PlanChange{{Index: 3, Plan: newPlan(300, 400, 10)}}
+	require.Equal(t, types.CodeInvalidPlanIndex, handler(ctx, proposal).Code())
+
+	// the target module must exist
+	target = types.RewardTarget{ModuleName: "nonexistent", Share: 5000}
+	proposal.Changes = []types.PlanChange{{Index: incentive.AppendPlanIndex, Plan: newPlan(300, 400, 10, target)}}
+	require.Equal(t, types.CodeInvalidRewardTarget, handler(ctx, proposal).Code())
+
+	// nothing is changed by the failed proposals
+	require.Equal(t, 3, len(input.keeper.GetParams(ctx).Plans))
+}
+
+func TestPlanChangeProposalValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr")
+	proposal := incentive.NewPlanChangeProposal("title", "desc", nil)
+	require.Equal(t, types.CodeNoPlanChange, proposal.ValidateBasic().Code())
+
+	proposal.Changes = []types.PlanChange{{Index: -2, Plan: newPlan(0, 10, 1)}}
+	require.Equal(t, types.CodeInvalidPlanIndex, proposal.ValidateBasic().Code())
+
+	proposal.Changes = []types.PlanChange{{Index: 0, Plan: types.Plan{StartHeight: 0, EndHeight: 10, RewardPerBlock: 1, TotalIncentive: 9}}}
+	require.Equal(t, types.CodeInvalidTotalIncentive, proposal.ValidateBasic().Code())
+
+	invalidTargets := [][]types.RewardTarget{
+		{{Share: 10}},
+		{{ModuleName: incentive.CommunityPoolTarget, Address: addr, Share: 10}},
+		{{Address: addr, Share: 0}},
+		{{Address: addr, Share: 6000}, {ModuleName: incentive.CommunityPoolTarget, Share: 5000}},
+		{{ModuleName: staking.BondedPoolName, Share: 10}},
+		{{ModuleName: "market_mining", Share: 10}},
+	}
+	for _, targets := range invalidTargets {
+		proposal.Changes = []types.PlanChange{{Index: 0, Plan: newPlan(0, 10, 1, targets...)}}
+		require.Equal(t, types.CodeInvalidRewardTarget, proposal.ValidateBasic().Code())
+	}
+}
@@ -7,8 +7,10 @@ import (
 )
 
 const (
-	StoreKey   = types.StoreKey
-	ModuleName = types.ModuleName
+	StoreKey          = types.StoreKey
+	ModuleName        = types.ModuleName
+	RouterKey         = types.RouterKey
+	DefaultParamspace = types.DefaultParamspace
 )
 
 const (
@@ -21,21 +23,42 @@ const (
 	ASK                     = types.ASK
 	BUY                     = types.BUY
 	SELL                    = types.SELL
+	MiningPoolName          = types.MiningPoolName
+	MiningSpreadBase        = types.MiningSpreadBase
+	HaltByCircuitBreaker    = types.HaltByCircuitBreaker
+	HaltByOwner             = types.HaltByOwner
+	HaltByProposal          = types.HaltByProposal
+	HaltExpired             = types.HaltExpired
 )
 
 var (
 	NewBaseKeeper       = keepers.NewKeeper
 	DefaultParams       = types.DefaultParams
+	ValidateParams      = types.ValidateParams
 	DecToBigEndianBytes = types.DecToBigEndianBytes
 	ValidateOrderID     = types.ValidateOrderID
 	IsValidTradingPair  = types.IsValidTradingPair
 	ModuleCdc           = types.ModuleCdc
 	GetSymbol           = dex.GetSymbol
 	SplitSymbol         = dex.SplitSymbol
+
+	NewMsgClaimMiningReward = types.NewMsgClaimMiningReward
+	CalcMiningScore         = types.CalcMiningScore
+
+	NewListMarketProposal    = types.NewListMarketProposal
+	NewDelistMarketProposal  = types.NewDelistMarketProposal
+	NewMarketFeeRateProposal = types.NewMarketFeeRateProposal
+	NewMarketHaltProposal    = types.NewMarketHaltProposal
+
+	NewMsgHaltMarket   = types.NewMsgHaltMarket
+	NewMsgResumeMarket = types.NewMsgResumeMarket
 )
 
 type (
 	Keeper                  = keepers.Keeper
+	DelistRequest           = keepers.DelistRequest
+	MarketPriceWindow       = keepers.MarketPriceWindow
+	PriceWindow             = keepers.PriceWindow
 	Order                   = types.Order
 	MarketInfo              = types.MarketInfo
 	Params                  = types.Params
@@ -48,4 +71,14 @@ type (
 	CreateOrderInfo         = types.CreateOrderInfo
 	FillOrderInfo           = types.FillOrderInfo
 	CancelOrderInfo         = types.CancelOrderInfo
+	MsgClaimMiningReward    = types.MsgClaimMiningReward
+	MiningMarket            = types.MiningMarket
+	MiningReward            = types.MiningReward
+	ListMarketProposal      = types.ListMarketProposal
+	DelistMarketProposal    = types.DelistMarketProposal
+	MarketFeeRateProposal   = types.MarketFeeRateProposal
+	MarketHaltProposal      = types.MarketHaltProposal
+	MsgHaltMarket           = types.MsgHaltMarket
+	MsgResumeMarket         = types.MsgResumeMarket
+	MarketHaltInfo          = types.MarketHaltInfo
 )
@@ -0,0 +1,265 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+)
+
+// ListMarketProposalJSON defines a ListMarketProposal with a deposit
+type ListMarketProposalJSON struct {
+	Title          string    `json:"title"`
+	Description    string    `json:"description"`
+	Stock          string    `json:"stock"`
+	Money          string    `json:"money"`
+	PricePrecision byte      `json:"price_precision"`
+	OrderPrecision byte      `json:"order_precision"`
+	Deposit        sdk.Coins `json:"deposit"`
+}
+
+// DelistMarketProposalJSON defines a DelistMarketProposal with a deposit
+type DelistMarketProposalJSON struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	TradingPair string    `json:"trading_pair"`
+	Deposit     sdk.Coins `json:"deposit"`
+}
+
+// MarketFeeRateProposalJSON defines a MarketFeeRateProposal with a deposit
+type MarketFeeRateProposalJSON struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	TradingPair string    `json:"trading_pair"`
+	BuyFeeRate  sdk.Dec   `json:"buy_fee_rate"`
+	SellFeeRate sdk.Dec   `json:"sell_fee_rate"`
+	Deposit     sdk.Coins `json:"deposit"`
+}
+
+// MarketHaltProposalJSON defines a MarketHaltProposal with a deposit
+type MarketHaltProposalJSON struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	TradingPair string    `json:"trading_pair"`
+	Halt        bool      `json:"halt"`
+	Deposit     sdk.Coins `json:"deposit"`
+}
+
+// GetCmdSubmitListMarketProposal implements the command to submit a list market proposal
+func GetCmdSubmitListMarketProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-market [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to list a CET trading pair without the stock issuer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a list market proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Once the proposal passes, the trading
+pair is created with the default fee rates and no CreateMarketFee is charged.
+
+Example:
+$ %s tx gov submit-proposal list-market <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "List ABC/CET",
+  "description": "ABC is widely held but its issuer is inactive",
+  "stock": "abc",
+  "money": "gkex",
+  "price_precision": 8,
+  "order_precision": 0,
+  "deposit": [
+    {
+      "denom": "gkex",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal ListMarketProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+
+			content := types.NewListMarketProposal(proposal.Title, proposal.Description,
+				proposal.Stock, proposal.Money, proposal.PricePrecision, proposal.OrderPrecision)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitDelistMarketProposal implements the command to submit a delist market proposal
+func GetCmdSubmitDelistMarketProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-market [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to force-delist a trading pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a delist market proposal along with an initial deposit.
+Once the proposal passes, the trading pair is queued for delisting and removed after
+MarketMinExpiredTime, just like a cancel-market request of the stock owner.
+
+Example:
+$ %s tx gov submit-proposal delist-market <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Delist ABC/CET",
+  "description": "ABC has been abandoned",
+  "trading_pair": "abc/gkex",
+  "deposit": [
+    {
+      "denom": "gkex",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal DelistMarketProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+
+			content := types.NewDelistMarketProposal(proposal.Title, proposal.Description, proposal.TradingPair)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitMarketFeeRateProposal implements the command to submit a market fee rate proposal
+func GetCmdSubmitMarketFeeRateProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-fee-rate [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to override the fee rates of a trading pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a market fee rate proposal along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal market-fee-rate <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Lower ABC/CET fees",
+  "description": "Attract liquidity to ABC/CET",
+  "trading_pair": "abc/gkex",
+  "buy_fee_rate": "0.001",
+  "sell_fee_rate": "0.001",
+  "deposit": [
+    {
+      "denom": "gkex",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal MarketFeeRateProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+
+			content := types.NewMarketFeeRateProposal(proposal.Title, proposal.Description,
+				proposal.TradingPair, proposal.BuyFeeRate, proposal.SellFeeRate)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitMarketHaltProposal implements the command to submit a market halt proposal
+func GetCmdSubmitMarketHaltProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-halt [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to halt or resume the matching of a trading pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a market halt proposal along with an initial deposit.
+Once the proposal passes, the trading pair is halted until it is resumed when "halt" is true,
+otherwise the halted trading pair is resumed.
+
+Example:
+$ %s tx gov submit-proposal market-halt <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Halt ABC/CET",
+  "description": "The issuer of ABC has been hacked",
+  "trading_pair": "abc/gkex",
+  "halt": true,
+  "deposit": [
+    {
+      "denom": "gkex",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal MarketHaltProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+
+			content := types.NewMarketHaltProposal(proposal.Title, proposal.Description,
+				proposal.TradingPair, proposal.Halt)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+
+	return cmd
+}
+
+func submitProposal(cdc *codec.Codec, content gov.Content, deposit sdk.Coins) error {
+	txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	msg := gov.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
+
+func parseProposalJSON(cdc *codec.Codec, proposalFile string, proposal interface{}) error {
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(contents, proposal)
+}
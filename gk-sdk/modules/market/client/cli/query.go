@@ -29,7 +29,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		QueryMarketListCmd(cdc),
 		QueryOrderbookCmd(cdc),
 		QueryOrderCmd(cdc),
-		QueryUserOrderList(cdc))...)
+		QueryUserOrderList(cdc),
+		QueryMiningRewardCmd(cdc))...)
 	return mktQueryCmd
 }
 
@@ -145,3 +146,26 @@ Example:
 
 	return cmd
 }
+
+func QueryMiningRewardCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mining-reward [userAddress]",
+		Short: "Query the unclaimed liquidity mining reward of a user",
+		Long: `Query the unclaimed liquidity mining reward of a user.
+
+Example:
+	cetcli query market mining-reward [userAddress] \
+	--trust-node=true --chain-id=coinexdex`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryMiningReward)
+			return cliutil.CliQuery(cdc, route, keepers.QueryMiningRewardParam{Address: addr})
+		},
+	}
+
+	return cmd
+}
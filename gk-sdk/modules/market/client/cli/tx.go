@@ -24,6 +24,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		CancelMarket(cdc),
 		ModifyTradingPairPricePrecision(cdc),
 		ModifyFeeRate(cdc),
+		ClaimMiningReward(cdc),
+		HaltMarket(cdc),
+		ResumeMarket(cdc),
 	)...)
 
 	return mktTxCmd
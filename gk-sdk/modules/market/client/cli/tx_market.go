@@ -206,3 +206,63 @@ func getMsgModifyFeeRateMsg(cdc *codec.Codec) (*types.MsgModifyFeeRate, error) {
 }
 
 
+
+func ClaimMiningReward(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-mining-reward",
+		Short: "claim the liquidity mining reward",
+		Long: `claim all the liquidity mining reward accrued by the GTE orders resting on the mining markets.
+
+Example 
+	cetcli tx market claim-mining-reward \
+	--from=bob --chain-id=coinexdex --gas=1000000 --fees=1000cet`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg := &types.MsgClaimMiningReward{}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+	return cmd
+}
+
+func HaltMarket(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt-market",
+		Short: "halt the matching of the trading pair",
+		Long: `halt the matching of the trading pair until it is resumed, only the owner of the stock can do it.
+New orders are still accepted and existing orders can be cancelled while the trading pair is halted.
+
+Example 
+	cetcli tx market halt-market --trading-pair=etc/cet \
+	--from=bob --chain-id=coinexdex --gas=1000000 --fees=1000cet`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg := &types.MsgHaltMarket{TradingPair: viper.GetString(FlagSymbol)}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSymbol, "btc/cet", "The market trading-pair")
+	cmd.MarkFlagRequired(FlagSymbol)
+	return cmd
+}
+
+func ResumeMarket(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-market",
+		Short: "resume the matching of the halted trading pair",
+		Long: `resume the matching of the trading pair halted by its owner, only the owner of the stock can do it.
+The halts by a proposal or by the circuit breaker can not be lifted by the owner.
+
+Example 
+	cetcli tx market resume-market --trading-pair=etc/cet \
+	--from=bob --chain-id=coinexdex --gas=1000000 --fees=1000cet`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg := &types.MsgResumeMarket{TradingPair: viper.GetString(FlagSymbol)}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSymbol, "btc/cet", "The market trading-pair")
+	cmd.MarkFlagRequired(FlagSymbol)
+	return cmd
+}
@@ -23,6 +23,7 @@ const (
 	FlagBlocks    = "blocks"
 	FlagTime      = "time"
 	FlagIdentify  = "identify"
+	FlagExpire    = "expire-time"
 )
 
 var createOrderFlags = []string{
@@ -64,13 +65,16 @@ Example:
 	cetcli tx market create-gte-order --trading-pair=btc/cet \
 	--order-type=2 --price=520 --quantity=10000000 --side=1 \
 	--price-precision=10 --blocks=100000 --from=bob --identify=1 \
-	--chain-id=coinexdex --gas=10000 --fees=1000cet`,
+	--chain-id=coinexdex --gas=10000 --fees=1000cet
+
+The order expires after --blocks blocks, or at the unix time given by --expire-time if it comes first.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return createAndBroadCastOrder(cdc, true)
 		},
 	}
 	markCreateOrderFlags(cmd)
 	cmd.Flags().Int(FlagBlocks, 10000, "the gte order will exist at least blocks in blockChain")
+	cmd.Flags().Int64(FlagExpire, 0, "the unix time in seconds at which the gte order expires, 0 means no expire time")
 	return cmd
 }
 
@@ -106,6 +110,7 @@ func parseCreateOrderFlags(isGTE bool) (*types.MsgCreateOrder, error) {
 	}
 	if isGTE {
 		msg.TimeInForce = types.GTE
+		msg.ExpireTime = viper.GetInt64(FlagExpire)
 	}
 	return msg, nil
 }
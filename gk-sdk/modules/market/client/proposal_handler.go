@@ -0,0 +1,16 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/coinexchain/cet-sdk/modules/market/client/cli"
+	"github.com/coinexchain/cet-sdk/modules/market/client/rest"
+)
+
+// market listing proposal handlers
+var (
+	ListMarketProposalHandler    = govclient.NewProposalHandler(cli.GetCmdSubmitListMarketProposal, rest.ListMarketProposalRESTHandler)
+	DelistMarketProposalHandler  = govclient.NewProposalHandler(cli.GetCmdSubmitDelistMarketProposal, rest.DelistMarketProposalRESTHandler)
+	MarketFeeRateProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitMarketFeeRateProposal, rest.MarketFeeRateProposalRESTHandler)
+	MarketHaltProposalHandler    = govclient.NewProposalHandler(cli.GetCmdSubmitMarketHaltProposal, rest.MarketHaltProposalRESTHandler)
+)
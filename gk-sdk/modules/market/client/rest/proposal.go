@@ -0,0 +1,160 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+)
+
+// ListMarketProposalReq defines a list market proposal request body
+type ListMarketProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title          string         `json:"title"`
+	Description    string         `json:"description"`
+	Stock          string         `json:"stock"`
+	Money          string         `json:"money"`
+	PricePrecision byte           `json:"price_precision"`
+	OrderPrecision byte           `json:"order_precision"`
+	Proposer       sdk.AccAddress `json:"proposer"`
+	Deposit        sdk.Coins      `json:"deposit"`
+}
+
+// DelistMarketProposalReq defines a delist market proposal request body
+type DelistMarketProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	TradingPair string         `json:"trading_pair"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// MarketFeeRateProposalReq defines a market fee rate proposal request body
+type MarketFeeRateProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	TradingPair string         `json:"trading_pair"`
+	BuyFeeRate  sdk.Dec        `json:"buy_fee_rate"`
+	SellFeeRate sdk.Dec        `json:"sell_fee_rate"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// MarketHaltProposalReq defines a market halt proposal request body
+type MarketHaltProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	TradingPair string         `json:"trading_pair"`
+	Halt        bool           `json:"halt"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// ListMarketProposalRESTHandler returns a ProposalRESTHandler that exposes the list market REST handler
+func ListMarketProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "list_market",
+		Handler:  postListMarketProposalHandlerFn(cliCtx),
+	}
+}
+
+// DelistMarketProposalRESTHandler returns a ProposalRESTHandler that exposes the delist market REST handler
+func DelistMarketProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "delist_market",
+		Handler:  postDelistMarketProposalHandlerFn(cliCtx),
+	}
+}
+
+// MarketFeeRateProposalRESTHandler returns a ProposalRESTHandler that exposes the market fee rate REST handler
+func MarketFeeRateProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "market_fee_rate",
+		Handler:  postMarketFeeRateProposalHandlerFn(cliCtx),
+	}
+}
+
+// MarketHaltProposalRESTHandler returns a ProposalRESTHandler that exposes the market halt REST handler
+func MarketHaltProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "market_halt",
+		Handler:  postMarketHaltProposalHandlerFn(cliCtx),
+	}
+}
+
+func postListMarketProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ListMarketProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		content := types.NewListMarketProposal(req.Title, req.Description, req.Stock, req.Money,
+			req.PricePrecision, req.OrderPrecision)
+		writeProposal(w, cliCtx, req.BaseReq, gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer))
+	}
+}
+
+func postDelistMarketProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DelistMarketProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		content := types.NewDelistMarketProposal(req.Title, req.Description, req.TradingPair)
+		writeProposal(w, cliCtx, req.BaseReq, gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer))
+	}
+}
+
+func postMarketFeeRateProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MarketFeeRateProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		content := types.NewMarketFeeRateProposal(req.Title, req.Description, req.TradingPair,
+			req.BuyFeeRate, req.SellFeeRate)
+		writeProposal(w, cliCtx, req.BaseReq, gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer))
+	}
+}
+
+func postMarketHaltProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MarketHaltProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		content := types.NewMarketHaltProposal(req.Title, req.Description, req.TradingPair, req.Halt)
+		writeProposal(w, cliCtx, req.BaseReq, gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer))
+	}
+}
+
+func writeProposal(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq, msg gov.MsgSubmitProposal) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+}
@@ -81,3 +81,17 @@ func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
 	}
 }
+
+func queryMiningRewardHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		addr, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		param := keepers.QueryMiningRewardParam{Address: addr}
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryMiningReward)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, param, nil)
+	}
+}
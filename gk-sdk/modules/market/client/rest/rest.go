@@ -19,6 +19,7 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc("/market/orders/{order-id}", queryOrderInfoHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/market/orders/account/{address}", queryUserOrderListHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/market/parameters", queryParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/market/mining-rewards/{address}", queryMiningRewardHandlerFn(cdc, cliCtx)).Methods("GET")
 }
 
 func registerTXRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
@@ -29,4 +30,7 @@ func registerTXRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 	r.HandleFunc("/market/cancel-trading-pair", cancelMarketHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/market/price-precision", modifyTradingPairPricePrecision(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/market/fee-rate", modifyFeeRateFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/market/claim-mining-reward", claimMiningRewardHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/market/halt", haltMarketHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/market/resume", resumeMarketHandlerFn(cdc, cliCtx)).Methods("POST")
 }
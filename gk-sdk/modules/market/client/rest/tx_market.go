@@ -77,6 +77,20 @@ func (req *modifyPricePrecision) GetMsg(r *http.Request, sender sdk.AccAddress)
 	return msg, nil
 }
 
+type claimMiningRewardReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func (req *claimMiningRewardReq) New() restutil.RestReq {
+	return new(claimMiningRewardReq)
+}
+func (req *claimMiningRewardReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *claimMiningRewardReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgClaimMiningReward(sender), nil
+}
+
 func createMarketHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	var req createMarketReq
 	return restutil.NewRestHandler(cdc, cliCtx, &req)
@@ -119,3 +133,48 @@ func modifyFeeRateFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFu
 	var req modifyFeeRate
 	return restutil.NewRestHandler(cdc, cliCtx, &req)
 }
+
+func claimMiningRewardHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	var req claimMiningRewardReq
+	return restutil.NewRestHandler(cdc, cliCtx, &req)
+}
+
+type haltMarketReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	TradingPair string       `json:"trading_pair"`
+}
+
+func (req *haltMarketReq) New() restutil.RestReq {
+	return new(haltMarketReq)
+}
+func (req *haltMarketReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *haltMarketReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgHaltMarket(sender, req.TradingPair), nil
+}
+
+type resumeMarketReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	TradingPair string       `json:"trading_pair"`
+}
+
+func (req *resumeMarketReq) New() restutil.RestReq {
+	return new(resumeMarketReq)
+}
+func (req *resumeMarketReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *resumeMarketReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgResumeMarket(sender, req.TradingPair), nil
+}
+
+func haltMarketHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	var req haltMarketReq
+	return restutil.NewRestHandler(cdc, cliCtx, &req)
+}
+
+func resumeMarketHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	var req resumeMarketReq
+	return restutil.NewRestHandler(cdc, cliCtx, &req)
+}
@@ -24,6 +24,7 @@ type createOrderReq struct {
 	Side           int          `json:"side"`
 	ExistBlocks    int          `json:"exist_blocks"`
 	TimeInForce    int          `json:"time_in_force"`
+	ExpireTime     int64        `json:"expire_time"`
 }
 
 func (req *createOrderReq) New() restutil.RestReq {
@@ -47,6 +48,7 @@ func (req *createOrderReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.M
 	}
 	if r.URL.Path == "/market/gte-orders" {
 		msg.TimeInForce = types.GTE
+		msg.ExpireTime = req.ExpireTime
 	}
 	return msg, nil
 }
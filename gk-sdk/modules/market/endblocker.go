@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
 	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
 	"github.com/coinexchain/cet-sdk/modules/market/match"
@@ -237,33 +238,23 @@ func chargeOrderFeatureFee(ctx sdk.Context, order *types.Order, freeTimeBlocks i
 }
 
 func chargeFee(ctx sdk.Context, fee int64, userAddr sdk.AccAddress, keeper types.Keeper) {
-	var (
-		rebateAmount int64
-		refereeAddr  sdk.AccAddress
-	)
-	refereeAddr = keeper.GetRefereeAddr(ctx, userAddr)
-	if refereeAddr != nil {
-		rebateAmount = calRebateAmount(ctx, fee, keeper)
-		fee -= rebateAmount
-	}
-	if rebateAmount > 0 {
-		if err := keeper.SendCoins(ctx, userAddr, refereeAddr, dex.NewCetCoins(rebateAmount)); err != nil {
+	rebates := keeper.CalcRebates(ctx, userAddr, fee)
+	paid := make(authx.Rebates, 0, len(rebates))
+	for _, rebate := range rebates {
+		if err := keeper.SendCoins(ctx, userAddr, rebate.Referee, dex.NewCetCoins(rebate.Amount)); err != nil {
 			ctx.Logger().Error("%s", err.Error())
+			continue
 		}
+		fee -= rebate.Amount
+		paid = append(paid, rebate)
 	}
+	keeper.AddRebates(ctx, paid)
 	if err := keeper.SubtractFeeAndCollectFee(ctx, userAddr, fee); err != nil {
 		//should not reach this clause in production
 		ctx.Logger().Debug("unfreezeCoinsForOrder: %s", err.Error())
 	}
 }
 
-func calRebateAmount(ctx sdk.Context, fee int64, keeper types.ExpectedAuthXKeeper) int64 {
-	ratio := keeper.GetRebateRatio(ctx)
-	ratioBase := keeper.GetRebateRatioBase(ctx)
-	rebateAmount := sdk.NewInt(fee).MulRaw(ratio).QuoRaw(ratioBase).Int64()
-	return rebateAmount
-}
-
 // Iterate the candidate orders for matching, and remove the orders whose sender is forbidden by the money owner or the stock owner.
 func filterCandidates(ctx sdk.Context, asKeeper types.ExpectedAssetStatusKeeper, ordersIn []*types.Order, stock, money string) []*types.Order {
 	ordersOut := make([]*types.Order, 0, len(ordersIn))
@@ -317,6 +308,13 @@ func runMatch(ctx sdk.Context, midPrice sdk.Dec, ratio int64, symbol string, kee
 
 	// both dealt orders and IOC order need further processing
 	ordersForUpdate := infoForDeal.changedOrders
+	addIOCOrders(ctx, orderKeeper, ordersForUpdate, currHeight)
+
+	return ordersForUpdate, infoForDeal.lastPrice
+}
+
+// the IOC orders created in this block are removed even if they did not deal
+func addIOCOrders(ctx sdk.Context, orderKeeper keepers.OrderKeeper, ordersForUpdate map[string]*types.Order, currHeight int64) {
 	for _, order := range orderKeeper.GetOrdersAtHeight(ctx, currHeight) {
 		if order.TimeInForce == types.IOC {
 			// if an IOC order is not included, we include it
@@ -325,27 +323,18 @@ func runMatch(ctx sdk.Context, midPrice sdk.Dec, ratio int64, symbol string, kee
 			}
 		}
 	}
-
-	return ordersForUpdate, infoForDeal.lastPrice
 }
 
-func removeExpiredOrder(ctx sdk.Context, keeper keepers.Keeper, marketInfoList []types.MarketInfo, marketParams *types.Params) {
-	currHeight := ctx.BlockHeight()
+// remove the GTE orders which expire at this block's height or time, using the expiry queues
+func removeExpiredOrders(ctx sdk.Context, keeper keepers.Keeper, marketParams *types.Params) {
 	bankxKeeper := keeper.GetBankxKeeper()
-	for _, mi := range marketInfoList {
-		orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), mi.GetSymbol(), types.ModuleCdc)
-		oldOrders := orderKeeper.GetOlderThan(ctx, currHeight)
-
-		for _, order := range oldOrders {
-			if order.Height+order.ExistBlocks > currHeight {
-				continue
-			}
-			removeOrder(ctx, orderKeeper, bankxKeeper, keeper, order, marketParams)
-			if keeper.IsSubScribed(types.Topic) {
-				cancelOrderInfo := packageCancelOrderMsgWithDelReason(ctx, order,
-					types.CancelOrderByGteTimeOut, marketParams, keeper)
-				msgqueue.FillMsgs(ctx, types.CancelOrderInfoKey, cancelOrderInfo)
-			}
+	for _, order := range keeper.GetExpiredOrders(ctx) {
+		orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), order.TradingPair, types.ModuleCdc)
+		removeOrder(ctx, orderKeeper, bankxKeeper, keeper, order, marketParams)
+		if keeper.IsSubScribed(types.Topic) {
+			cancelOrderInfo := packageCancelOrderMsgWithDelReason(ctx, order,
+				types.CancelOrderByGteTimeOut, marketParams, keeper)
+			msgqueue.FillMsgs(ctx, types.CancelOrderInfoKey, cancelOrderInfo)
 		}
 	}
 }
@@ -377,6 +366,17 @@ func removeExpiredMarket(ctx sdk.Context, keeper keepers.Keeper, marketParams *t
 func EndBlocker(ctx sdk.Context, keeper keepers.Keeper) /*sdk.Tags*/ {
 	marketParams := keeper.GetParams(ctx)
 
+	// the orders resting before this block's matching earn the liquidity mining rewards
+	keeper.AccrueMiningRewards(ctx)
+
+	// the markets whose halts end at this height can be matched again
+	for _, symbol := range keeper.ResumeExpiredMarkets(ctx) {
+		notifyMarketHalt(ctx, keeper, symbol, false, 0, types.HaltExpired)
+	}
+
+	// the expired GTE orders are removed before matching in every block
+	removeExpiredOrders(ctx, keeper, &marketParams)
+
 	chainID := ctx.ChainID()
 	recordTime := keeper.GetOrderCleanTime(ctx)
 	currTime := ctx.BlockHeader().Time.Unix()
@@ -392,11 +392,9 @@ func EndBlocker(ctx sdk.Context, keeper keepers.Keeper) /*sdk.Tags*/ {
 		}
 	}
 
-	// if this is the first block of a new day, we clean the GTE order and there is no trade
+	// if this is the first block of a new day, we remove the delisted markets and there is no trade
 	if needRemove {
-		marketInfoList := keeper.GetAllMarketInfos(ctx)
 		keeper.SetOrderCleanTime(ctx, currTime)
-		removeExpiredOrder(ctx, keeper, marketInfoList, &marketParams)
 		removeExpiredMarket(ctx, keeper, &marketParams)
 		return //nil
 	}
@@ -423,6 +421,14 @@ func EndBlocker(ctx sdk.Context, keeper keepers.Keeper) /*sdk.Tags*/ {
 			continue
 		}
 		symbol := mi.GetSymbol()
+		// a halted market keeps its order book, but its new IOC orders can not wait for resuming
+		if mi.IsHalted(currHeight) {
+			orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), symbol, types.ModuleCdc)
+			ordersForUpdateList[idx] = make(map[string]*types.Order)
+			addIOCOrders(ctx, orderKeeper, ordersForUpdateList[idx], currHeight)
+			newPrices[idx] = sdk.ZeroDec()
+			continue
+		}
 		dataHash := ctx.BlockHeader().DataHash
 		ratio := marketParams.MaxExecutedPriceChangeRatio
 		oUpdate, newPrice := runMatch(ctx, mi.LastExecutedPrice, ratio, symbol, keeper, dataHash, currHeight)
@@ -449,8 +455,10 @@ func EndBlocker(ctx sdk.Context, keeper keepers.Keeper) /*sdk.Tags*/ {
 		}
 		// if some orders dealt, update last executed price of this market
 		if !newPrices[idx].IsZero() {
+			lastPrice := mi.LastExecutedPrice
 			mi.LastExecutedPrice = newPrices[idx]
 			keeper.SetMarket(ctx, mi)
+			checkCircuitBreaker(ctx, keeper, mi.GetSymbol(), lastPrice, newPrices[idx])
 		}
 	}
 }
@@ -482,16 +490,29 @@ func packageCancelOrderMsgWithDelReason(ctx sdk.Context, order *types.Order, del
 	}
 	msgInfo.RebateRefereeAddr = keeper.GetRefereeAddr(ctx, order.Sender).String()
 	if len(msgInfo.RebateRefereeAddr) != 0 {
-		msgInfo.RebateAmount = getRebateAmountInOrder(ctx, keeper, msgInfo.UsedCommission, msgInfo.UsedFeatureFee)
+		msgInfo.Rebates = getRebatesInOrder(ctx, keeper, order.Sender, msgInfo.UsedCommission, msgInfo.UsedFeatureFee)
+		msgInfo.RebateAmount = msgInfo.Rebates.Total()
 	}
 	msgInfo.DelReason = getCancelOrderReason(order, delReason)
 	return msgInfo
 }
 
-func getRebateAmountInOrder(ctx sdk.Context, keeper types.ExpectedAuthXKeeper, commission, featureFee int64) int64 {
-	rebateCommission := calRebateAmount(ctx, commission, keeper)
-	rebateFeatureFee := calRebateAmount(ctx, featureFee, keeper)
-	return rebateCommission + rebateFeatureFee
+// commission and feature fee are charged separately, so are their rebates
+func getRebatesInOrder(ctx sdk.Context, keeper types.ExpectedAuthXKeeper, sender sdk.AccAddress, commission, featureFee int64) authx.Rebates {
+	rebates := keeper.CalcRebates(ctx, sender, commission)
+	for _, r := range keeper.CalcRebates(ctx, sender, featureFee) {
+		merged := false
+		for i := range rebates {
+			if rebates[i].Level == r.Level {
+				rebates[i].Amount += r.Amount
+				merged = true
+			}
+		}
+		if !merged {
+			rebates = append(rebates, r)
+		}
+	}
+	return rebates
 }
 
 func getCancelOrderReason(order *types.Order, delReason string) string {
@@ -395,13 +395,13 @@ func TestRemoveExpiredOrder(t *testing.T) {
 
 	// current height - GteOrderLifeTime < 0
 	input.ctx = input.ctx.WithBlockHeight(9)
-	removeExpiredOrder(input.ctx, input.mk, []MarketInfo{mkInfo}, param)
+	removeExpiredOrders(input.ctx, input.mk, param)
 	orders = orderKeeper.GetOlderThan(input.ctx, 9)
 	require.EqualValues(t, 6, len(orders))
 
 	// Set blockHeight = 15; test remove order old than height = 5
 	input.ctx = input.ctx.WithBlockHeight(15)
-	removeExpiredOrder(input.ctx, input.mk, []MarketInfo{mkInfo}, param)
+	removeExpiredOrders(input.ctx, input.mk, param)
 	orders = orderKeeper.GetOlderThan(input.ctx, 5)
 	require.EqualValues(t, 0, len(orders))
 	orders = orderKeeper.GetOlderThan(input.ctx, 9)
@@ -411,14 +411,14 @@ func TestRemoveExpiredOrder(t *testing.T) {
 
 	// Before the height not have orders
 	input.ctx = input.ctx.WithBlockHeight(14)
-	removeExpiredOrder(input.ctx, input.mk, []MarketInfo{mkInfo}, param)
+	removeExpiredOrders(input.ctx, input.mk, param)
 	orders = orderKeeper.GetOlderThan(input.ctx, 9)
 	require.EqualValues(t, 4, len(orders))
 	require.EqualValues(t, 5, orders[3].Height)
 
 	// Order height + exist block height > current block height
 	input.ctx = input.ctx.WithBlockHeight(16)
-	removeExpiredOrder(input.ctx, input.mk, []MarketInfo{mkInfo}, param)
+	removeExpiredOrders(input.ctx, input.mk, param)
 	orders = orderKeeper.GetOlderThan(input.ctx, 9)
 	require.EqualValues(t, 3, len(orders))
 	require.EqualValues(t, 8, orders[0].Height)
@@ -427,7 +427,7 @@ func TestRemoveExpiredOrder(t *testing.T) {
 
 	// Set blockHeight = 18; test remove order old than height = 8
 	input.ctx = input.ctx.WithBlockHeight(17)
-	removeExpiredOrder(input.ctx, input.mk, []MarketInfo{mkInfo}, param)
+	removeExpiredOrders(input.ctx, input.mk, param)
 	orders = orderKeeper.GetOlderThan(input.ctx, 8)
 	require.EqualValues(t, 0, len(orders))
 	orders = orderKeeper.GetOlderThan(input.ctx, 9)
@@ -436,7 +436,7 @@ func TestRemoveExpiredOrder(t *testing.T) {
 
 	// Set blockHeight = 20; test remove order old than height = 10
 	input.ctx = input.ctx.WithBlockHeight(18)
-	removeExpiredOrder(input.ctx, input.mk, []MarketInfo{mkInfo}, param)
+	removeExpiredOrders(input.ctx, input.mk, param)
 	orders = orderKeeper.GetOlderThan(input.ctx, 10)
 	require.EqualValues(t, 0, len(orders))
 }
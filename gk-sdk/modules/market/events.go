@@ -11,6 +11,9 @@ var (
 	EventTypeKeyModifyPricePrecision = "modify_price_precision"
 	EventTypeKeyModifyBuyFeeRate = "modify_buy_fee_rate"
 	EventTypeKeyModifySellFeeRate = "modify_sell_fee_rate"
+	EventTypeKeyClaimMiningReward    = "claim_mining_reward"
+	EventTypeKeyHaltMarket           = "halt_market"
+	EventTypeKeyResumeMarket         = "resume_market"
 
 	AttributeKeyTradingPair      = "trading_pair"
 	AttributeKeyOrder            = "order"
@@ -40,4 +43,13 @@ var (
 
 	AttributeKeyOldPricePrecision = "old_price_precision"
 	AttributeKeyNewPricePrecision = "new_price_precision"
+
+	AttributeKeyMiningReward = "mining_reward"
+
+	AttributeKeyFeeRate = "fee_rate"
+
+	AttributeKeyHaltEndHeight = "halt_end_height"
+	AttributeKeyReason        = "reason"
+
+	AttributeKeyFrozen = "frozen"
 )
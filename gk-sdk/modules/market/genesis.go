@@ -10,10 +10,13 @@ import (
 )
 
 type GenesisState struct {
-	Params         types.Params       `json:"params"`
-	Orders         []*types.Order     `json:"orders"`
-	MarketInfos    []types.MarketInfo `json:"market_infos"`
-	OrderCleanTime int64              `json:"order_clean_time"`
+	Params         types.Params                `json:"params"`
+	Orders         []*types.Order              `json:"orders"`
+	MarketInfos    []types.MarketInfo          `json:"market_infos"`
+	OrderCleanTime int64                       `json:"order_clean_time"`
+	MiningRewards  []types.MiningReward        `json:"mining_rewards"`
+	DelistRequests []keepers.DelistRequest     `json:"delist_requests,omitempty"`
+	PriceWindows   []keepers.MarketPriceWindow `json:"price_windows,omitempty"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -23,6 +26,7 @@ func NewGenesisState(params types.Params, orders []*types.Order, infos []types.M
 		Orders:         orders,
 		MarketInfos:    infos,
 		OrderCleanTime: cleanTime,
+		MiningRewards:  []types.MiningReward{},
 	}
 }
 
@@ -41,13 +45,27 @@ func InitGenesis(ctx sdk.Context, keeper keepers.Keeper, data GenesisState) {
 
 	for _, info := range data.MarketInfos {
 		keeper.SetMarket(ctx, info)
+		if info.Halted && info.HaltEndHeight != 0 {
+			keeper.ScheduleResume(ctx, info.GetSymbol(), info.HaltEndHeight)
+		}
 	}
 	keeper.SetOrderCleanTime(ctx, data.OrderCleanTime)
+	keeper.SetMiningRewards(ctx, data.MiningRewards)
+
+	dlk := keepers.NewDelistKeeper(keeper.GetMarketKey())
+	for _, req := range data.DelistRequests {
+		dlk.AddDelistRequest(ctx, req.Time, req.TradingPair)
+	}
+	keeper.SetPriceWindows(ctx, data.PriceWindows)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func ExportGenesis(ctx sdk.Context, k keepers.Keeper) GenesisState {
-	return NewGenesisState(k.GetParams(ctx), k.GetAllOrders(ctx), k.GetAllMarketInfos(ctx), k.GetOrderCleanTime(ctx))
+	gs := NewGenesisState(k.GetParams(ctx), k.GetAllOrders(ctx), k.GetAllMarketInfos(ctx), k.GetOrderCleanTime(ctx))
+	gs.MiningRewards = k.GetAllMiningRewards(ctx)
+	gs.DelistRequests = keepers.NewDelistKeeper(k.GetMarketKey()).GetAllDelistRequests(ctx)
+	gs.PriceWindows = k.GetAllPriceWindows(ctx)
+	return gs
 }
 
 // ValidateGenesis performs basic validation of market genesis data returning an
@@ -63,6 +81,9 @@ func (data GenesisState) Validate() error {
 		if _, exists := tokenSymbols[order.OrderID()]; exists {
 			return errors.New("duplicate order found during market ValidateGenesis")
 		}
+		if order.ExpireTime < 0 {
+			return errors.New("invalid order expire time found during market ValidateGenesis")
+		}
 		tokenSymbols[order.OrderID()] = struct{}{}
 	}
 
@@ -72,7 +93,43 @@ func (data GenesisState) Validate() error {
 		if _, exists := infos[symbol]; exists {
 			return errors.New("duplicate market found during market ValidateGenesis")
 		}
+		if info.HaltEndHeight < 0 || (!info.Halted && info.HaltEndHeight != 0) {
+			return errors.New("invalid market halt found during market ValidateGenesis")
+		}
 		infos[symbol] = struct{}{}
 	}
+
+	miners := make(map[string]struct{})
+	for _, reward := range data.MiningRewards {
+		if reward.Address.Empty() || reward.Amount <= 0 {
+			return errors.New("invalid mining reward found during market ValidateGenesis")
+		}
+		if _, exists := miners[string(reward.Address)]; exists {
+			return errors.New("duplicate mining reward found during market ValidateGenesis")
+		}
+		miners[string(reward.Address)] = struct{}{}
+	}
+
+	delists := make(map[string]struct{})
+	for _, req := range data.DelistRequests {
+		if _, exists := infos[req.TradingPair]; !exists || req.Time <= 0 {
+			return errors.New("invalid delist request found during market ValidateGenesis")
+		}
+		if _, exists := delists[req.TradingPair]; exists {
+			return errors.New("duplicate delist request found during market ValidateGenesis")
+		}
+		delists[req.TradingPair] = struct{}{}
+	}
+
+	windows := make(map[string]struct{})
+	for _, w := range data.PriceWindows {
+		if _, exists := infos[w.TradingPair]; !exists || w.Window.StartPrice.IsNil() || w.Window.StartPrice.IsNegative() {
+			return errors.New("invalid price window found during market ValidateGenesis")
+		}
+		if _, exists := windows[w.TradingPair]; exists {
+			return errors.New("duplicate price window found during market ValidateGenesis")
+		}
+		windows[w.TradingPair] = struct{}{}
+	}
 	return nil
 }
@@ -63,6 +63,9 @@ func TestExportGenesis(t *testing.T) {
 	input := prepareMockInput(t, false, false)
 	_, orderInfos, _, mkInfos := createOrdersAndMarkets(9)
 	state := NewGenesisState(types.DefaultParams(), orderInfos, mkInfos, 876738)
+	state.DelistRequests = []DelistRequest{{TradingPair: mkInfos[1].GetSymbol(), Time: 1000}}
+	state.PriceWindows = []MarketPriceWindow{{TradingPair: mkInfos[2].GetSymbol(),
+		Window: PriceWindow{StartHeight: 10, StartPrice: sdk.NewDec(987)}}}
 	require.Nil(t, state.Validate())
 	InitGenesis(input.ctx, input.mk, state)
 	orders := make(map[string]Order)
@@ -80,6 +83,8 @@ func TestExportGenesis(t *testing.T) {
 	for i, exMarket := range exportState.MarketInfos {
 		require.EqualValues(t, mkInfos[i], exMarket)
 	}
+	require.Equal(t, state.DelistRequests, exportState.DelistRequests)
+	require.Equal(t, state.PriceWindows, exportState.PriceWindows)
 }
 
 func TestValidateGenesis(t *testing.T) {
@@ -99,4 +104,8 @@ func TestValidateGenesis(t *testing.T) {
 	require.NotNil(t, err)
 	require.EqualValues(t, "duplicate order found during market ValidateGenesis", err.Error())
 
+	state = NewGenesisState(types.DefaultParams(), orderInfos[:1], mkInfos, 876738)
+	state.DelistRequests = []DelistRequest{{TradingPair: "xyz/abc", Time: 1000}}
+	require.EqualValues(t, "invalid delist request found during market ValidateGenesis", state.Validate().Error())
+
 }
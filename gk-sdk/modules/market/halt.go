@@ -0,0 +1,110 @@
+package market
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	"github.com/coinexchain/cet-sdk/msgqueue"
+)
+
+func handleMsgHaltMarket(ctx sdk.Context, msg types.MsgHaltMarket, k keepers.Keeper) sdk.Result {
+	if err := checkMarketOwner(ctx, k, msg.Sender, msg.TradingPair); err != nil {
+		return err.Result()
+	}
+	if err := k.HaltMarket(ctx, msg.TradingPair, 0, types.HaltByOwner); err != nil {
+		return err.Result()
+	}
+
+	notifyMarketHalt(ctx, k, msg.TradingPair, true, 0, types.HaltByOwner)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgResumeMarket(ctx sdk.Context, msg types.MsgResumeMarket, k keepers.Keeper) sdk.Result {
+	if err := checkMarketOwner(ctx, k, msg.Sender, msg.TradingPair); err != nil {
+		return err.Result()
+	}
+	// the halts by governance and by the circuit breaker can not be lifted by the owner
+	if info, _ := k.GetMarketInfo(ctx, msg.TradingPair); info.Halted && info.HaltReason != types.HaltByOwner {
+		return types.ErrNotHaltedByOwner(msg.TradingPair, info.HaltReason).Result()
+	}
+	if err := k.ResumeMarket(ctx, msg.TradingPair); err != nil {
+		return err.Result()
+	}
+
+	notifyMarketHalt(ctx, k, msg.TradingPair, false, 0, types.HaltByOwner)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func checkMarketOwner(ctx sdk.Context, k keepers.Keeper, sender sdk.AccAddress, tradingPair string) sdk.Error {
+	info, err := k.GetMarketInfo(ctx, tradingPair)
+	if err != nil {
+		return types.ErrInvalidMarket("Error retrieving market information: " + err.Error())
+	}
+	if owner := k.MarketOwner(ctx, info); !owner.Equals(sender) {
+		return types.ErrNotMatchSender(fmt.Sprintf(
+			"The sender of the transaction (%s) does not match the owner of the transaction pair (%s)",
+			sender.String(), owner.String()))
+	}
+	return nil
+}
+
+// checkCircuitBreaker is called after a market's price changed in EndBlocker
+func checkCircuitBreaker(ctx sdk.Context, k keepers.Keeper, symbol string, lastPrice, newPrice sdk.Dec) {
+	halted, err := k.CheckCircuitBreaker(ctx, symbol, lastPrice, newPrice)
+	if err != nil {
+		ctx.Logger().Error("%s", err.Error())
+		return
+	}
+	if halted {
+		haltEndHeight := ctx.BlockHeight() + k.GetParams(ctx).CircuitBreakerHaltBlocks
+		notifyMarketHalt(ctx, k, symbol, true, haltEndHeight, types.HaltByCircuitBreaker)
+	}
+}
+
+// notifyMarketHalt emits the event of a halted or resumed market and sends it to msgqueue
+func notifyMarketHalt(ctx sdk.Context, k keepers.Keeper, symbol string, halted bool, haltEndHeight int64, reason string) {
+	eventType := EventTypeKeyResumeMarket
+	if halted {
+		eventType = EventTypeKeyHaltMarket
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(AttributeKeyTradingPair, symbol),
+			sdk.NewAttribute(AttributeKeyHaltEndHeight, strconv.FormatInt(haltEndHeight, 10)),
+			sdk.NewAttribute(AttributeKeyReason, reason),
+		),
+	)
+
+	if k.IsSubScribed(types.Topic) {
+		msgqueue.FillMsgs(ctx, types.MarketHaltInfoKey, types.MarketHaltInfo{
+			TradingPair:   symbol,
+			Halted:        halted,
+			Height:        ctx.BlockHeight(),
+			HaltEndHeight: haltEndHeight,
+			Reason:        reason,
+		})
+	}
+}
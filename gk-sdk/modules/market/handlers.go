@@ -31,6 +31,12 @@ func NewHandler(k keepers.Keeper) sdk.Handler {
 			return handleMsgModifyPricePrecision(ctx, msg, k)
 		case types.MsgModifyFeeRate:
 			return handleMsgModifyFeeRate(ctx, msg, k)
+		case types.MsgClaimMiningReward:
+			return handleMsgClaimMiningReward(ctx, msg, k)
+		case types.MsgHaltMarket:
+			return handleMsgHaltMarket(ctx, msg, k)
+		case types.MsgResumeMarket:
+			return handleMsgResumeMarket(ctx, msg, k)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 		}
@@ -42,22 +48,7 @@ func handleMsgCreateTradingPair(ctx sdk.Context, msg types.MsgCreateTradingPair,
 		return err.Result()
 	}
 
-	var orderPrecision byte
-	if msg.OrderPrecision <= types.MaxOrderPrecision {
-		orderPrecision = msg.OrderPrecision
-	}
-
-	buyFeeRate := sdk.NewDec(2).Quo(sdk.NewDec(1000))
-	sellFeeRate := sdk.NewDec(2).Quo(sdk.NewDec(1000))
-	info := types.MarketInfo{
-		Stock:             msg.Stock,
-		Money:             msg.Money,
-		PricePrecision:    msg.PricePrecision,
-		LastExecutedPrice: sdk.ZeroDec(),
-		OrderPrecision:    orderPrecision,
-		BuyFeeRate: buyFeeRate,
-		SellFeeRate: sellFeeRate,
-	}
+	info := newMarketInfo(msg.Stock, msg.Money, msg.PricePrecision, msg.OrderPrecision)
 
 	if msg.BuyFeeRate != (sdk.Dec{}) {
 		info.BuyFeeRate = msg.BuyFeeRate
@@ -101,6 +92,22 @@ func handleMsgCreateTradingPair(ctx sdk.Context, msg types.MsgCreateTradingPair,
 	}
 }
 
+// newMarketInfo returns a fresh trading pair with the default fee rates
+func newMarketInfo(stock, money string, pricePrecision, orderPrecision byte) types.MarketInfo {
+	if orderPrecision > types.MaxOrderPrecision {
+		orderPrecision = 0
+	}
+	return types.MarketInfo{
+		Stock:             stock,
+		Money:             money,
+		PricePrecision:    pricePrecision,
+		LastExecutedPrice: sdk.ZeroDec(),
+		OrderPrecision:    orderPrecision,
+		BuyFeeRate:        sdk.NewDec(2).Quo(sdk.NewDec(1000)),
+		SellFeeRate:       sdk.NewDec(2).Quo(sdk.NewDec(1000)),
+	}
+}
+
 func checkMsgCreateTradingPair(ctx sdk.Context, msg types.MsgCreateTradingPair, keeper keepers.Keeper) sdk.Error {
 	if _, err := keeper.GetMarketInfo(ctx, msg.GetSymbol()); err == nil {
 		return types.ErrRepeatTradingPair()
@@ -215,6 +222,8 @@ func sendCreateOrderMsg(ctx sdk.Context, keeper keepers.Keeper, order types.Orde
 			FrozenFeatureFee: order.FrozenFeatureFee,
 			Freeze:           order.Freeze,
 			FeeRate:          order.FeeRate,
+			ExistBlocks:      order.ExistBlocks,
+			ExpireTime:       order.ExpireTime,
 		}
 		msgqueue.FillMsgs(ctx, types.CreateOrderInfoKey, createOrderInfo)
 	}
@@ -266,6 +275,9 @@ func handleMsgCreateOrder(ctx sdk.Context, msg types.MsgCreateOrder, keeper keep
 	if existBlocks == 0 && msg.TimeInForce == GTE {
 		existBlocks = marketParams.GTEOrderLifetime
 	}
+	if msg.TimeInForce == GTE && msg.ExpireTime != 0 && msg.ExpireTime <= ctx.BlockHeader().Time.Unix() {
+		return types.ErrInvalidExpireTime(msg.ExpireTime).Result()
+	}
 
 	feeRate := sdk.NewDec(2).Quo(sdk.NewDec(1000))
 
@@ -289,6 +301,9 @@ func handleMsgCreateOrder(ctx sdk.Context, msg types.MsgCreateOrder, keeper keep
 		DealStock:        0,
 		FeeRate:          feeRate,
 	}
+	if msg.TimeInForce == GTE {
+		order.ExpireTime = msg.ExpireTime
+	}
 
 	ork := keepers.NewOrderKeeper(keeper.GetMarketKey(), order.TradingPair, types.ModuleCdc)
 	if err := ork.Add(ctx, &order); err != nil {
@@ -299,12 +314,14 @@ func handleMsgCreateOrder(ctx sdk.Context, msg types.MsgCreateOrder, keeper keep
 	}
 	sendCreateOrderMsg(ctx, keeper, order)
 
+	frozen := sdk.NewCoins(sdk.NewInt64Coin(denom, amount)).Add(dex.NewCetCoins(totalFee))
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			EventTypeKeyCreateOrder,
 			sdk.NewAttribute(AttributeKeyOrder, order.OrderID()),
 			sdk.NewAttribute(AttributeKeyTradingPair, order.TradingPair),
 			sdk.NewAttribute(AttributeKeyHeight, strconv.FormatInt(order.Height, 10)),
+			sdk.NewAttribute(AttributeKeyFrozen, frozen.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -481,6 +498,9 @@ func handleMsgModifyPricePrecision(ctx sdk.Context, msg types.MsgModifyPricePrec
 		LastExecutedPrice: oldInfo.LastExecutedPrice,
 		BuyFeeRate: oldInfo.BuyFeeRate,
 		SellFeeRate: oldInfo.SellFeeRate,
+		Halted:            oldInfo.Halted,
+		HaltEndHeight:     oldInfo.HaltEndHeight,
+		HaltReason:        oldInfo.HaltReason,
 	}
 	if err := k.SetMarket(ctx, info); err != nil {
 		return err.Result()
@@ -535,6 +555,9 @@ func handleMsgModifyFeeRate(ctx sdk.Context, msg types.MsgModifyFeeRate, k keepe
 		LastExecutedPrice: oldInfo.LastExecutedPrice,
 		BuyFeeRate: msg.BuyFeeRate,
 		SellFeeRate: msg.SellFeeRate,
+		Halted:            oldInfo.Halted,
+		HaltEndHeight:     oldInfo.HaltEndHeight,
+		HaltReason:        oldInfo.HaltReason,
 	}
 
 	
@@ -578,3 +601,26 @@ func checkMsgModifyFeeRate(ctx sdk.Context, msg types.MsgModifyFeeRate, k keeper
 
 	return nil
 }
+
+func handleMsgClaimMiningReward(ctx sdk.Context, msg types.MsgClaimMiningReward, k keepers.Keeper) sdk.Result {
+	amount, err := k.ClaimMiningReward(ctx, msg.Sender)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeKeyClaimMiningReward,
+			sdk.NewAttribute(AttributeKeyMiningReward, strconv.FormatInt(amount, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
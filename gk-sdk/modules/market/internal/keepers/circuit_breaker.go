@@ -0,0 +1,172 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// PriceWindow records the price of a market when its current circuit breaker window started
+type PriceWindow struct {
+	StartHeight int64   `json:"start_height"`
+	StartPrice  sdk.Dec `json:"start_price"`
+}
+
+func priceWindowKey(symbol string) []byte {
+	return dex.ConcatKeys(PriceWindowKeyPrefix, []byte(symbol))
+}
+
+func haltEndKey(height int64, symbol string) []byte {
+	return dex.ConcatKeys(HaltEndKeyPrefix, int64ToBigEndianBytes(height), []byte{0x0}, []byte(symbol))
+}
+
+func (k Keeper) GetPriceWindow(ctx sdk.Context, symbol string) (window PriceWindow, found bool) {
+	bz := ctx.KVStore(k.marketKey).Get(priceWindowKey(symbol))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &window)
+	return window, true
+}
+
+func (k Keeper) setPriceWindow(ctx sdk.Context, symbol string, window PriceWindow) {
+	ctx.KVStore(k.marketKey).Set(priceWindowKey(symbol), k.cdc.MustMarshalBinaryBare(window))
+}
+
+// CheckCircuitBreaker halts a market for CircuitBreakerHaltBlocks when its newly executed price
+// moved more than CircuitBreakerRatio percent from the price at the start of the current window.
+// A new window starts from lastPrice once the old one is CircuitBreakerWindow blocks old.
+func (k Keeper) CheckCircuitBreaker(ctx sdk.Context, symbol string, lastPrice, newPrice sdk.Dec) (bool, sdk.Error) {
+	params := k.GetParams(ctx)
+	if params.CircuitBreakerRatio == 0 || !newPrice.IsPositive() {
+		return false, nil
+	}
+
+	height := ctx.BlockHeight()
+	window, found := k.GetPriceWindow(ctx, symbol)
+	if !found || height-window.StartHeight >= params.CircuitBreakerWindow {
+		window = PriceWindow{StartHeight: height, StartPrice: lastPrice}
+		if !lastPrice.IsPositive() {
+			window.StartPrice = newPrice
+		}
+		k.setPriceWindow(ctx, symbol, window)
+	}
+
+	change := newPrice.Sub(window.StartPrice).Abs().MulInt64(100)
+	if change.LTE(window.StartPrice.MulInt64(params.CircuitBreakerRatio)) {
+		return false, nil
+	}
+	return true, k.HaltMarket(ctx, symbol, height+params.CircuitBreakerHaltBlocks, types.HaltByCircuitBreaker)
+}
+
+// HaltMarket stops matching the orders of a market until haltEndHeight,
+// or until it is resumed when haltEndHeight is zero. A halt by proposal
+// replaces any current halt, so that it can not be lifted by the owner.
+func (k Keeper) HaltMarket(ctx sdk.Context, symbol string, haltEndHeight int64, reason string) sdk.Error {
+	info, err := k.GetMarketInfo(ctx, symbol)
+	if err != nil {
+		return types.ErrInvalidMarket(err.Error())
+	}
+	if info.IsHalted(ctx.BlockHeight()) && reason != types.HaltByProposal {
+		return types.ErrMarketHalted(symbol)
+	}
+
+	k.clearHalt(ctx, info)
+	info.Halted = true
+	info.HaltEndHeight = haltEndHeight
+	info.HaltReason = reason
+	if haltEndHeight != 0 {
+		k.ScheduleResume(ctx, symbol, haltEndHeight)
+	}
+	// the price moves before the halt should not trip the breaker again after it
+	ctx.KVStore(k.marketKey).Delete(priceWindowKey(symbol))
+	return k.SetMarket(ctx, info)
+}
+
+// ResumeMarket restarts matching the orders of a halted market
+func (k Keeper) ResumeMarket(ctx sdk.Context, symbol string) sdk.Error {
+	info, err := k.GetMarketInfo(ctx, symbol)
+	if err != nil {
+		return types.ErrInvalidMarket(err.Error())
+	}
+	if !info.Halted {
+		return types.ErrMarketNotHalted(symbol)
+	}
+
+	k.clearHalt(ctx, info)
+	info.Halted = false
+	info.HaltEndHeight = 0
+	info.HaltReason = ""
+	return k.SetMarket(ctx, info)
+}
+
+// ScheduleResume queues a halted market to be resumed at height
+func (k Keeper) ScheduleResume(ctx sdk.Context, symbol string, height int64) {
+	ctx.KVStore(k.marketKey).Set(haltEndKey(height, symbol), []byte(symbol))
+}
+
+// ResumeExpiredMarkets resumes the markets whose halts end at or before the current height
+func (k Keeper) ResumeExpiredMarkets(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.marketKey)
+	start := dex.ConcatKeys(HaltEndKeyPrefix, int64ToBigEndianBytes(0), []byte{0x0})
+	end := dex.ConcatKeys(HaltEndKeyPrefix, int64ToBigEndianBytes(ctx.BlockHeight()), []byte{0x1})
+	iter := store.Iterator(start, end)
+	var symbols []string
+	for ; iter.Valid(); iter.Next() {
+		symbols = append(symbols, string(iter.Value()))
+	}
+	iter.Close()
+
+	resumed := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		if err := k.ResumeMarket(ctx, symbol); err != nil {
+			ctx.Logger().Error("%s", err.Error())
+			continue
+		}
+		resumed = append(resumed, symbol)
+	}
+	return resumed
+}
+
+func (k Keeper) clearHalt(ctx sdk.Context, info types.MarketInfo) {
+	if info.Halted && info.HaltEndHeight != 0 {
+		ctx.KVStore(k.marketKey).Delete(haltEndKey(info.HaltEndHeight, info.GetSymbol()))
+	}
+}
+
+// clearCircuitBreaker drops the circuit breaker state of a market being removed
+func (k Keeper) clearCircuitBreaker(ctx sdk.Context, symbol string) {
+	if info, err := k.GetMarketInfo(ctx, symbol); err == nil {
+		k.clearHalt(ctx, info)
+	}
+	ctx.KVStore(k.marketKey).Delete(priceWindowKey(symbol))
+}
+
+// MarketPriceWindow is the circuit breaker window of a market, for dumping state
+type MarketPriceWindow struct {
+	TradingPair string      `json:"trading_pair"`
+	Window      PriceWindow `json:"window"`
+}
+
+func (k Keeper) GetAllPriceWindows(ctx sdk.Context) []MarketPriceWindow {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.marketKey), PriceWindowKeyPrefix)
+	defer iter.Close()
+	windows := make([]MarketPriceWindow, 0)
+	for ; iter.Valid(); iter.Next() {
+		var window PriceWindow
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &window)
+		windows = append(windows, MarketPriceWindow{
+			TradingPair: string(iter.Key()[len(PriceWindowKeyPrefix):]),
+			Window:      window,
+		})
+	}
+	return windows
+}
+
+// SetPriceWindows restores the circuit breaker windows from genesis
+func (k Keeper) SetPriceWindows(ctx sdk.Context, windows []MarketPriceWindow) {
+	for _, w := range windows {
+		k.setPriceWindow(ctx, w.TradingPair, w.Window)
+	}
+}
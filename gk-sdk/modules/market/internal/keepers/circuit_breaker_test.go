@@ -0,0 +1,177 @@
+package keepers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/market"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	"github.com/coinexchain/cet-sdk/testapp"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	testApp := testapp.NewTestApp()
+	mk := testApp.MarketKeeper
+	ctx := testApp.NewCtx().WithBlockHeight(100)
+	symbol := "abc/" + dex.CET
+
+	params := types.DefaultParams()
+	params.CircuitBreakerRatio = 10
+	params.CircuitBreakerWindow = 10
+	params.CircuitBreakerHaltBlocks = 20
+	mk.SetParams(ctx, params)
+	require.Nil(t, mk.SetMarket(ctx, types.MarketInfo{Stock: "abc", Money: dex.CET, LastExecutedPrice: sdk.OneDec()}))
+
+	// the window starts at the last price, moves within 10% are fine
+	halted, err := mk.CheckCircuitBreaker(ctx, symbol, sdk.OneDec(), sdk.MustNewDecFromStr("1.1"))
+	require.Nil(t, err)
+	require.False(t, halted)
+	window, found := mk.GetPriceWindow(ctx, symbol)
+	require.True(t, found)
+	require.Equal(t, int64(100), window.StartHeight)
+	require.Equal(t, sdk.OneDec(), window.StartPrice)
+	windows := mk.GetAllPriceWindows(ctx)
+	require.Equal(t, []keepers.MarketPriceWindow{{TradingPair: symbol, Window: window}}, windows)
+	mk.SetPriceWindows(ctx.WithBlockHeight(0), windows)
+	window2, _ := mk.GetPriceWindow(ctx, symbol)
+	require.Equal(t, window, window2)
+
+	// a new window starts after CircuitBreakerWindow blocks
+	ctx = ctx.WithBlockHeight(110)
+	halted, err = mk.CheckCircuitBreaker(ctx, symbol, sdk.MustNewDecFromStr("1.1"), sdk.MustNewDecFromStr("1.2"))
+	require.Nil(t, err)
+	require.False(t, halted)
+
+	// 1.1 -> 0.9 trips the breaker
+	halted, err = mk.CheckCircuitBreaker(ctx, symbol, sdk.MustNewDecFromStr("1.2"), sdk.MustNewDecFromStr("0.9"))
+	require.Nil(t, err)
+	require.True(t, halted)
+	info, _ := mk.GetMarketInfo(ctx, symbol)
+	require.True(t, info.IsHalted(110))
+	require.Equal(t, types.HaltByCircuitBreaker, info.HaltReason)
+	require.Equal(t, int64(130), info.HaltEndHeight)
+	_, found = mk.GetPriceWindow(ctx, symbol)
+	require.False(t, found)
+	require.Equal(t, types.CodeMarketHalted, mk.HaltMarket(ctx, symbol, 0, types.HaltByOwner).Code())
+
+	// the halt ends at HaltEndHeight
+	require.Empty(t, mk.ResumeExpiredMarkets(ctx.WithBlockHeight(129)))
+	require.Equal(t, []string{symbol}, mk.ResumeExpiredMarkets(ctx.WithBlockHeight(130)))
+	info, _ = mk.GetMarketInfo(ctx, symbol)
+	require.False(t, info.Halted)
+	require.Equal(t, int64(0), info.HaltEndHeight)
+	require.Empty(t, mk.ResumeExpiredMarkets(ctx.WithBlockHeight(131)))
+
+	// the breaker is off when the ratio is zero
+	params.CircuitBreakerRatio = 0
+	mk.SetParams(ctx, params)
+	halted, err = mk.CheckCircuitBreaker(ctx, symbol, sdk.OneDec(), sdk.NewDec(100))
+	require.Nil(t, err)
+	require.False(t, halted)
+}
+
+func TestHaltAndResumeMarket(t *testing.T) {
+	testApp := testapp.NewTestApp()
+	mk := testApp.MarketKeeper
+	ctx := testApp.NewCtx().WithBlockHeight(100)
+	symbol := "abc/" + dex.CET
+	mk.SetParams(ctx, types.DefaultParams())
+
+	require.Equal(t, types.CodeInvalidMarket, mk.HaltMarket(ctx, symbol, 0, types.HaltByOwner).Code())
+	require.Nil(t, mk.SetMarket(ctx, types.MarketInfo{Stock: "abc", Money: dex.CET, LastExecutedPrice: sdk.OneDec()}))
+	require.Equal(t, types.CodeMarketNotHalted, mk.ResumeMarket(ctx, symbol).Code())
+
+	// a halt without an end height lasts until the market is resumed
+	require.Nil(t, mk.HaltMarket(ctx, symbol, 0, types.HaltByOwner))
+	require.Empty(t, mk.ResumeExpiredMarkets(ctx.WithBlockHeight(100000)))
+	info, _ := mk.GetMarketInfo(ctx, symbol)
+	require.True(t, info.IsHalted(100000))
+	require.Nil(t, mk.ResumeMarket(ctx, symbol))
+
+	// resuming a market early drops its scheduled resume
+	require.Nil(t, mk.HaltMarket(ctx, symbol, 120, types.HaltByOwner))
+	require.Nil(t, mk.ResumeMarket(ctx, symbol))
+	require.Empty(t, mk.ResumeExpiredMarkets(ctx.WithBlockHeight(120)))
+
+	// so does removing it
+	require.Nil(t, mk.HaltMarket(ctx, symbol, 120, types.HaltByOwner))
+	require.Nil(t, mk.RemoveMarket(ctx, symbol))
+	require.Empty(t, mk.ResumeExpiredMarkets(ctx.WithBlockHeight(120)))
+}
+
+func TestResumeMarketByOwner(t *testing.T) {
+	testApp := testapp.NewTestApp()
+	mk := testApp.MarketKeeper
+	ctx := testApp.NewCtx().WithBlockHeight(100)
+	symbol := "abc/" + dex.CET
+	owner := testutil.ToAccAddress("owner")
+	mk.SetParams(ctx, types.DefaultParams())
+	token, _ := asset.NewToken("abc", "abc", sdk.NewInt(100000000), owner,
+		false, false, false, false, "", "", asset.TestIdentityString)
+	require.Nil(t, testApp.AssetKeeper.SetToken(ctx, token))
+	require.Nil(t, mk.SetMarket(ctx, types.MarketInfo{Stock: "abc", Money: dex.CET, LastExecutedPrice: sdk.OneDec()}))
+	handler := market.NewHandler(mk)
+	resume := market.NewMsgResumeMarket(owner, symbol)
+
+	// the owner can not lift the halts by governance and by the circuit breaker
+	require.Nil(t, mk.HaltMarket(ctx, symbol, 0, types.HaltByProposal))
+	require.Equal(t, types.CodeNotHaltedByOwner, handler(ctx, resume).Code)
+	require.Nil(t, mk.ResumeMarket(ctx, symbol))
+	require.Nil(t, mk.HaltMarket(ctx, symbol, 120, types.HaltByCircuitBreaker))
+	require.Equal(t, types.CodeNotHaltedByOwner, handler(ctx, resume).Code)
+	info, _ := mk.GetMarketInfo(ctx, symbol)
+	require.True(t, info.IsHalted(100))
+	require.Nil(t, mk.ResumeMarket(ctx, symbol))
+
+	// but can lift its own
+	require.True(t, handler(ctx, market.NewMsgHaltMarket(owner, symbol)).IsOK())
+	info, _ = mk.GetMarketInfo(ctx, symbol)
+	require.Equal(t, types.HaltByOwner, info.HaltReason)
+	require.True(t, handler(ctx, resume).IsOK())
+	info, _ = mk.GetMarketInfo(ctx, symbol)
+	require.False(t, info.Halted)
+	require.Empty(t, info.HaltReason)
+}
+
+func TestProposalOverridesHalt(t *testing.T) {
+	testApp := testapp.NewTestApp()
+	mk := testApp.MarketKeeper
+	ctx := testApp.NewCtx().WithBlockHeight(100)
+	symbol := "abc/" + dex.CET
+	owner := testutil.ToAccAddress("owner")
+	mk.SetParams(ctx, types.DefaultParams())
+	token, _ := asset.NewToken("abc", "abc", sdk.NewInt(100000000), owner,
+		false, false, false, false, "", "", asset.TestIdentityString)
+	require.Nil(t, testApp.AssetKeeper.SetToken(ctx, token))
+	require.Nil(t, mk.SetMarket(ctx, types.MarketInfo{Stock: "abc", Money: dex.CET, LastExecutedPrice: sdk.OneDec()}))
+	handler := market.NewHandler(mk)
+	proposalHandler := market.NewProposalHandler(mk)
+	haltProposal := types.NewMarketHaltProposal("title", "desc", symbol, true)
+
+	// the owner halts just before the proposal passes, and can not lift the halt by proposal
+	require.True(t, handler(ctx, market.NewMsgHaltMarket(owner, symbol)).IsOK())
+	require.Nil(t, proposalHandler(ctx, haltProposal))
+	require.Equal(t, types.CodeNotHaltedByOwner, handler(ctx, market.NewMsgResumeMarket(owner, symbol)).Code)
+	info, _ := mk.GetMarketInfo(ctx, symbol)
+	require.Equal(t, types.HaltByProposal, info.HaltReason)
+	require.EqualValues(t, 0, info.HaltEndHeight)
+
+	// the scheduled end of a circuit breaker halt is dropped too
+	require.Nil(t, mk.ResumeMarket(ctx, symbol))
+	require.Nil(t, mk.HaltMarket(ctx, symbol, 120, types.HaltByCircuitBreaker))
+	require.Nil(t, proposalHandler(ctx, haltProposal))
+	require.Empty(t, mk.ResumeExpiredMarkets(ctx.WithBlockHeight(120)))
+	info, _ = mk.GetMarketInfo(ctx, symbol)
+	require.True(t, info.IsHalted(200))
+	require.Equal(t, types.HaltByProposal, info.HaltReason)
+
+	// while the other halts do not replace it
+	require.Equal(t, types.CodeMarketHalted, mk.HaltMarket(ctx, symbol, 150, types.HaltByCircuitBreaker).Code())
+}
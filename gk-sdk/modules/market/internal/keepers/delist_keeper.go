@@ -1,6 +1,8 @@
 package keepers
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	dex "github.com/coinexchain/cet-sdk/types"
@@ -74,3 +76,24 @@ func (keeper *DelistKeeper) RemoveDelistRequestsBeforeTime(ctx sdk.Context, time
 		store.Delete(append(DelistRevKey, symbol...))
 	}
 }
+
+// DelistRequest is a trading pair to be delisted at Time, for dumping state
+type DelistRequest struct {
+	TradingPair string `json:"trading_pair"`
+	Time        int64  `json:"time"`
+}
+
+func (keeper *DelistKeeper) GetAllDelistRequests(ctx sdk.Context) []DelistRequest {
+	store := ctx.KVStore(keeper.marketKey)
+	iter := sdk.KVStorePrefixIterator(store, DelistKey)
+	defer iter.Close()
+	requests := make([]DelistRequest, 0)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(DelistKey):]
+		requests = append(requests, DelistRequest{
+			TradingPair: string(iter.Value()),
+			Time:        int64(binary.BigEndian.Uint64(key[:8])),
+		})
+	}
+	return requests
+}
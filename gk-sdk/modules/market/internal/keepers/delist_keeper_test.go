@@ -23,6 +23,9 @@ func TestRemoveDeListRequestsBeforeTime(t *testing.T) {
 	require.Equal(t, true, keeper.HasDelistRequest(ctx, "aaa/b"))
 	require.Equal(t, true, keeper.HasDelistRequest(ctx, "bbb/b"))
 	require.Equal(t, true, keeper.HasDelistRequest(ctx, "ccc/b"))
+	require.Equal(t, []keepers.DelistRequest{
+		{TradingPair: "aaa/b", Time: 100}, {TradingPair: "bbb/b", Time: 200}, {TradingPair: "ccc/b", Time: 300}},
+		keeper.GetAllDelistRequests(ctx))
 	keeper.RemoveDelistRequestsBeforeTime(ctx, 200)
 	s = keeper.GetDelistSymbolsBeforeTime(ctx, 300)
 	require.Equal(t, len(s), 1)
@@ -30,4 +33,5 @@ func TestRemoveDeListRequestsBeforeTime(t *testing.T) {
 	require.Equal(t, false, keeper.HasDelistRequest(ctx, "aaa/b"))
 	require.Equal(t, false, keeper.HasDelistRequest(ctx, "bbb/b"))
 	require.Equal(t, true, keeper.HasDelistRequest(ctx, "ccc/b"))
+	require.Equal(t, []keepers.DelistRequest{{TradingPair: "ccc/b", Time: 300}}, keeper.GetAllDelistRequests(ctx))
 }
@@ -0,0 +1,66 @@
+package keepers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	"github.com/coinexchain/cet-sdk/testapp"
+	"github.com/coinexchain/cet-sdk/testutil"
+)
+
+func TestOrderExpiryQueues(t *testing.T) {
+	app := testapp.NewTestApp()
+	mk := app.MarketKeeper
+	ctx := app.NewCtx().WithBlockHeight(100).WithBlockTime(time.Unix(1000, 0))
+	alice := testutil.ToAccAddress("alice")
+
+	newOrder := func(seq uint64, tif, existBlocks, expireTime int64) *types.Order {
+		return &types.Order{
+			Sender:      alice,
+			Sequence:    seq,
+			TradingPair: "abc/cet",
+			OrderType:   types.LimitOrder,
+			Price:       sdk.OneDec(),
+			Quantity:    10,
+			LeftStock:   10,
+			Side:        types.BUY,
+			TimeInForce: tif,
+			Height:      100,
+			ExistBlocks: existBlocks,
+			ExpireTime:  expireTime,
+		}
+	}
+	byHeight := newOrder(1, types.GTE, 10, 0)
+	byTime := newOrder(2, types.GTE, 1000, 1100)
+	byBoth := newOrder(3, types.GTE, 20, 1100)
+	ioc := newOrder(4, types.IOC, 0, 0)
+	for _, order := range []*types.Order{byHeight, byTime, byBoth, ioc} {
+		require.Nil(t, mk.SetOrder(ctx, order))
+	}
+
+	orderIDs := func(ctx sdk.Context) []string {
+		var ids []string
+		for _, order := range mk.GetExpiredOrders(ctx) {
+			ids = append(ids, order.OrderID())
+		}
+		return ids
+	}
+	require.Empty(t, orderIDs(ctx.WithBlockHeight(109)))
+	require.Equal(t, []string{byHeight.OrderID()}, orderIDs(ctx.WithBlockHeight(110)))
+	require.Equal(t, []string{byTime.OrderID(), byBoth.OrderID()}, orderIDs(ctx.WithBlockTime(time.Unix(1100, 0))))
+	// an order expiring both by height and by time is only returned once
+	require.Equal(t, []string{byHeight.OrderID(), byBoth.OrderID(), byTime.OrderID()},
+		orderIDs(ctx.WithBlockHeight(120).WithBlockTime(time.Unix(1100, 0))))
+
+	// removed orders leave the queues
+	ok := keepers.NewOrderKeeper(mk.GetMarketKey(), "abc/cet", types.ModuleCdc)
+	require.Nil(t, ok.Remove(ctx, byBoth))
+	require.Nil(t, ok.Remove(ctx, byHeight))
+	require.Equal(t, []string{byTime.OrderID()}, orderIDs(ctx.WithBlockHeight(120).WithBlockTime(time.Unix(1100, 0))))
+}
@@ -6,11 +6,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
 	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
@@ -32,14 +32,14 @@ type Keeper struct {
 	msgProducer   msgqueue.MsgSender
 	ak            auth.AccountKeeper
 	authX         types.ExpectedAuthXKeeper
-	supplyKeeper     authtypes.SupplyKeeper
+	supplyKeeper     types.ExpectedSupplyKeeper
 }
 
 func NewKeeper(key sdk.StoreKey, axkVal types.ExpectedAssetStatusKeeper,
 	bnkVal types.ExpectedBankxKeeper, cdcVal *codec.Codec,
 	msgKeeperVal msgqueue.MsgSender, paramstore params.Subspace,
 	ak auth.AccountKeeper, authX types.ExpectedAuthXKeeper,
-	supplyKeeper     authtypes.SupplyKeeper) Keeper {
+	supplyKeeper     types.ExpectedSupplyKeeper) Keeper {
 
 	return Keeper{
 		paramSubspace: paramstore.WithKeyTable(types.ParamKeyTable()),
@@ -68,7 +68,12 @@ func (k Keeper) GetMarketsWithNewlyAddedOrder(ctx sdk.Context) []string {
 	return res
 }
 
+// QuerySeqWithAddr returns the sequence used by the orders of addr, which is set
+// by the ante handler if the tx uses a nonce lane of addr
 func (k Keeper) QuerySeqWithAddr(ctx sdk.Context, addr sdk.AccAddress) (uint64, sdk.Error) {
+	if seq, ok := dex.GetOrderSequence(ctx, addr); ok {
+		return seq, nil
+	}
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		return acc.GetSequence(), nil
@@ -164,6 +169,14 @@ func (k Keeper) GetRebateRatioBase(ctx sdk.Context) int64 {
 	return k.authX.GetRebateRatioBase(ctx)
 }
 
+func (k Keeper) CalcRebates(ctx sdk.Context, addr sdk.AccAddress, fee int64) authx.Rebates {
+	return k.authX.CalcRebates(ctx, addr, fee)
+}
+
+func (k Keeper) AddRebates(ctx sdk.Context, rebates authx.Rebates) {
+	k.authX.AddRebates(ctx, rebates)
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -194,6 +207,56 @@ func (k Keeper) GetAllOrders(ctx sdk.Context) []*types.Order {
 	return NewGlobalOrderKeeper(k.marketKey, k.cdc).GetAllOrders(ctx)
 }
 
+// GetExpiredOrders returns the GTE orders which expire at the current block's height or time
+func (k Keeper) GetExpiredOrders(ctx sdk.Context) []*types.Order {
+	return NewGlobalOrderKeeper(k.marketKey, k.cdc).GetExpiredOrders(ctx, ctx.BlockHeight(), ctx.BlockHeader().Time.Unix())
+}
+
+// RebaseHeights moves the recorded heights back by height, for a genesis which restarts from zero height.
+// The order heights are encoded in the store keys and can not be negative, so the orders are moved back
+// together until the oldest one is at zero, keeping their priorities, and their lifetimes are cut to end
+// at the same number of blocks after the restart. A halt ends no earlier than the first block.
+func (k Keeper) RebaseHeights(ctx sdk.Context, height int64) {
+	orders := k.GetAllOrders(ctx)
+	shift := height
+	for _, order := range orders {
+		if order.Height < shift {
+			shift = order.Height
+		}
+	}
+	for _, order := range orders {
+		ok := NewOrderKeeper(k.marketKey, order.TradingPair, k.cdc)
+		if err := ok.Remove(ctx, order); err != nil {
+			panic(err)
+		}
+		order.ExistBlocks -= height - shift
+		order.Height -= shift
+		if err := ok.Update(ctx, order); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, info := range k.GetAllMarketInfos(ctx) {
+		if !info.Halted || info.HaltEndHeight == 0 {
+			continue
+		}
+		k.clearHalt(ctx, info)
+		info.HaltEndHeight -= height
+		if info.HaltEndHeight < 1 {
+			info.HaltEndHeight = 1
+		}
+		k.ScheduleResume(ctx, info.GetSymbol(), info.HaltEndHeight)
+		if err := k.SetMarket(ctx, info); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, w := range k.GetAllPriceWindows(ctx) {
+		w.Window.StartHeight -= height
+		k.setPriceWindow(ctx, w.TradingPair, w.Window)
+	}
+}
+
 // -----------------------------------------------
 // market info
 
@@ -202,6 +265,7 @@ func (k Keeper) SetMarket(ctx sdk.Context, info types.MarketInfo) sdk.Error {
 }
 
 func (k Keeper) RemoveMarket(ctx sdk.Context, symbol string) sdk.Error {
+	k.clearCircuitBreaker(ctx, symbol)
 	return k.gmk.RemoveMarket(ctx, symbol)
 }
 
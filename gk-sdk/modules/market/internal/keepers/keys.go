@@ -4,4 +4,8 @@ var (
 	MarketIdentifierPrefix = []byte{0x15}
 	DelistKey              = []byte{0x40}
 	DelistRevKey           = []byte{0x42}
+	MiningRewardKeyPrefix  = []byte{0x50}
+	MiningPendingTotalKey  = []byte{0x51}
+	PriceWindowKeyPrefix   = []byte{0x60}
+	HaltEndKeyPrefix       = []byte{0x61}
 )
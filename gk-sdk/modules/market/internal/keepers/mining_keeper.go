@@ -0,0 +1,153 @@
+package keepers
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func miningRewardKey(addr sdk.AccAddress) []byte {
+	return dex.ConcatKeys(MiningRewardKeyPrefix, addr)
+}
+
+func getInt64(store sdk.KVStore, key []byte) int64 {
+	value := store.Get(key)
+	if len(value) == 0 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(value))
+}
+
+func setInt64(store sdk.KVStore, key []byte, n int64) {
+	if n == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, int64ToBigEndianBytes(n))
+}
+
+// GetMiningReward returns the accrued and unclaimed liquidity mining reward of addr
+func (k Keeper) GetMiningReward(ctx sdk.Context, addr sdk.AccAddress) int64 {
+	return getInt64(ctx.KVStore(k.marketKey), miningRewardKey(addr))
+}
+
+// GetPendingMiningRewards returns the sum of all the unclaimed liquidity mining rewards,
+// which must be covered by the balance of MiningPoolName
+func (k Keeper) GetPendingMiningRewards(ctx sdk.Context) int64 {
+	return getInt64(ctx.KVStore(k.marketKey), MiningPendingTotalKey)
+}
+
+func (k Keeper) addMiningReward(ctx sdk.Context, addr sdk.AccAddress, amount int64) {
+	store := ctx.KVStore(k.marketKey)
+	setInt64(store, miningRewardKey(addr), getInt64(store, miningRewardKey(addr))+amount)
+	setInt64(store, MiningPendingTotalKey, getInt64(store, MiningPendingTotalKey)+amount)
+}
+
+// SetMiningRewards restores the unclaimed liquidity mining rewards from genesis
+func (k Keeper) SetMiningRewards(ctx sdk.Context, rewards []types.MiningReward) {
+	for _, reward := range rewards {
+		k.addMiningReward(ctx, reward.Address, reward.Amount)
+	}
+}
+
+// GetAllMiningRewards returns all the unclaimed liquidity mining rewards, for dumping state
+func (k Keeper) GetAllMiningRewards(ctx sdk.Context) []types.MiningReward {
+	store := ctx.KVStore(k.marketKey)
+	iter := sdk.KVStorePrefixIterator(store, MiningRewardKeyPrefix)
+	defer iter.Close()
+	rewards := make([]types.MiningReward, 0)
+	for ; iter.Valid(); iter.Next() {
+		rewards = append(rewards, types.MiningReward{
+			Address: sdk.AccAddress(iter.Key()[len(MiningRewardKeyPrefix):]),
+			Amount:  int64(binary.BigEndian.Uint64(iter.Value())),
+		})
+	}
+	return rewards
+}
+
+// GetMiningPoolBalance returns the CET in MiningPoolName
+func (k Keeper) GetMiningPoolBalance(ctx sdk.Context) sdk.Int {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.MiningPoolName).GetCoins().AmountOf(dex.CET)
+}
+
+// ClaimMiningReward pays all the unclaimed liquidity mining reward of addr from MiningPoolName
+func (k Keeper) ClaimMiningReward(ctx sdk.Context, addr sdk.AccAddress) (int64, sdk.Error) {
+	amount := k.GetMiningReward(ctx, addr)
+	if amount <= 0 {
+		return 0, types.ErrNoMiningReward()
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.MiningPoolName, addr, dex.NewCetCoins(amount)); err != nil {
+		return 0, err
+	}
+	k.addMiningReward(ctx, addr, -amount)
+	return amount, nil
+}
+
+// AccrueMiningRewards scores the resting GTE orders of every mining market, and shares the reward
+// of the market among the makers pro rata. A market is skipped in the block if MiningPoolName
+// can not cover its reward on top of the unclaimed rewards, or if it has no executed price yet.
+func (k Keeper) AccrueMiningRewards(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if len(params.MiningMarkets) == 0 {
+		return
+	}
+	available := k.GetMiningPoolBalance(ctx).SubRaw(k.GetPendingMiningRewards(ctx))
+	for _, mm := range params.MiningMarkets {
+		if available.LT(sdk.NewInt(mm.RewardPerBlock)) {
+			continue
+		}
+		paid := k.accrueMarketMiningRewards(ctx, mm, params.MiningMaxSpread, params.MiningMaxRestingBlocks)
+		available = available.SubRaw(paid)
+	}
+}
+
+func (k Keeper) accrueMarketMiningRewards(ctx sdk.Context, mm types.MiningMarket, maxSpread, maxRestingBlocks int64) int64 {
+	info, err := k.GetMarketInfo(ctx, mm.TradingPair)
+	if err != nil || !info.LastExecutedPrice.IsPositive() {
+		return 0
+	}
+	if k.IsTokenForbidden(ctx, info.Stock) || k.IsTokenForbidden(ctx, info.Money) {
+		return 0
+	}
+	lastPrice := info.LastExecutedPrice
+	spread := lastPrice.MulInt64(maxSpread).QuoInt64(types.MiningSpreadBase)
+	orders := NewOrderKeeper(k.marketKey, mm.TradingPair, k.cdc).
+		GetOrdersInPriceRange(ctx, lastPrice.Sub(spread), lastPrice.Add(spread))
+
+	// the makers are kept in the order of the order book, to distribute deterministically
+	var makers []sdk.AccAddress
+	scores := make(map[string]sdk.Dec)
+	totalScore := sdk.ZeroDec()
+	for _, order := range orders {
+		if k.IsForbiddenByTokenIssuer(ctx, info.Stock, order.Sender) ||
+			k.IsForbiddenByTokenIssuer(ctx, info.Money, order.Sender) {
+			continue
+		}
+		score := types.CalcMiningScore(order, lastPrice, ctx.BlockHeight(), maxSpread, maxRestingBlocks)
+		if !score.IsPositive() {
+			continue
+		}
+		if _, ok := scores[string(order.Sender)]; !ok {
+			makers = append(makers, order.Sender)
+			scores[string(order.Sender)] = sdk.ZeroDec()
+		}
+		scores[string(order.Sender)] = scores[string(order.Sender)].Add(score)
+		totalScore = totalScore.Add(score)
+	}
+	if !totalScore.IsPositive() {
+		return 0
+	}
+
+	paid := int64(0)
+	for _, maker := range makers {
+		reward := scores[string(maker)].MulInt64(mm.RewardPerBlock).Quo(totalScore).TruncateInt64()
+		if reward > 0 {
+			k.addMiningReward(ctx, maker, reward)
+			paid += reward
+		}
+	}
+	return paid
+}
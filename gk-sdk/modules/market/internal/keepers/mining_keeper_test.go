@@ -0,0 +1,103 @@
+package keepers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/market"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	"github.com/coinexchain/cet-sdk/testapp"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func newMiningOrder(sender sdk.AccAddress, seq uint64, side byte, price string, leftStock, height int64, timeInForce int64) *types.Order {
+	return &types.Order{
+		Sender:      sender,
+		Sequence:    seq,
+		TradingPair: "abc/" + dex.CET,
+		OrderType:   types.LimitOrder,
+		Price:       sdk.MustNewDecFromStr(price),
+		Quantity:    leftStock,
+		LeftStock:   leftStock,
+		Side:        side,
+		TimeInForce: timeInForce,
+		Height:      height,
+	}
+}
+
+func TestLiquidityMining(t *testing.T) {
+	testApp := testapp.NewTestApp()
+	mk := testApp.MarketKeeper
+	ctx := testApp.NewCtx().WithBlockHeight(200)
+	symbol := "abc/" + dex.CET
+	alice := testutil.ToAccAddress("alice")
+	bob := testutil.ToAccAddress("bob")
+	carol := testutil.ToAccAddress("carol")
+
+	testApp.SupplyKeeper.SetSupply(ctx, supply.Supply{Total: sdk.Coins{}})
+	testApp.AssetKeeper.SetParams(ctx, asset.DefaultParams())
+	for _, symbol := range []string{"abc", dex.CET} {
+		require.Nil(t, testApp.AssetKeeper.IssueToken(ctx, symbol, symbol, sdk.NewInt(10e8), carol,
+			false, false, false, false, "", "", "123"))
+	}
+
+	params := types.DefaultParams()
+	params.MiningMarkets = []types.MiningMarket{{TradingPair: symbol, RewardPerBlock: 300}}
+	mk.SetParams(ctx, params)
+	require.Nil(t, mk.SetMarket(ctx, types.MarketInfo{Stock: "abc", Money: dex.CET, LastExecutedPrice: sdk.OneDec()}))
+
+	// alice rests long enough, but is 1% away from the last executed price: 100 * 0.5 * 1 = 50
+	// bob is at the last executed price, but rests half the blocks: 50 * 1 * 0.5 = 25
+	// the IOC order, the order just placed and the order out of the spread get nothing
+	orders := []*types.Order{
+		newMiningOrder(alice, 1, types.BUY, "0.99", 100, 100, types.GTE),
+		newMiningOrder(bob, 1, types.SELL, "1.0", 50, 150, types.GTE),
+		newMiningOrder(bob, 2, types.SELL, "1.0", 50, 150, types.IOC),
+		newMiningOrder(carol, 1, types.SELL, "1.0", 50, 200, types.GTE),
+		newMiningOrder(carol, 2, types.SELL, "1.05", 50, 100, types.GTE),
+	}
+	for _, order := range orders {
+		require.Nil(t, mk.SetOrder(ctx, order))
+	}
+	inRange := keepers.NewOrderKeeper(mk.GetMarketKey(), symbol, types.ModuleCdc).
+		GetOrdersInPriceRange(ctx, sdk.MustNewDecFromStr("0.98"), sdk.MustNewDecFromStr("1.02"))
+	require.Equal(t, 4, len(inRange))
+
+	// nothing accrues before the pool is funded
+	mk.AccrueMiningRewards(ctx)
+	require.Equal(t, int64(0), mk.GetPendingMiningRewards(ctx))
+
+	pool := testApp.SupplyKeeper.GetModuleAccount(ctx, market.MiningPoolName)
+	require.Nil(t, pool.SetCoins(dex.NewCetCoins(1000)))
+	testApp.SupplyKeeper.SetModuleAccount(ctx, pool)
+
+	mk.AccrueMiningRewards(ctx)
+	require.Equal(t, int64(200), mk.GetMiningReward(ctx, alice))
+	require.Equal(t, int64(100), mk.GetMiningReward(ctx, bob))
+	require.Equal(t, int64(0), mk.GetMiningReward(ctx, carol))
+	require.Equal(t, int64(300), mk.GetPendingMiningRewards(ctx))
+
+	// the fourth block is not covered by the pool
+	mk.AccrueMiningRewards(ctx)
+	mk.AccrueMiningRewards(ctx)
+	mk.AccrueMiningRewards(ctx)
+	require.Equal(t, int64(900), mk.GetPendingMiningRewards(ctx))
+
+	amount, err := mk.ClaimMiningReward(ctx, alice)
+	require.Nil(t, err)
+	require.Equal(t, int64(600), amount)
+	require.Equal(t, int64(600), testApp.AccountKeeper.GetAccount(ctx, alice).GetCoins().AmountOf(dex.CET).Int64())
+	require.Equal(t, int64(400), mk.GetMiningPoolBalance(ctx).Int64())
+	require.Equal(t, int64(300), mk.GetPendingMiningRewards(ctx))
+	_, err = mk.ClaimMiningReward(ctx, alice)
+	require.Equal(t, types.CodeNoMiningReward, err.Code())
+
+	require.Equal(t, []types.MiningReward{{Address: bob, Amount: 300}}, mk.GetAllMiningRewards(ctx))
+}
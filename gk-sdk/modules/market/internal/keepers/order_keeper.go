@@ -17,6 +17,8 @@ var (
 	BidListKeyPrefix       = []byte{0x12}
 	AskListKeyPrefix       = []byte{0x13}
 	OrderQueueKeyPrefix    = []byte{0x14}
+	ExpiryHeightKeyPrefix  = []byte{0x16}
+	ExpiryTimeKeyPrefix    = []byte{0x17}
 	NewlyAddedKeyPrefix    = []byte{0x66}
 	NewlyAddedKeyEnd       = []byte{0x67}
 	LastOrderCleanUpDayKey = []byte{0x20}
@@ -59,6 +61,7 @@ type OrderKeeper interface {
 	GetOlderThan(ctx sdk.Context, height int64) []*types.Order
 	GetOrdersAtHeight(ctx sdk.Context, height int64) []*types.Order
 	GetMatchingCandidates(ctx sdk.Context) []*types.Order
+	GetOrdersInPriceRange(ctx sdk.Context, lowPrice, highPrice sdk.Dec) []*types.Order
 	GetSymbol() string
 }
 
@@ -111,6 +114,15 @@ func (keeper *PersistentOrderKeeper) orderQueueKey(order *types.Order) []byte {
 	)
 }
 
+// build the keys for the global expiry queues, GTE orders expire at a height and optionally at a unix time
+func expiryHeightKey(order *types.Order) []byte {
+	return dex.ConcatKeys(ExpiryHeightKeyPrefix, int64ToBigEndianBytes(order.Height+order.ExistBlocks), []byte(order.OrderID()))
+}
+
+func expiryTimeKey(order *types.Order) []byte {
+	return dex.ConcatKeys(ExpiryTimeKeyPrefix, int64ToBigEndianBytes(order.ExpireTime), []byte(order.OrderID()))
+}
+
 func NewOrderKeeper(key sdk.StoreKey, symbol string, codec *codec.Codec) OrderKeeper {
 	return &PersistentOrderKeeper{
 		marketKey: key,
@@ -148,6 +160,14 @@ func (keeper *PersistentOrderKeeper) Update(ctx sdk.Context, order *types.Order)
 	key = keeper.orderQueueKey(order)
 	store.Set(key, []byte{})
 
+	// add it to the expiry queues
+	if order.TimeInForce == types.GTE {
+		store.Set(expiryHeightKey(order), []byte{})
+		if order.ExpireTime != 0 {
+			store.Set(expiryTimeKey(order), []byte{})
+		}
+	}
+
 	// add it to the local bidList and askList
 	if order.Side == types.BID {
 		key = keeper.bidListKey(order)
@@ -173,6 +193,14 @@ func (keeper *PersistentOrderKeeper) Remove(ctx sdk.Context, order *types.Order)
 	key = keeper.orderQueueKey(order)
 	store.Delete(key)
 
+	// remove it from the expiry queues
+	if order.TimeInForce == types.GTE {
+		store.Delete(expiryHeightKey(order))
+		if order.ExpireTime != 0 {
+			store.Delete(expiryTimeKey(order))
+		}
+	}
+
 	// remove it from the local bidList and askList
 	if order.Side == types.BID {
 		key = keeper.bidListKey(order)
@@ -308,6 +336,31 @@ func (keeper *PersistentOrderKeeper) GetMatchingCandidates(ctx sdk.Context) []*t
 	return result
 }
 
+// Return the bid orders and ask orders whose prices are in [lowPrice, highPrice].
+// Unlike GetMatchingCandidates, it leaves the newly-added mark of the order book untouched.
+func (keeper *PersistentOrderKeeper) GetOrdersInPriceRange(ctx sdk.Context, lowPrice, highPrice sdk.Dec) []*types.Order {
+	store := ctx.KVStore(keeper.marketKey)
+	orderIDStartPos := len(keeper.symbol) + 2 + types.DecByteCount
+	var orderIDList []string
+	for _, prefix := range [][]byte{BidListKeyPrefix, AskListKeyPrefix} {
+		start := dex.ConcatKeys(prefix, []byte(keeper.symbol), []byte{0x0}, types.DecToBigEndianBytes(lowPrice))
+		// order IDs are printable, so 0xFF is larger than all of them
+		end := dex.ConcatKeys(prefix, []byte(keeper.symbol), []byte{0x0}, types.DecToBigEndianBytes(highPrice), []byte{0xFF})
+		iter := store.Iterator(start, end)
+		for ; iter.Valid(); iter.Next() {
+			orderIDList = append(orderIDList, string(iter.Key()[orderIDStartPos:]))
+		}
+		iter.Close()
+	}
+	result := make([]*types.Order, 0, len(orderIDList))
+	for _, orderID := range orderIDList {
+		if order := keeper.getOrder(ctx, orderID); order != nil {
+			result = append(result, order)
+		}
+	}
+	return result
+}
+
 ////////////////////////////////////////////////
 
 // Global order keep can lookup a order, given its ID or the prefix of its ID, i.e. the sender's address
@@ -315,6 +368,7 @@ type GlobalOrderKeeper interface {
 	GetAllOrders(ctx sdk.Context) []*types.Order
 	QueryOrder(ctx sdk.Context, orderID string) *types.Order
 	GetOrdersFromUser(ctx sdk.Context, user string) []string
+	GetExpiredOrders(ctx sdk.Context, height, unixTime int64) []*types.Order
 }
 
 type PersistentGlobalOrderKeeper struct {
@@ -372,3 +426,35 @@ func (keeper *PersistentGlobalOrderKeeper) QueryOrder(ctx sdk.Context, orderID s
 	keeper.codec.MustUnmarshalBinaryBare(orderBytes, order)
 	return order
 }
+
+// Using the expiry queues, find the GTE orders which expire at or before a height or a unix time.
+// An order in both queues is only returned once.
+func (keeper *PersistentGlobalOrderKeeper) GetExpiredOrders(ctx sdk.Context, height, unixTime int64) []*types.Order {
+	store := ctx.KVStore(keeper.marketKey)
+	var result []*types.Order
+	seen := make(map[string]bool)
+	for _, queue := range []struct {
+		prefix []byte
+		upTo   int64
+	}{{ExpiryHeightKeyPrefix, height}, {ExpiryTimeKeyPrefix, unixTime}} {
+		if queue.upTo < 0 {
+			// a zero block time, which is only seen in tests
+			continue
+		}
+		start := dex.ConcatKeys(queue.prefix, int64ToBigEndianBytes(0))
+		end := dex.ConcatKeys(queue.prefix, int64ToBigEndianBytes(queue.upTo+1))
+		iter := store.Iterator(start, end)
+		for ; iter.Valid(); iter.Next() {
+			orderID := string(iter.Key()[len(end):])
+			if seen[orderID] {
+				continue
+			}
+			seen[orderID] = true
+			if order := keeper.QueryOrder(ctx, orderID); order != nil {
+				result = append(result, order)
+			}
+		}
+		iter.Close()
+	}
+	return result
+}
@@ -0,0 +1,117 @@
+package keepers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/market"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+	"github.com/coinexchain/cet-sdk/testapp"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func setupProposalTest(t *testing.T) (*testapp.TestApp, sdk.Context, govtypes.Handler) {
+	testApp := testapp.NewTestApp()
+	ctx := testApp.NewCtx().WithBlockHeight(100).WithBlockTime(time.Unix(1000, 0))
+	testApp.MarketKeeper.SetParams(ctx, types.DefaultParams())
+	owner := testutil.ToAccAddress("owner")
+	for _, symbol := range []string{"abc", dex.CET} {
+		token, err := asset.NewToken(symbol, symbol, sdk.NewInt(100000000), owner,
+			false, false, false, false, "", "", asset.TestIdentityString)
+		require.Nil(t, err)
+		require.Nil(t, testApp.AssetKeeper.SetToken(ctx, token))
+	}
+	return testApp, ctx, market.NewProposalHandler(testApp.MarketKeeper)
+}
+
+func TestListMarketProposal(t *testing.T) {
+	testApp, ctx, handler := setupProposalTest(t)
+	mk := testApp.MarketKeeper
+	symbol := "abc/" + dex.CET
+
+	require.Nil(t, handler(ctx, types.NewListMarketProposal("title", "desc", "abc", dex.CET, 8, 2)))
+	info, err := mk.GetMarketInfo(ctx, symbol)
+	require.Nil(t, err)
+	require.Equal(t, byte(8), info.PricePrecision)
+	require.Equal(t, byte(2), info.OrderPrecision)
+	require.False(t, info.Halted)
+
+	// the pair can not be listed twice
+	require.Equal(t, types.CodeRepeatTradingPair,
+		handler(ctx, types.NewListMarketProposal("title", "desc", "abc", dex.CET, 8, 2)).Code())
+	// nor without its tokens
+	require.Equal(t, types.CodeInvalidToken,
+		handler(ctx, types.NewListMarketProposal("title", "desc", "xyz", dex.CET, 8, 2)).Code())
+}
+
+func TestDelistMarketProposal(t *testing.T) {
+	testApp, ctx, handler := setupProposalTest(t)
+	mk := testApp.MarketKeeper
+	symbol := "abc/" + dex.CET
+	proposal := types.NewDelistMarketProposal("title", "desc", symbol)
+
+	require.Equal(t, types.CodeInvalidMarket, handler(ctx, proposal).Code())
+	require.Nil(t, handler(ctx, types.NewListMarketProposal("title", "desc", "abc", dex.CET, 8, 0)))
+
+	// the request takes effect after MarketMinExpiredTime, like one made by the owner
+	require.Nil(t, handler(ctx, proposal))
+	dlk := keepers.NewDelistKeeper(mk.GetMarketKey())
+	require.True(t, dlk.HasDelistRequest(ctx, symbol))
+	effectiveTime := ctx.BlockHeader().Time.UnixNano() + mk.GetParams(ctx).MarketMinExpiredTime
+	require.Empty(t, dlk.GetDelistSymbolsBeforeTime(ctx, effectiveTime-1))
+	require.Equal(t, []string{symbol}, dlk.GetDelistSymbolsBeforeTime(ctx, effectiveTime))
+
+	require.Equal(t, types.CodeDelistRequestExist, handler(ctx, proposal).Code())
+}
+
+func TestMarketFeeRateProposal(t *testing.T) {
+	testApp, ctx, handler := setupProposalTest(t)
+	mk := testApp.MarketKeeper
+	symbol := "abc/" + dex.CET
+	buyFeeRate, sellFeeRate := sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(5, 3)
+	proposal := types.NewMarketFeeRateProposal("title", "desc", symbol, buyFeeRate, sellFeeRate)
+
+	require.Equal(t, types.CodeInvalidMarket, handler(ctx, proposal).Code())
+	require.Nil(t, handler(ctx, types.NewListMarketProposal("title", "desc", "abc", dex.CET, 8, 0)))
+
+	// the fee rates set by the owner are overridden
+	info, _ := mk.GetMarketInfo(ctx, symbol)
+	info.BuyFeeRate, info.SellFeeRate = sdk.NewDecWithPrec(2, 3), sdk.NewDecWithPrec(2, 3)
+	require.Nil(t, mk.SetMarket(ctx, info))
+	require.Nil(t, handler(ctx, proposal))
+	info, _ = mk.GetMarketInfo(ctx, symbol)
+	require.Equal(t, buyFeeRate, info.BuyFeeRate)
+	require.Equal(t, sellFeeRate, info.SellFeeRate)
+}
+
+func TestMarketHaltProposal(t *testing.T) {
+	testApp, ctx, handler := setupProposalTest(t)
+	mk := testApp.MarketKeeper
+	symbol := "abc/" + dex.CET
+	halt := types.NewMarketHaltProposal("title", "desc", symbol, true)
+	resume := types.NewMarketHaltProposal("title", "desc", symbol, false)
+
+	require.Equal(t, types.CodeInvalidMarket, handler(ctx, halt).Code())
+	require.Nil(t, handler(ctx, types.NewListMarketProposal("title", "desc", "abc", dex.CET, 8, 0)))
+	require.Equal(t, types.CodeMarketNotHalted, handler(ctx, resume).Code())
+
+	// the halt lasts until it is resumed by another proposal
+	require.Nil(t, handler(ctx, halt))
+	require.Empty(t, mk.ResumeExpiredMarkets(ctx.WithBlockHeight(100000)))
+	info, _ := mk.GetMarketInfo(ctx, symbol)
+	require.True(t, info.IsHalted(100000))
+	require.Equal(t, types.HaltByProposal, info.HaltReason)
+
+	require.Nil(t, handler(ctx, resume))
+	info, _ = mk.GetMarketInfo(ctx, symbol)
+	require.False(t, info.Halted)
+	require.Empty(t, info.HaltReason)
+}
@@ -21,6 +21,7 @@ const (
 	QueryUserOrders        = "user-order-list"
 	QueryWaitCancelMarkets = "wait-cancel-markets"
 	QueryParameters        = "parameters"
+	QueryMiningReward      = "mining-reward"
 )
 
 // creates a querier for asset REST endpoints
@@ -41,6 +42,8 @@ func NewQuerier(mk Keeper) sdk.Querier {
 			return queryUserOrderList(ctx, req, mk)
 		case QueryWaitCancelMarkets:
 			return queryWaitCancelMarkets(ctx, req, mk)
+		case QueryMiningReward:
+			return queryMiningReward(ctx, req, mk)
 		default:
 			return nil, sdk.ErrUnknownRequest("query symbol : " + path[0])
 		}
@@ -148,6 +151,7 @@ type ResOrder struct {
 	ExistBlocks      int64          `json:"exist_blocks"`
 	FrozenFeatureFee int64          `json:"frozen_feature_fee"`   // DEX2
 	FrozenFee        int64          `json:"frozen_fee,omitempty"` // DEX2: -> frozen_commission
+	ExpireTime       int64          `json:"expire_time,omitempty"`
 
 	// These fields will change when order was filled/canceled.
 	LeftStock int64 `json:"left_stock"`
@@ -173,6 +177,7 @@ func convertResOrderFromOrder(order *types.Order) *ResOrder {
 		ExistBlocks:      order.ExistBlocks,
 		FrozenFeatureFee: order.FrozenFeatureFee,
 		FrozenFee:        order.FrozenFee,
+		ExpireTime:       order.ExpireTime,
 		LeftStock:        order.LeftStock,
 		Freeze:           order.Freeze,
 		DealStock:        order.DealStock,
@@ -269,3 +274,24 @@ func queryWaitCancelMarkets(ctx sdk.Context, req abci.RequestQuery, mk Keeper) (
 	}
 	return bz, nil
 }
+
+type QueryMiningRewardParam struct {
+	Address sdk.AccAddress
+}
+
+func queryMiningReward(ctx sdk.Context, req abci.RequestQuery, mk Keeper) ([]byte, sdk.Error) {
+	var param QueryMiningRewardParam
+	if err := mk.cdc.UnmarshalJSON(req.Data, &param); err != nil {
+		return nil, types.ErrFailedParseParam()
+	}
+
+	reward := types.MiningReward{
+		Address: param.Address,
+		Amount:  mk.GetMiningReward(ctx, param.Address),
+	}
+	bz, err := codec.MarshalJSONIndent(mk.cdc, reward)
+	if err != nil {
+		return nil, types.ErrFailedMarshal()
+	}
+	return bz, nil
+}
@@ -20,4 +20,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCancelTradingPair{}, "market/MsgCancelTradingPair", nil)
 	cdc.RegisterConcrete(MsgModifyPricePrecision{}, "market/MsgModifyPricePrecision", nil)
 	cdc.RegisterConcrete(MsgModifyFeeRate{}, "market/MsgModifyFeeRate", nil)
+	cdc.RegisterConcrete(MsgClaimMiningReward{}, "market/MsgClaimMiningReward", nil)
+	cdc.RegisterConcrete(MsgHaltMarket{}, "market/MsgHaltMarket", nil)
+	cdc.RegisterConcrete(MsgResumeMarket{}, "market/MsgResumeMarket", nil)
+	cdc.RegisterConcrete(ListMarketProposal{}, "market/ListMarketProposal", nil)
+	cdc.RegisterConcrete(DelistMarketProposal{}, "market/DelistMarketProposal", nil)
+	cdc.RegisterConcrete(MarketFeeRateProposal{}, "market/MarketFeeRateProposal", nil)
+	cdc.RegisterConcrete(MarketHaltProposal{}, "market/MarketHaltProposal", nil)
 }
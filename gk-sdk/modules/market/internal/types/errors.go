@@ -37,6 +37,12 @@ const (
 	CodeOrderAlreadyExist      sdk.CodeType = 630
 	CodeDelistRequestExist     sdk.CodeType = 632
 	CodeInvalidMarket          sdk.CodeType = 633
+	CodeNoMiningReward         sdk.CodeType = 634
+	CodeInvalidMarketProposal  sdk.CodeType = 635
+	CodeMarketHalted           sdk.CodeType = 636
+	CodeMarketNotHalted        sdk.CodeType = 637
+	CodeInvalidExpireTime      sdk.CodeType = 638
+	CodeNotHaltedByOwner       sdk.CodeType = 639
 )
 
 func ErrFailedParseParam() sdk.Error {
@@ -51,6 +57,10 @@ func ErrInvalidExistBlocks(eb int64) sdk.Error {
 	return sdk.NewError(CodeSpaceMarket, CodeInvalidExistBlocks, fmt.Sprintf("Invalid existence time : %d; The range of expected values [0, +∞] ", eb))
 }
 
+func ErrInvalidExpireTime(et int64) sdk.Error {
+	return sdk.NewError(CodeSpaceMarket, CodeInvalidExpireTime, "Invalid expire time : %d; It must be zero or later than the current block time", et)
+}
+
 func ErrInvalidTimeInForce(tif int64) sdk.Error {
 	return sdk.NewError(CodeSpaceMarket, CodeInvalidTimeInForce, fmt.Sprintf("Invalid timeInForce : %d; The valid value : 3, 4", tif))
 }
@@ -162,3 +172,24 @@ func ErrOrderAmountTooSmall(err string) sdk.Error {
 func ErrDelistRequestExist(market string) sdk.Error {
 	return sdk.NewError(CodeSpaceMarket, CodeDelistRequestExist, "The delist request for %s already exists", market)
 }
+
+func ErrNoMiningReward() sdk.Error {
+	return sdk.NewError(CodeSpaceMarket, CodeNoMiningReward, "No liquidity mining reward to claim")
+}
+
+func ErrInvalidMarketProposal(reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceMarket, CodeInvalidMarketProposal, "Invalid market proposal : %s", reason)
+}
+
+func ErrMarketHalted(market string) sdk.Error {
+	return sdk.NewError(CodeSpaceMarket, CodeMarketHalted, "The market %s is already halted", market)
+}
+
+func ErrMarketNotHalted(market string) sdk.Error {
+	return sdk.NewError(CodeSpaceMarket, CodeMarketNotHalted, "The market %s is not halted", market)
+}
+
+func ErrNotHaltedByOwner(market, reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceMarket, CodeNotHaltedByOwner,
+		"The market %s is halted by %s, its owner can not resume it", market, reason)
+}
@@ -2,8 +2,10 @@ package types
 
 import (
 	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/authx"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 type Keeper interface {
@@ -39,4 +41,12 @@ type ExpectedAuthXKeeper interface {
 	GetRefereeAddr(ctx sdk.Context, accAddr sdk.AccAddress) sdk.AccAddress
 	GetRebateRatio(ctx sdk.Context) int64
 	GetRebateRatioBase(ctx sdk.Context) int64
+	CalcRebates(ctx sdk.Context, addr sdk.AccAddress, fee int64) authx.Rebates
+	AddRebates(ctx sdk.Context, rebates authx.Rebates)
+}
+
+// Supply Keeper will implement the interface, to pay the liquidity mining rewards from MiningPoolName
+type ExpectedSupplyKeeper interface {
+	authtypes.SupplyKeeper
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 }
@@ -16,6 +16,13 @@ type MarketInfo struct {
 	OrderPrecision    byte    `json:"order_precision"`
 	BuyFeeRate    sdk.Dec    `json:"buy_fee_rate"`
 	SellFeeRate    sdk.Dec    `json:"sell_fee_rate"`
+
+	// a halted market accepts and cancels orders but does not match them,
+	// HaltEndHeight is zero when it stays halted until resumed manually,
+	// HaltReason is one of HaltByOwner, HaltByProposal and HaltByCircuitBreaker
+	Halted        bool   `json:"halted"`
+	HaltEndHeight int64  `json:"halt_end_height"`
+	HaltReason    string `json:"halt_reason"`
 }
 
 func GetGranularityOfOrder(orderPrecision byte) int64 {
@@ -28,3 +35,8 @@ func GetGranularityOfOrder(orderPrecision byte) int64 {
 func (msg MarketInfo) GetSymbol() string {
 	return dex.GetSymbol(msg.Stock, msg.Money)
 }
+
+// IsHalted returns whether the market is halted at height
+func (msg MarketInfo) IsHalted(height int64) bool {
+	return msg.Halted && (msg.HaltEndHeight == 0 || height < msg.HaltEndHeight)
+}
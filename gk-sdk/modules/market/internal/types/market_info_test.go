@@ -24,3 +24,15 @@ func TestMarketInfo(t *testing.T) {
 	}
 	require.EqualValues(t, "abc/cet", msg.GetSymbol())
 }
+
+func TestMarketInfoIsHalted(t *testing.T) {
+	info := MarketInfo{Stock: "abc", Money: "cet"}
+	require.False(t, info.IsHalted(100))
+
+	info.Halted = true
+	require.True(t, info.IsHalted(100))
+
+	info.HaltEndHeight = 120
+	require.True(t, info.IsHalted(119))
+	require.False(t, info.IsHalted(120))
+}
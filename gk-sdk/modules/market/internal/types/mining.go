@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MiningPoolName is the module account which pays the liquidity mining rewards.
+	// It is funded by the community pool spend proposals which pay to its module address.
+	MiningPoolName = "market_mining"
+
+	// MiningSpreadBase is the denominator of MiningMaxSpread
+	MiningSpreadBase = 10000
+)
+
+// MiningMarket is a market whose resting GTE orders share RewardPerBlock CET in every block
+type MiningMarket struct {
+	TradingPair    string `json:"trading_pair"`
+	RewardPerBlock int64  `json:"reward_per_block"`
+}
+
+func (mm MiningMarket) String() string {
+	return fmt.Sprintf("%s:%d", mm.TradingPair, mm.RewardPerBlock)
+}
+
+// MiningReward is the accrued and unclaimed liquidity mining reward of a maker
+type MiningReward struct {
+	Address sdk.AccAddress `json:"address"`
+	Amount  int64          `json:"amount"`
+}
+
+func checkMiningMarkets(markets []MiningMarket) error {
+	symbols := make(map[string]struct{}, len(markets))
+	for _, mm := range markets {
+		if !IsValidTradingPair(strings.Split(mm.TradingPair, SymbolSeparator)) {
+			return fmt.Errorf("invalid trading pair of mining market: %s", mm.TradingPair)
+		}
+		if _, ok := symbols[mm.TradingPair]; ok {
+			return fmt.Errorf("duplicate mining market: %s", mm.TradingPair)
+		}
+		symbols[mm.TradingPair] = struct{}{}
+		if mm.RewardPerBlock <= 0 {
+			return fmt.Errorf("reward per block of mining market %s must be positive, is %d",
+				mm.TradingPair, mm.RewardPerBlock)
+		}
+	}
+	return nil
+}
+
+// CalcMiningScore scores a resting GTE order by its size, its distance from the last executed price
+// and the blocks it has rested. The score decreases linearly to zero as the distance grows to
+// maxSpread/MiningSpreadBase, and increases linearly until the order has rested for maxRestingBlocks.
+func CalcMiningScore(order *Order, lastPrice sdk.Dec, height, maxSpread, maxRestingBlocks int64) sdk.Dec {
+	if order.TimeInForce != GTE || order.LeftStock <= 0 || !lastPrice.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	resting := height - order.Height
+	if resting <= 0 {
+		return sdk.ZeroDec()
+	}
+	if resting > maxRestingBlocks {
+		resting = maxRestingBlocks
+	}
+	spread := sdk.NewDec(maxSpread)
+	distance := order.Price.Sub(lastPrice).Abs().MulInt64(MiningSpreadBase).Quo(lastPrice)
+	if distance.GTE(spread) {
+		return sdk.ZeroDec()
+	}
+	proximity := spread.Sub(distance).Quo(spread)
+	return sdk.NewDec(order.LeftStock).Mul(proximity).MulInt64(resting).QuoInt64(maxRestingBlocks)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCalcMiningScore(t *testing.T) {
+	lastPrice := sdk.NewDec(100)
+	order := &Order{
+		Price:       sdk.MustNewDecFromStr("99.5"),
+		Quantity:    100,
+		LeftStock:   100,
+		Side:        BUY,
+		TimeInForce: GTE,
+		Height:      10,
+	}
+	// 50 basis points away with a max spread of 100, rested 20 out of 40 blocks
+	require.Equal(t, sdk.NewDec(25), CalcMiningScore(order, lastPrice, 30, 100, 40))
+	// rested longer than the max
+	require.Equal(t, sdk.NewDec(50), CalcMiningScore(order, lastPrice, 100, 100, 40))
+	// just placed, out of the spread, or no executed price yet
+	require.True(t, CalcMiningScore(order, lastPrice, 10, 100, 40).IsZero())
+	require.True(t, CalcMiningScore(order, lastPrice, 30, 50, 40).IsZero())
+	require.True(t, CalcMiningScore(order, sdk.ZeroDec(), 30, 100, 40).IsZero())
+
+	order.TimeInForce = IOC
+	require.True(t, CalcMiningScore(order, lastPrice, 30, 100, 40).IsZero())
+}
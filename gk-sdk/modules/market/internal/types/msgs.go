@@ -19,6 +19,15 @@ const (
 	CreateOrderInfoKey  = "create_order_info"
 	FillOrderInfoKey    = "fill_order_info"
 	CancelOrderInfoKey  = "del_order_info"
+	MarketHaltInfoKey   = "market_halt_info"
+)
+
+// reasons of halting and resuming markets
+const (
+	HaltByCircuitBreaker = "circuit_breaker"
+	HaltByOwner          = "owner"
+	HaltByProposal       = "proposal"
+	HaltExpired          = "expired"
 )
 
 // cancel order of reasons
@@ -113,6 +122,8 @@ type MsgCreateOrder struct {
 	Side           byte           `json:"side"`
 	TimeInForce    int64          `json:"time_in_force"`
 	ExistBlocks    int64          `json:"exist_blocks"`
+	// a GTE order also expires at this unix time in seconds, unless it is zero
+	ExpireTime int64 `json:"expire_time,omitempty"`
 }
 
 func (msg *MsgCreateOrder) SetAccAddress(address sdk.AccAddress) {
@@ -151,6 +162,9 @@ func (msg MsgCreateOrder) ValidateBasic() sdk.Error {
 	if msg.ExistBlocks < 0 {
 		return ErrInvalidExistBlocks(msg.ExistBlocks)
 	}
+	if msg.ExpireTime < 0 {
+		return ErrInvalidExpireTime(msg.ExpireTime)
+	}
 
 	return nil
 }
@@ -356,3 +370,119 @@ func (msg MsgModifyFeeRate) GetSignBytes() []byte {
 func (msg MsgModifyFeeRate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
+
+// MsgClaimMiningReward claims all the accrued liquidity mining rewards of the sender
+type MsgClaimMiningReward struct {
+	Sender sdk.AccAddress `json:"sender"`
+}
+
+func NewMsgClaimMiningReward(sender sdk.AccAddress) MsgClaimMiningReward {
+	return MsgClaimMiningReward{Sender: sender}
+}
+
+func (msg *MsgClaimMiningReward) SetAccAddress(address sdk.AccAddress) {
+	msg.Sender = address
+}
+
+func (msg MsgClaimMiningReward) Route() string {
+	return RouterKey
+}
+
+func (msg MsgClaimMiningReward) Type() string {
+	return "claim_mining_reward"
+}
+
+func (msg MsgClaimMiningReward) ValidateBasic() sdk.Error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return ErrInvalidAddress()
+	}
+	return nil
+}
+
+func (msg MsgClaimMiningReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgClaimMiningReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgHaltMarket halts the matching of a market until it is resumed, only the stock owner can send it
+type MsgHaltMarket struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	TradingPair string         `json:"trading_pair"`
+}
+
+func NewMsgHaltMarket(sender sdk.AccAddress, tradingPair string) MsgHaltMarket {
+	return MsgHaltMarket{Sender: sender, TradingPair: tradingPair}
+}
+
+func (msg *MsgHaltMarket) SetAccAddress(address sdk.AccAddress) {
+	msg.Sender = address
+}
+
+func (msg MsgHaltMarket) Route() string {
+	return RouterKey
+}
+
+func (msg MsgHaltMarket) Type() string {
+	return "halt_market"
+}
+
+func (msg MsgHaltMarket) ValidateBasic() sdk.Error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return ErrInvalidAddress()
+	}
+	if !IsValidTradingPair(strings.Split(msg.TradingPair, SymbolSeparator)) {
+		return ErrInvalidSymbol()
+	}
+	return nil
+}
+
+func (msg MsgHaltMarket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgHaltMarket) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgResumeMarket resumes the matching of a market halted by MsgHaltMarket, only the stock owner can send it
+type MsgResumeMarket struct {
+	Sender      sdk.AccAddress `json:"sender"`
+	TradingPair string         `json:"trading_pair"`
+}
+
+func NewMsgResumeMarket(sender sdk.AccAddress, tradingPair string) MsgResumeMarket {
+	return MsgResumeMarket{Sender: sender, TradingPair: tradingPair}
+}
+
+func (msg *MsgResumeMarket) SetAccAddress(address sdk.AccAddress) {
+	msg.Sender = address
+}
+
+func (msg MsgResumeMarket) Route() string {
+	return RouterKey
+}
+
+func (msg MsgResumeMarket) Type() string {
+	return "resume_market"
+}
+
+func (msg MsgResumeMarket) ValidateBasic() sdk.Error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return ErrInvalidAddress()
+	}
+	if !IsValidTradingPair(strings.Split(msg.TradingPair, SymbolSeparator)) {
+		return ErrInvalidSymbol()
+	}
+	return nil
+}
+
+func (msg MsgResumeMarket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgResumeMarket) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
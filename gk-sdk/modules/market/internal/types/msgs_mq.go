@@ -2,8 +2,20 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/msgqueue"
 )
 
+func init() {
+	msgqueue.RegisterSchema(CreateMarketInfoKey, 1, CreateMarketInfo{})
+	msgqueue.RegisterSchema(CancelMarketInfoKey, 1, CancelMarketInfo{})
+	msgqueue.RegisterSchema(CreateOrderInfoKey, 1, CreateOrderInfo{})
+	msgqueue.RegisterSchema(FillOrderInfoKey, 1, FillOrderInfo{})
+	msgqueue.RegisterSchema(CancelOrderInfoKey, 1, CancelOrderInfo{})
+	msgqueue.RegisterSchema(MarketHaltInfoKey, 1, MarketHaltInfo{})
+}
+
 type CreateMarketInfo struct {
 	Stock          string `json:"stock"`
 	Money          string `json:"money"`
@@ -23,6 +35,15 @@ type CancelMarketInfo struct {
 	DelTime int64  `json:"del_time"`
 }
 
+// MarketHaltInfo notifies that a market halted or resumed matching
+type MarketHaltInfo struct {
+	TradingPair   string `json:"trading_pair"`
+	Halted        bool   `json:"halted"`
+	Height        int64  `json:"height"`
+	HaltEndHeight int64  `json:"halt_end_height"`
+	Reason        string `json:"reason"`
+}
+
 type CreateOrderInfo struct {
 	OrderID          string  `json:"order_id"`
 	Sender           string  `json:"sender"`
@@ -37,6 +58,8 @@ type CreateOrderInfo struct {
 	FrozenFeatureFee int64   `json:"frozen_feature_fee"`
 	Freeze           int64   `json:"freeze"`
 	FeeRate          sdk.Dec  `json:"fee_rate"`
+	ExistBlocks      int64   `json:"exist_blocks"`
+	ExpireTime       int64   `json:"expire_time"`
 }
 
 type FillOrderInfo struct {
@@ -81,6 +104,9 @@ type CancelOrderInfo struct {
 	RemainAmount      int64  `json:"remain_amount"`
 	DealStock         int64  `json:"deal_stock"`
 	DealMoney         int64  `json:"deal_money"`
+
+	// rebates of all the referral levels, RebateAmount is their sum
+	Rebates authx.Rebates `json:"rebates,omitempty"`
 }
 
 type ModifyPricePrecisionInfo struct {
@@ -74,8 +74,14 @@ func TestMsgCreateOrder(t *testing.T) {
 	err = msg.ValidateBasic()
 	require.EqualValues(t, CodeInvalidExistBlocks, err.Code())
 
-	// Success
+	// Invalid expire time
 	msg.ExistBlocks = 10000
+	msg.ExpireTime = -1
+	err = msg.ValidateBasic()
+	require.EqualValues(t, CodeInvalidExpireTime, err.Code())
+
+	// Success
+	msg.ExpireTime = 0
 	err = msg.ValidateBasic()
 	require.EqualValues(t, nil, err)
 }
@@ -227,3 +233,16 @@ func TestMsgModifyPricePrecision(t *testing.T) {
 	err = msg.ValidateBasic()
 	require.EqualValues(t, ErrInvalidPricePrecision(msg.PricePrecision), err)
 }
+
+func TestMsgHaltAndResumeMarket(t *testing.T) {
+	addr, failed := sdk.AccAddressFromHex("0123456789012345678901234567890123423456")
+	require.Nil(t, failed)
+
+	require.Nil(t, NewMsgHaltMarket(addr, "abc/cet").ValidateBasic())
+	require.EqualValues(t, ErrInvalidAddress(), NewMsgHaltMarket([]byte("superman"), "abc/cet").ValidateBasic())
+	require.EqualValues(t, ErrInvalidSymbol(), NewMsgHaltMarket(addr, "abc-cet").ValidateBasic())
+
+	require.Nil(t, NewMsgResumeMarket(addr, "abc/cet").ValidateBasic())
+	require.EqualValues(t, ErrInvalidAddress(), NewMsgResumeMarket([]byte("superman"), "abc/cet").ValidateBasic())
+	require.EqualValues(t, ErrInvalidSymbol(), NewMsgResumeMarket(addr, "abc/3cet").ValidateBasic())
+}
@@ -31,6 +31,8 @@ type Order struct {
 	DealStock int64 `json:"deal_stock"`
 	DealMoney int64 `json:"deal_money"`
 	FeeRate            sdk.Dec        `json:"fee_rate"`
+	// the unix time in seconds after which a GTE order expires, zero means it only expires by ExistBlocks
+	ExpireTime int64 `json:"expire_time,omitempty"`
 }
 
 func (or *Order) OrderID() string {
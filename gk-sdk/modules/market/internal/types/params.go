@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -18,6 +19,11 @@ const (
 	DefaultMarketFeeMin                = 0
 	DefaultFeeForZeroDeal              = 0
 	DefaultMarketMinExpiredTime        = 1 * time.Minute
+	DefaultMiningMaxSpread             = 200
+	DefaultMiningMaxRestingBlocks      = 100
+	DefaultCircuitBreakerRatio         = 50
+	DefaultCircuitBreakerWindow        = 100
+	DefaultCircuitBreakerHaltBlocks    = 600
 )
 
 var (
@@ -29,6 +35,12 @@ var (
 	KeyMarketFeeRate               = []byte("MarketFeeRate")
 	KeyMarketFeeMin                = []byte("MarketFeeMin")
 	KeyFeeForZeroDeal              = []byte("FeeForZeroDeal")
+	KeyMiningMarkets               = []byte("MiningMarkets")
+	KeyMiningMaxSpread             = []byte("MiningMaxSpread")
+	KeyMiningMaxRestingBlocks      = []byte("MiningMaxRestingBlocks")
+	KeyCircuitBreakerRatio         = []byte("CircuitBreakerRatio")
+	KeyCircuitBreakerWindow        = []byte("CircuitBreakerWindow")
+	KeyCircuitBreakerHaltBlocks    = []byte("CircuitBreakerHaltBlocks")
 )
 
 type Params struct {
@@ -40,6 +52,17 @@ type Params struct {
 	MarketFeeRate               int64 `json:"market_fee_rate"`
 	MarketFeeMin                int64 `json:"market_fee_min"`
 	FeeForZeroDeal              int64 `json:"fee_for_zero_deal"`
+
+	// liquidity mining
+	MiningMarkets          []MiningMarket `json:"mining_markets"`
+	MiningMaxSpread        int64          `json:"mining_max_spread"` // in 1/MiningSpreadBase of the last executed price
+	MiningMaxRestingBlocks int64          `json:"mining_max_resting_blocks"`
+
+	// circuit breaker, a market halts for CircuitBreakerHaltBlocks when its price moves more than
+	// CircuitBreakerRatio percent within CircuitBreakerWindow blocks, a zero ratio turns it off
+	CircuitBreakerRatio      int64 `json:"circuit_breaker_ratio"`
+	CircuitBreakerWindow     int64 `json:"circuit_breaker_window"`
+	CircuitBreakerHaltBlocks int64 `json:"circuit_breaker_halt_blocks"`
 }
 
 // ParamKeyTable for market module
@@ -58,6 +81,12 @@ func DefaultParams() Params {
 		DefaultMarketFeeRate,
 		DefaultMarketFeeMin,
 		DefaultFeeForZeroDeal,
+		nil,
+		DefaultMiningMaxSpread,
+		DefaultMiningMaxRestingBlocks,
+		DefaultCircuitBreakerRatio,
+		DefaultCircuitBreakerWindow,
+		DefaultCircuitBreakerHaltBlocks,
 	}
 }
 
@@ -74,6 +103,12 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMarketFeeRate, Value: &p.MarketFeeRate},
 		{Key: KeyMarketFeeMin, Value: &p.MarketFeeMin},
 		{Key: KeyFeeForZeroDeal, Value: &p.FeeForZeroDeal},
+		{Key: KeyMiningMarkets, Value: &p.MiningMarkets},
+		{Key: KeyMiningMaxSpread, Value: &p.MiningMaxSpread},
+		{Key: KeyMiningMaxRestingBlocks, Value: &p.MiningMaxRestingBlocks},
+		{Key: KeyCircuitBreakerRatio, Value: &p.CircuitBreakerRatio},
+		{Key: KeyCircuitBreakerWindow, Value: &p.CircuitBreakerWindow},
+		{Key: KeyCircuitBreakerHaltBlocks, Value: &p.CircuitBreakerHaltBlocks},
 	}
 }
 
@@ -94,7 +129,29 @@ func (p *Params) ValidateGenesis() error {
 			p.MarketFeeRate, p.MarketFeeMin, p.FeeForZeroDeal, p.GTEOrderLifetime,
 			p.GTEOrderFeatureFeeByBlocks)
 	}
-	return nil
+	if p.MiningMaxSpread <= 0 || p.MiningMaxSpread > MiningSpreadBase {
+		return fmt.Errorf("%s must be in (0, %d], is %d", KeyMiningMaxSpread, MiningSpreadBase, p.MiningMaxSpread)
+	}
+	if p.MiningMaxRestingBlocks <= 0 {
+		return fmt.Errorf("%s must be a positive number, is %d", KeyMiningMaxRestingBlocks, p.MiningMaxRestingBlocks)
+	}
+	if p.CircuitBreakerRatio < 0 {
+		return fmt.Errorf("%s must not be negative, is %d", KeyCircuitBreakerRatio, p.CircuitBreakerRatio)
+	}
+	if p.CircuitBreakerWindow <= 0 {
+		return fmt.Errorf("%s must be a positive number, is %d", KeyCircuitBreakerWindow, p.CircuitBreakerWindow)
+	}
+	if p.CircuitBreakerHaltBlocks <= 0 {
+		return fmt.Errorf("%s must be a positive number, is %d", KeyCircuitBreakerHaltBlocks, p.CircuitBreakerHaltBlocks)
+	}
+	return checkMiningMarkets(p.MiningMarkets)
+}
+
+// ValidateParams validates the market params in the subspace
+func ValidateParams(ctx sdk.Context, ss params.Subspace) error {
+	var p Params
+	ss.GetParamSet(ctx, &p)
+	return p.ValidateGenesis()
 }
 
 // Equal returns a boolean determining if two Params types are identical.
@@ -113,7 +170,13 @@ func (p Params) String() string {
   MaxExecutedPriceChangeRatio: %d
   MarketFeeRate:               %d
   MarketFeeMin:                %d
-  FeeForZeroDeal:              %d`,
+  FeeForZeroDeal:              %d
+  MiningMarkets:               %v
+  MiningMaxSpread:             %d
+  MiningMaxRestingBlocks:      %d
+  CircuitBreakerRatio:         %d
+  CircuitBreakerWindow:        %d
+  CircuitBreakerHaltBlocks:    %d`,
 		p.CreateMarketFee,
 		p.MarketMinExpiredTime,
 		p.GTEOrderLifetime,
@@ -121,5 +184,11 @@ func (p Params) String() string {
 		p.MaxExecutedPriceChangeRatio,
 		p.MarketFeeRate,
 		p.MarketFeeMin,
-		p.FeeForZeroDeal)
+		p.FeeForZeroDeal,
+		p.MiningMarkets,
+		p.MiningMaxSpread,
+		p.MiningMaxRestingBlocks,
+		p.CircuitBreakerRatio,
+		p.CircuitBreakerWindow,
+		p.CircuitBreakerHaltBlocks)
 }
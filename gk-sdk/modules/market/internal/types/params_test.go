@@ -16,6 +16,12 @@ func TestParams(t *testing.T) {
 		MarketFeeRate:               100,
 		MarketFeeMin:                100,
 		FeeForZeroDeal:              100,
+		MiningMarkets:               []MiningMarket{{TradingPair: "abc/cet", RewardPerBlock: 100}},
+		MiningMaxSpread:             100,
+		MiningMaxRestingBlocks:      100,
+		CircuitBreakerRatio:         0,
+		CircuitBreakerWindow:        100,
+		CircuitBreakerHaltBlocks:    100,
 	}
 	require.Equal(t, nil, params.ValidateGenesis())
 	params1 := params
@@ -39,4 +45,25 @@ func TestParams(t *testing.T) {
 	params1 = params
 	params1.FeeForZeroDeal = -1
 	require.NotNil(t, params1.ValidateGenesis())
+	params1 = params
+	params1.MiningMaxSpread = MiningSpreadBase + 1
+	require.NotNil(t, params1.ValidateGenesis())
+	params1 = params
+	params1.MiningMaxRestingBlocks = 0
+	require.NotNil(t, params1.ValidateGenesis())
+	params1 = params
+	params1.MiningMarkets = []MiningMarket{{TradingPair: "abc/cet", RewardPerBlock: 0}}
+	require.NotNil(t, params1.ValidateGenesis())
+	params1 = params
+	params1.MiningMarkets = []MiningMarket{{TradingPair: "abc/cet", RewardPerBlock: 1}, {TradingPair: "abc/cet", RewardPerBlock: 2}}
+	require.NotNil(t, params1.ValidateGenesis())
+	params1 = params
+	params1.CircuitBreakerRatio = -1
+	require.NotNil(t, params1.ValidateGenesis())
+	params1 = params
+	params1.CircuitBreakerWindow = 0
+	require.NotNil(t, params1.ValidateGenesis())
+	params1 = params
+	params1.CircuitBreakerHaltBlocks = 0
+	require.NotNil(t, params1.ValidateGenesis())
 }
@@ -0,0 +1,264 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+const (
+	// ProposalTypeListMarket defines the type for a ListMarketProposal
+	ProposalTypeListMarket = "ListMarket"
+	// ProposalTypeDelistMarket defines the type for a DelistMarketProposal
+	ProposalTypeDelistMarket = "DelistMarket"
+	// ProposalTypeMarketFeeRate defines the type for a MarketFeeRateProposal
+	ProposalTypeMarketFeeRate = "MarketFeeRate"
+	// ProposalTypeMarketHalt defines the type for a MarketHaltProposal
+	ProposalTypeMarketHalt = "MarketHalt"
+)
+
+// Assert the market proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = ListMarketProposal{}
+	_ govtypes.Content = DelistMarketProposal{}
+	_ govtypes.Content = MarketFeeRateProposal{}
+	_ govtypes.Content = MarketHaltProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeListMarket)
+	govtypes.RegisterProposalTypeCodec(ListMarketProposal{}, "market/ListMarketProposal")
+	govtypes.RegisterProposalType(ProposalTypeDelistMarket)
+	govtypes.RegisterProposalTypeCodec(DelistMarketProposal{}, "market/DelistMarketProposal")
+	govtypes.RegisterProposalType(ProposalTypeMarketFeeRate)
+	govtypes.RegisterProposalTypeCodec(MarketFeeRateProposal{}, "market/MarketFeeRateProposal")
+	govtypes.RegisterProposalType(ProposalTypeMarketHalt)
+	govtypes.RegisterProposalTypeCodec(MarketHaltProposal{}, "market/MarketHaltProposal")
+}
+
+// ListMarketProposal creates a CET trading pair for a token without its issuer,
+// the CreateMarketFee is not charged
+type ListMarketProposal struct {
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	Stock          string `json:"stock"`
+	Money          string `json:"money"`
+	PricePrecision byte   `json:"price_precision"`
+	OrderPrecision byte   `json:"order_precision"`
+}
+
+func NewListMarketProposal(title, description, stock, money string, pricePrecision, orderPrecision byte) ListMarketProposal {
+	return ListMarketProposal{
+		Title:          title,
+		Description:    description,
+		Stock:          stock,
+		Money:          money,
+		PricePrecision: pricePrecision,
+		OrderPrecision: orderPrecision,
+	}
+}
+
+// GetTitle returns the title of a list market proposal.
+func (p ListMarketProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a list market proposal.
+func (p ListMarketProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a list market proposal.
+func (p ListMarketProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a list market proposal.
+func (p ListMarketProposal) ProposalType() string { return ProposalTypeListMarket }
+
+// ValidateBasic runs basic stateless validity checks
+func (p ListMarketProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(CodeSpaceMarket, p); err != nil {
+		return err
+	}
+	if !IsValidTradingPair([]string{p.Stock, p.Money}) {
+		return ErrInvalidSymbol()
+	}
+	if p.Money != dex.CET {
+		return ErrInvalidMarketProposal(fmt.Sprintf("only %s markets can be listed by proposal", dex.CET))
+	}
+	if p.Money == p.Stock {
+		return ErrStockAndMoneyAreSame()
+	}
+	if p.PricePrecision > MaxTokenPricePrecision {
+		return ErrInvalidPricePrecision(p.PricePrecision)
+	}
+	if p.OrderPrecision > MaxOrderPrecision {
+		return ErrInvalidMarketProposal(fmt.Sprintf("order precision must not be greater than %d", MaxOrderPrecision))
+	}
+	return nil
+}
+
+// GetSymbol returns the trading pair listed by the proposal
+func (p ListMarketProposal) GetSymbol() string {
+	return dex.GetSymbol(p.Stock, p.Money)
+}
+
+// String implements the Stringer interface.
+func (p ListMarketProposal) String() string {
+	return fmt.Sprintf(`List Market Proposal:
+  Title:          %s
+  Description:    %s
+  Stock:          %s
+  Money:          %s
+  PricePrecision: %d
+  OrderPrecision: %d
+`, p.Title, p.Description, p.Stock, p.Money, p.PricePrecision, p.OrderPrecision)
+}
+
+// DelistMarketProposal force-delists a trading pair through the delist queue,
+// the pair is removed MarketMinExpiredTime after the proposal passes
+type DelistMarketProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	TradingPair string `json:"trading_pair"`
+}
+
+func NewDelistMarketProposal(title, description, tradingPair string) DelistMarketProposal {
+	return DelistMarketProposal{Title: title, Description: description, TradingPair: tradingPair}
+}
+
+// GetTitle returns the title of a delist market proposal.
+func (p DelistMarketProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a delist market proposal.
+func (p DelistMarketProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a delist market proposal.
+func (p DelistMarketProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a delist market proposal.
+func (p DelistMarketProposal) ProposalType() string { return ProposalTypeDelistMarket }
+
+// ValidateBasic runs basic stateless validity checks
+func (p DelistMarketProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(CodeSpaceMarket, p); err != nil {
+		return err
+	}
+	if !IsValidTradingPair(strings.Split(p.TradingPair, SymbolSeparator)) {
+		return ErrInvalidSymbol()
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p DelistMarketProposal) String() string {
+	return fmt.Sprintf(`Delist Market Proposal:
+  Title:       %s
+  Description: %s
+  TradingPair: %s
+`, p.Title, p.Description, p.TradingPair)
+}
+
+// MarketFeeRateProposal overrides the fee rates of a listed trading pair
+type MarketFeeRateProposal struct {
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	TradingPair string  `json:"trading_pair"`
+	BuyFeeRate  sdk.Dec `json:"buy_fee_rate"`
+	SellFeeRate sdk.Dec `json:"sell_fee_rate"`
+}
+
+func NewMarketFeeRateProposal(title, description, tradingPair string, buyFeeRate, sellFeeRate sdk.Dec) MarketFeeRateProposal {
+	return MarketFeeRateProposal{
+		Title:       title,
+		Description: description,
+		TradingPair: tradingPair,
+		BuyFeeRate:  buyFeeRate,
+		SellFeeRate: sellFeeRate,
+	}
+}
+
+// GetTitle returns the title of a market fee rate proposal.
+func (p MarketFeeRateProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a market fee rate proposal.
+func (p MarketFeeRateProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a market fee rate proposal.
+func (p MarketFeeRateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a market fee rate proposal.
+func (p MarketFeeRateProposal) ProposalType() string { return ProposalTypeMarketFeeRate }
+
+// ValidateBasic runs basic stateless validity checks
+func (p MarketFeeRateProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(CodeSpaceMarket, p); err != nil {
+		return err
+	}
+	if !IsValidTradingPair(strings.Split(p.TradingPair, SymbolSeparator)) {
+		return ErrInvalidSymbol()
+	}
+	if !isValidFeeRate(p.BuyFeeRate) || !isValidFeeRate(p.SellFeeRate) {
+		return ErrInvalidMarketProposal("fee rates must be in [0, 1)")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p MarketFeeRateProposal) String() string {
+	return fmt.Sprintf(`Market Fee Rate Proposal:
+  Title:       %s
+  Description: %s
+  TradingPair: %s
+  BuyFeeRate:  %s
+  SellFeeRate: %s
+`, p.Title, p.Description, p.TradingPair, p.BuyFeeRate, p.SellFeeRate)
+}
+
+// MarketHaltProposal halts a market until it is resumed, or resumes a halted one
+type MarketHaltProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	TradingPair string `json:"trading_pair"`
+	Halt        bool   `json:"halt"`
+}
+
+func NewMarketHaltProposal(title, description, tradingPair string, halt bool) MarketHaltProposal {
+	return MarketHaltProposal{Title: title, Description: description, TradingPair: tradingPair, Halt: halt}
+}
+
+// GetTitle returns the title of a market halt proposal.
+func (p MarketHaltProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a market halt proposal.
+func (p MarketHaltProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a market halt proposal.
+func (p MarketHaltProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a market halt proposal.
+func (p MarketHaltProposal) ProposalType() string { return ProposalTypeMarketHalt }
+
+// ValidateBasic runs basic stateless validity checks
+func (p MarketHaltProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(CodeSpaceMarket, p); err != nil {
+		return err
+	}
+	if !IsValidTradingPair(strings.Split(p.TradingPair, SymbolSeparator)) {
+		return ErrInvalidSymbol()
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p MarketHaltProposal) String() string {
+	return fmt.Sprintf(`Market Halt Proposal:
+  Title:       %s
+  Description: %s
+  TradingPair: %s
+  Halt:        %t
+`, p.Title, p.Description, p.TradingPair, p.Halt)
+}
+
+func isValidFeeRate(rate sdk.Dec) bool {
+	return rate != (sdk.Dec{}) && !rate.IsNegative() && rate.LT(sdk.OneDec())
+}
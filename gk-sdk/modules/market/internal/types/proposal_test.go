@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func TestListMarketProposal(t *testing.T) {
+	p := NewListMarketProposal("title", "desc", "abc", dex.CET, 8, 0)
+	require.Nil(t, p.ValidateBasic())
+	require.Equal(t, "abc/"+dex.CET, p.GetSymbol())
+
+	p.Money = "eth"
+	require.EqualValues(t, CodeInvalidMarketProposal, p.ValidateBasic().Code())
+
+	p = NewListMarketProposal("title", "desc", dex.CET, dex.CET, 8, 0)
+	require.EqualValues(t, CodeInvalidSymbol, p.ValidateBasic().Code())
+
+	p = NewListMarketProposal("title", "desc", "abc", dex.CET, MaxTokenPricePrecision+1, 0)
+	require.EqualValues(t, CodeInvalidPricePrecision, p.ValidateBasic().Code())
+
+	p = NewListMarketProposal("title", "desc", "abc", dex.CET, 8, MaxOrderPrecision+1)
+	require.EqualValues(t, CodeInvalidMarketProposal, p.ValidateBasic().Code())
+
+	p = NewListMarketProposal("", "desc", "abc", dex.CET, 8, 0)
+	require.NotNil(t, p.ValidateBasic())
+}
+
+func TestDelistMarketProposal(t *testing.T) {
+	require.Nil(t, NewDelistMarketProposal("title", "desc", "abc/"+dex.CET).ValidateBasic())
+	require.EqualValues(t, CodeInvalidSymbol, NewDelistMarketProposal("title", "desc", "abc").ValidateBasic().Code())
+}
+
+func TestMarketFeeRateProposal(t *testing.T) {
+	rate := sdk.NewDecWithPrec(1, 3)
+	require.Nil(t, NewMarketFeeRateProposal("title", "desc", "abc/"+dex.CET, rate, sdk.ZeroDec()).ValidateBasic())
+
+	p := NewMarketFeeRateProposal("title", "desc", "abc/"+dex.CET, sdk.OneDec(), rate)
+	require.EqualValues(t, CodeInvalidMarketProposal, p.ValidateBasic().Code())
+	p = NewMarketFeeRateProposal("title", "desc", "abc/"+dex.CET, rate, rate.Neg())
+	require.EqualValues(t, CodeInvalidMarketProposal, p.ValidateBasic().Code())
+	p = NewMarketFeeRateProposal("title", "desc", "abc/"+dex.CET, sdk.Dec{}, rate)
+	require.EqualValues(t, CodeInvalidMarketProposal, p.ValidateBasic().Code())
+}
+
+func TestMarketHaltProposal(t *testing.T) {
+	require.Nil(t, NewMarketHaltProposal("title", "desc", "abc/"+dex.CET, true).ValidateBasic())
+	require.Nil(t, NewMarketHaltProposal("title", "desc", "abc/"+dex.CET, false).ValidateBasic())
+	require.EqualValues(t, CodeInvalidSymbol, NewMarketHaltProposal("title", "desc", "abc", true).ValidateBasic().Code())
+}
@@ -0,0 +1,82 @@
+package market
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// register all the invariants of market
+func RegisterInvariants(ir sdk.InvariantRegistry, k keepers.Keeper) {
+	ir.RegisterRoute(ModuleName, "orders", OrdersInvariant(k))
+	ir.RegisterRoute(ModuleName, "mining-pool", MiningPoolInvariant(k))
+}
+
+// OrdersInvariant checks that every order belongs to an existing market, and its amounts are in range
+func OrdersInvariant(k keepers.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		for _, order := range k.GetAllOrders(ctx) {
+			if !k.IsMarketExist(ctx, order.TradingPair) {
+				count++
+				msg += fmt.Sprintf("\t%s is in a nonexistent market %s\n", order.OrderID(), order.TradingPair)
+			}
+			if order.Freeze < 0 || order.FrozenCommission < 0 || order.FrozenFeatureFee < 0 {
+				count++
+				msg += fmt.Sprintf("\t%s has negative frozen amounts: freeze %d, commission %d, feature fee %d\n",
+					order.OrderID(), order.Freeze, order.FrozenCommission, order.FrozenFeatureFee)
+			}
+			if order.LeftStock < 0 || order.LeftStock > order.Quantity {
+				count++
+				msg += fmt.Sprintf("\t%s has left stock %d out of quantity %d\n",
+					order.OrderID(), order.LeftStock, order.Quantity)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "orders",
+			fmt.Sprintf("%d invalid orders found\n%s", count, msg)), broken
+	}
+}
+
+// MiningPoolInvariant checks that the unclaimed liquidity mining rewards add up to their recorded sum,
+// which is covered by the mining pool
+func MiningPoolInvariant(k keepers.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		total := int64(0)
+		for _, reward := range k.GetAllMiningRewards(ctx) {
+			total += reward.Amount
+		}
+		pending := k.GetPendingMiningRewards(ctx)
+		balance := k.GetMiningPoolBalance(ctx)
+
+		broken := total != pending || balance.LT(sdk.NewInt(pending))
+		return sdk.FormatInvariant(ModuleName, "mining pool",
+			fmt.Sprintf("\tsum of unclaimed rewards: %d\n\trecorded pending rewards: %d\n\tmining pool balance: %s\n",
+				total, pending, balance)), broken
+	}
+}
+
+// FrozenCoinsInOrders returns the coins frozen by all the orders, including the commissions and feature fees.
+// The negative amounts are left to OrdersInvariant.
+func FrozenCoinsInOrders(k keepers.Keeper) authx.FrozenCoinsSource {
+	return func(ctx sdk.Context) map[string]sdk.Coins {
+		frozen := make(map[string]sdk.Coins)
+		add := func(addr sdk.AccAddress, denom string, amount int64) {
+			if amount > 0 {
+				frozen[string(addr)] = frozen[string(addr)].Add(dex.NewCoins(denom, amount))
+			}
+		}
+		for _, order := range k.GetAllOrders(ctx) {
+			add(order.Sender, order.GetOrderUsedDenom(), order.Freeze)
+			add(order.Sender, dex.CET, order.FrozenCommission)
+			add(order.Sender, dex.CET, order.FrozenFeatureFee)
+		}
+		return frozen
+	}
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/authx"
 )
 
 type mockKeeper struct {
@@ -26,6 +27,15 @@ func (k *mockKeeper) GetRebateRatio(ctx sdk.Context) int64 {
 func (k *mockKeeper) GetRebateRatioBase(ctx sdk.Context) int64 {
 	return 10000
 }
+func (k *mockKeeper) CalcRebates(ctx sdk.Context, addr sdk.AccAddress, fee int64) authx.Rebates {
+	amount := fee * k.GetRebateRatio(ctx) / k.GetRebateRatioBase(ctx)
+	if amount <= 0 {
+		return nil
+	}
+	return authx.Rebates{{Referee: k.GetRefereeAddr(ctx, addr), Level: 1, Amount: amount}}
+}
+func (k *mockKeeper) AddRebates(ctx sdk.Context, rebates authx.Rebates) {
+}
 func (k *mockKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	panic("implement me")
 }
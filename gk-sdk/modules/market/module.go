@@ -70,7 +70,9 @@ func NewAppModule(marketKeeper keepers.Keeper) AppModule {
 }
 
 // registers
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.marketKeeper)
+}
 
 // routes
 func (am AppModule) Route() string {
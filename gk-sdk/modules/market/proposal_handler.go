@@ -0,0 +1,129 @@
+package market
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/market/internal/types"
+)
+
+func NewProposalHandler(k keepers.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.ListMarketProposal:
+			return handleListMarketProposal(ctx, k, c)
+		case types.DelistMarketProposal:
+			return handleDelistMarketProposal(ctx, k, c)
+		case types.MarketFeeRateProposal:
+			return handleMarketFeeRateProposal(ctx, k, c)
+		case types.MarketHaltProposal:
+			return handleMarketHaltProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized market proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleListMarketProposal(ctx sdk.Context, k keepers.Keeper, p types.ListMarketProposal) sdk.Error {
+	if _, err := k.GetMarketInfo(ctx, p.GetSymbol()); err == nil {
+		return types.ErrRepeatTradingPair()
+	}
+	if !k.IsTokenExists(ctx, p.Money) || !k.IsTokenExists(ctx, p.Stock) {
+		return types.ErrTokenNoExist()
+	}
+
+	info := newMarketInfo(p.Stock, p.Money, p.PricePrecision, p.OrderPrecision)
+	if err := k.SetMarket(ctx, info); err != nil {
+		return err
+	}
+
+	sendCreateMarketMsg(ctx, k, &types.MsgCreateTradingPair{
+		Stock:          p.Stock,
+		Money:          p.Money,
+		PricePrecision: p.PricePrecision,
+		OrderPrecision: info.OrderPrecision,
+		BuyFeeRate:     info.BuyFeeRate,
+		SellFeeRate:    info.SellFeeRate,
+	})
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeKeyCreateTradingPair,
+			sdk.NewAttribute(AttributeKeyTradingPair, p.GetSymbol()),
+			sdk.NewAttribute(AttributeKeyStock, p.Stock),
+			sdk.NewAttribute(AttributeKeyMoney, p.Money),
+			sdk.NewAttribute(AttributeKeyPricePrecision, strconv.Itoa(int(info.PricePrecision))),
+			sdk.NewAttribute(AttributeKeyLastExecutePrice, info.LastExecutedPrice.String()),
+		),
+	)
+	return nil
+}
+
+// handleDelistMarketProposal queues the trading pair like MsgCancelTradingPair does,
+// using the earliest effective time allowed for the owner
+func handleDelistMarketProposal(ctx sdk.Context, k keepers.Keeper, p types.DelistMarketProposal) sdk.Error {
+	if _, err := k.GetMarketInfo(ctx, p.TradingPair); err != nil {
+		return types.ErrInvalidMarket(err.Error())
+	}
+
+	dlk := keepers.NewDelistKeeper(k.GetMarketKey())
+	if dlk.HasDelistRequest(ctx, p.TradingPair) {
+		return types.ErrDelistRequestExist(p.TradingPair)
+	}
+	effectiveTime := ctx.BlockHeader().Time.UnixNano() + k.GetParams(ctx).MarketMinExpiredTime
+	dlk.AddDelistRequest(ctx, effectiveTime, p.TradingPair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeKeyCancelTradingPair,
+			sdk.NewAttribute(AttributeKeyTradingPair, p.TradingPair),
+			sdk.NewAttribute(AttributeKeyEffectiveTime, strconv.FormatInt(effectiveTime, 10)),
+		),
+	)
+	return nil
+}
+
+func handleMarketFeeRateProposal(ctx sdk.Context, k keepers.Keeper, p types.MarketFeeRateProposal) sdk.Error {
+	info, err := k.GetMarketInfo(ctx, p.TradingPair)
+	if err != nil {
+		return types.ErrInvalidMarket(err.Error())
+	}
+
+	info.BuyFeeRate = p.BuyFeeRate
+	info.SellFeeRate = p.SellFeeRate
+	if err := k.SetMarket(ctx, info); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeKeyModifyBuyFeeRate,
+			sdk.NewAttribute(AttributeKeyTradingPair, p.TradingPair),
+			sdk.NewAttribute(AttributeKeyFeeRate, p.BuyFeeRate.String()),
+		),
+		sdk.NewEvent(
+			EventTypeKeyModifySellFeeRate,
+			sdk.NewAttribute(AttributeKeyTradingPair, p.TradingPair),
+			sdk.NewAttribute(AttributeKeyFeeRate, p.SellFeeRate.String()),
+		),
+	})
+	return nil
+}
+
+func handleMarketHaltProposal(ctx sdk.Context, k keepers.Keeper, p types.MarketHaltProposal) sdk.Error {
+	if p.Halt {
+		if err := k.HaltMarket(ctx, p.TradingPair, 0, types.HaltByProposal); err != nil {
+			return err
+		}
+	} else if err := k.ResumeMarket(ctx, p.TradingPair); err != nil {
+		return err
+	}
+
+	notifyMarketHalt(ctx, k, p.TradingPair, p.Halt, 0, types.HaltByProposal)
+	return nil
+}
@@ -6,7 +6,10 @@ import (
 )
 
 type (
-	Params = types.Params
+	Params                             = types.Params
+	ValidatorCompliance                = keepers.ValidatorCompliance
+	NonBondableAddressesChangeProposal = types.NonBondableAddressesChangeProposal
+	NonBondableAddressesChange         = types.NonBondableAddressesChange
 )
 
 const (
@@ -17,6 +20,16 @@ const (
 	DefaultMinSelfDelegation            = types.DefaultMinSelfDelegation
 	CodeMinSelfDelegationBelowRequired  = types.CodeMinSelfDelegationBelowRequired
 	CodeBelowMinMandatoryCommissionRate = types.CodeBelowMinMandatoryCommissionRate
+	CodeCommissionChangeTooLarge        = types.CodeCommissionChangeTooLarge
+	CodeValidatorBondedShareTooLarge    = types.CodeValidatorBondedShareTooLarge
+	QueryCompliance                     = keepers.QueryCompliance
+	QueryNonBondableAddresses           = keepers.QueryNonBondableAddresses
+	QueryNonBondableAddressesChanges    = keepers.QueryNonBondableAddressesChanges
+	CodeNonBondableAddressExists        = types.CodeNonBondableAddressExists
+	CodeNonBondableAddressNotFound      = types.CodeNonBondableAddressNotFound
+	RouterKey                           = types.RouterKey
+
+	ProposalTypeNonBondableAddressesChange = types.ProposalTypeNonBondableAddressesChange
 )
 
 type (
@@ -25,9 +38,16 @@ type (
 
 var (
 	DefaultParams                          = types.DefaultParams
+	ValidateParams                         = types.ValidateParams
 	DefaultMinMandatoryCommissionRate      = types.DefaultMinMandatoryCommissionRate
+	DefaultMaxCommissionChangeRate         = types.DefaultMaxCommissionChangeRate
+	DefaultMaxValidatorBondedShare         = types.DefaultMaxValidatorBondedShare
 	ErrInvalidMinSelfDelegation            = types.ErrInvalidMinSelfDelegation
 	ErrMinSelfDelegationBelowRequired      = types.ErrMinSelfDelegationBelowRequired
 	ErrRateBelowMinMandatoryCommissionRate = types.ErrRateBelowMinMandatoryCommissionRate
+	ErrCommissionChangeTooLarge            = types.ErrCommissionChangeTooLarge
+	ErrValidatorBondedShareTooLarge        = types.ErrValidatorBondedShareTooLarge
 	NewKeeper                              = keepers.NewKeeper
+	NewNonBondableAddressesChangeProposal  = types.NewNonBondableAddressesChangeProposal
+	ModuleCdc                              = types.ModuleCdc
 )
@@ -13,7 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	staking_cli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 
+	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/keepers"
 	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/types"
+	"github.com/coinexchain/cosmos-utils/client/cliutil"
 )
 
 func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
@@ -24,6 +26,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	//replace pool cmd with new bondPoolCmd which can also show the non-bondable-cet-tokens in locked positions
 	replacePoolCmd(stakingQueryCmd, "pool", bondPoolCmd)
 	replacePoolCmd(stakingQueryCmd, "params", paramsCmd)
+	stakingQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryCompliance(cdc),
+		GetCmdQueryNonBondableAddresses(cdc),
+		GetCmdQueryNonBondableAddressesChanges(cdc),
+	)...)
 	return stakingQueryCmd
 }
 
@@ -93,3 +100,60 @@ $ %s query staking params
 		},
 	}
 }
+
+// GetCmdQueryCompliance implements the validator compliance query command.
+func GetCmdQueryCompliance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "compliance",
+		Args:  cobra.NoArgs,
+		Short: "Query whether each validator complies with the stakingx policies",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query each validator's min self-delegation, commission rate and share of
+total bonded tokens, checked against the stakingx parameters.
+
+Example:
+$ %s query staking compliance
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryCompliance)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryNonBondableAddresses implements the non-bondable addresses query command.
+func GetCmdQueryNonBondableAddresses(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "non-bondable-addresses",
+		Args:  cobra.NoArgs,
+		Short: "Query the addresses whose CET is excluded from the bondable tokens",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryNonBondableAddresses)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+}
+
+// GetCmdQueryNonBondableAddressesChanges implements the non-bondable addresses changes query command.
+func GetCmdQueryNonBondableAddressesChanges(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "non-bondable-addresses-changes",
+		Args:  cobra.NoArgs,
+		Short: "Query the non-bondable address changes made by governance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryNonBondableAddressesChanges)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/types"
+)
+
+// NonBondableAddressesChangeProposalJSON defines a NonBondableAddressesChangeProposal with a deposit
+type NonBondableAddressesChangeProposalJSON struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Add         []sdk.AccAddress `json:"add"`
+	Remove      []sdk.AccAddress `json:"remove"`
+	Deposit     sdk.Coins        `json:"deposit"`
+}
+
+// GetCmdSubmitProposal implements the command to submit a non-bondable addresses change proposal
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "non-bondable-addresses-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add or remove non-bondable addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a non-bondable addresses change proposal along with an initial deposit.
+The CET held by non-bondable addresses is excluded from the bondable tokens when the bonded
+ratio is calculated. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal non-bondable-addresses-change <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Foundation Wallet",
+  "description": "Exclude the foundation wallet from the bonded ratio",
+  "add": [
+    "gkex1s5afhd6gxevu37mkqcvvsj8qeylhn0rz95pysw"
+  ],
+  "remove": [],
+  "deposit": [
+    {
+      "denom": "gkex",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := parseNonBondableAddressesChangeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewNonBondableAddressesChangeProposal(proposal.Title, proposal.Description,
+				proposal.Add, proposal.Remove)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func parseNonBondableAddressesChangeProposalJSON(cdc *codec.Codec, proposalFile string) (NonBondableAddressesChangeProposalJSON, error) {
+	proposal := NonBondableAddressesChangeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/coinexchain/cet-sdk/modules/stakingx/client/cli"
+	"github.com/coinexchain/cet-sdk/modules/stakingx/client/rest"
+)
+
+// non-bondable addresses change proposal handler
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+)
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
+	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/keepers"
 	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/types"
 	"github.com/coinexchain/cosmos-utils/client/restutil"
 )
@@ -16,6 +17,11 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/staking/pool", poolHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/staking/parameters", paramsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/staking/compliance", complianceHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/staking/non-bondable-addresses",
+		queryHandlerFn(cliCtx, keepers.QueryNonBondableAddresses)).Methods("GET")
+	r.HandleFunc("/staking/non-bondable-addresses/changes",
+		queryHandlerFn(cliCtx, keepers.QueryNonBondableAddressesChanges)).Methods("GET")
 }
 
 // HTTP request handler to query the pool information
@@ -32,3 +38,19 @@ func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
 	}
 }
+
+// HTTP request handler to query each validator's compliance with the stakingx policies
+func complianceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keepers.QueryCompliance)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
+	}
+}
+
+// HTTP request handler to query an endpoint without parameters
+func queryHandlerFn(cliCtx context.CLIContext, query string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, query)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
+	}
+}
@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/types"
+)
+
+// NonBondableAddressesChangeProposalReq defines a non-bondable addresses change proposal request body
+type NonBondableAddressesChangeProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Add         []sdk.AccAddress `json:"add"`
+	Remove      []sdk.AccAddress `json:"remove"`
+	Proposer    sdk.AccAddress   `json:"proposer"`
+	Deposit     sdk.Coins        `json:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the non-bondable addresses change REST handler
+func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "non_bondable_addresses_change",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req NonBondableAddressesChangeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewNonBondableAddressesChangeProposal(req.Title, req.Description, req.Add, req.Remove)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
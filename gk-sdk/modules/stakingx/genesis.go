@@ -1,6 +1,8 @@
 package stakingx
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/incentive"
@@ -9,44 +11,73 @@ import (
 
 type GenesisState struct {
 	Params Params `json:"params"`
+	// nil when the non-bondable addresses are to be computed, an empty list is kept as it is
+	NonBondableAddresses        *[]sdk.AccAddress            `json:"non_bondable_addresses"`
+	NonBondableAddressesChanges []NonBondableAddressesChange `json:"non_bondable_addresses_changes"`
 }
 
-func NewGenesisState(params Params) GenesisState {
-	return GenesisState{
-		Params: params,
+// NewGenesisState returns a GenesisState whose non-bondable addresses are computed if addresses is nil
+func NewGenesisState(params Params, addresses []sdk.AccAddress, changes []NonBondableAddressesChange) GenesisState {
+	state := GenesisState{
+		Params:                      params,
+		NonBondableAddressesChanges: changes,
+	}
+	if addresses != nil {
+		state.NonBondableAddresses = &addresses
 	}
+	return state
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams())
+	return NewGenesisState(DefaultParams(), nil, nil)
 }
 
 // InitGenesis - Init store state from genesis data
 func InitGenesis(ctx sdk.Context, keeper keepers.Keeper, data GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 
-	// cache non-bondable addresses
-	addresses := keeper.GetAllVestingAccountAddresses(ctx)
-	addresses = append(addresses, incentive.PoolAddr)
-	if cetOwner := keeper.GetCetOwnerAddress(ctx); cetOwner != nil {
-		addresses = append(addresses, cetOwner)
+	// cache non-bondable addresses, which are computed unless given by an exported genesis
+	var addresses []sdk.AccAddress
+	if data.NonBondableAddresses != nil {
+		addresses = *data.NonBondableAddresses
+	} else {
+		addresses = keeper.GetAllVestingAccountAddresses(ctx)
+		addresses = append(addresses, incentive.PoolAddr)
+		if cetOwner := keeper.GetCetOwnerAddress(ctx); cetOwner != nil {
+			addresses = append(addresses, cetOwner)
+		}
 	}
 	keeper.SetNonBondableAddresses(ctx, addresses)
+	if len(data.NonBondableAddressesChanges) != 0 {
+		keeper.SetNonBondableAddressesChanges(ctx, data.NonBondableAddressesChanges)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func ExportGenesis(ctx sdk.Context, keeper keepers.Keeper) GenesisState {
 	params := keeper.GetParams(ctx)
-	return NewGenesisState(params)
+	addresses := keeper.GetNonBondableAddresses(ctx)
+	if addresses == nil {
+		// the list emptied by governance is not computed again
+		addresses = []sdk.AccAddress{}
+	}
+	return NewGenesisState(params, addresses, keeper.GetNonBondableAddressesChanges(ctx))
 }
 
 // ValidateGenesis performs basic validation of asset genesis data returning an
 // error for any failed validation criteria.
 func (data GenesisState) ValidateGenesis() error {
-	msd := data.Params.MinSelfDelegation
-	if msd <= 0 {
-		return ErrInvalidMinSelfDelegation(msd)
+	var addresses []sdk.AccAddress
+	if data.NonBondableAddresses != nil {
+		addresses = *data.NonBondableAddresses
+	}
+	seen := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		if addr.Empty() || seen[addr.String()] {
+			return fmt.Errorf("invalid or duplicated non-bondable address %s", addr)
+		}
+		seen[addr.String()] = true
 	}
-	return nil
+	return data.Params.ValidateGenesis()
 }
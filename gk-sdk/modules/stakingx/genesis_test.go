@@ -5,7 +5,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/coinexchain/cet-sdk/modules/stakingx"
+	"github.com/coinexchain/cet-sdk/testutil"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
@@ -28,3 +31,12 @@ func TestDefaultGenesisState(t *testing.T) {
 	defaultGenesisState := stakingx.DefaultGenesisState()
 	require.Equal(t, stakingx.DefaultParams(), defaultGenesisState.Params)
 }
+
+func TestGenesisState_ValidateNonBondableAddresses(t *testing.T) {
+	addr := testutil.ToAccAddress("foundation__________")
+	state := stakingx.NewGenesisState(stakingx.DefaultParams(), []sdk.AccAddress{addr}, nil)
+	require.Nil(t, state.ValidateGenesis())
+
+	state = stakingx.NewGenesisState(stakingx.DefaultParams(), []sdk.AccAddress{addr, addr}, nil)
+	require.NotNil(t, state.ValidateGenesis())
+}
@@ -12,7 +12,8 @@ import (
 )
 
 var (
-	NonBondableAddressesKey = []byte("0x01")
+	NonBondableAddressesKey       = []byte("0x01")
+	NonBondableAddressesChangeKey = []byte("0x02")
 )
 
 type Keeper struct {
@@ -150,9 +151,17 @@ func (k Keeper) SetNonBondableAddresses(ctx sdk.Context, addresses []sdk.AccAddr
 	if err != nil {
 		panic(err)
 	}
+	if bz == nil {
+		// an empty list is encoded as nil, which can not be stored
+		bz = []byte{}
+	}
 	store.Set(NonBondableAddressesKey, bz)
 }
 
+func (k Keeper) GetNonBondableAddresses(ctx sdk.Context) []sdk.AccAddress {
+	return k.getNonBondableAddresses(ctx)
+}
+
 func (k Keeper) getNonBondableAddresses(ctx sdk.Context) (addresses []sdk.AccAddress) {
 	store := ctx.KVStore(k.key)
 	bz := store.Get(NonBondableAddressesKey)
@@ -166,3 +175,65 @@ func (k Keeper) getNonBondableAddresses(ctx sdk.Context) (addresses []sdk.AccAdd
 	}
 	return
 }
+
+// ChangeNonBondableAddresses applies a passed NonBondableAddressesChangeProposal and records it
+func (k Keeper) ChangeNonBondableAddresses(ctx sdk.Context, title string, add, remove []sdk.AccAddress) sdk.Error {
+	addresses := k.getNonBondableAddresses(ctx)
+	for _, addr := range add {
+		if containsAddress(addresses, addr) {
+			return types.ErrNonBondableAddressExists(addr)
+		}
+		addresses = append(addresses, addr)
+	}
+	for _, addr := range remove {
+		if !containsAddress(addresses, addr) {
+			return types.ErrNonBondableAddressNotFound(addr)
+		}
+		addresses = removeAddress(addresses, addr)
+	}
+	k.SetNonBondableAddresses(ctx, addresses)
+
+	changes := append(k.GetNonBondableAddressesChanges(ctx), types.NonBondableAddressesChange{
+		Height:  ctx.BlockHeight(),
+		Title:   title,
+		Added:   add,
+		Removed: remove,
+	})
+	k.SetNonBondableAddressesChanges(ctx, changes)
+	return nil
+}
+
+func (k Keeper) SetNonBondableAddressesChanges(ctx sdk.Context, changes []types.NonBondableAddressesChange) {
+	store := ctx.KVStore(k.key)
+	store.Set(NonBondableAddressesChangeKey, k.cdc.MustMarshalBinaryBare(changes))
+}
+
+func (k Keeper) GetNonBondableAddressesChanges(ctx sdk.Context) (changes []types.NonBondableAddressesChange) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(NonBondableAddressesChangeKey)
+	if bz == nil {
+		return
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &changes)
+	return
+}
+
+func containsAddress(addresses []sdk.AccAddress, addr sdk.AccAddress) bool {
+	for _, a := range addresses {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func removeAddress(addresses []sdk.AccAddress, addr sdk.AccAddress) []sdk.AccAddress {
+	result := make([]sdk.AccAddress, 0, len(addresses))
+	for _, a := range addresses {
+		if !a.Equals(addr) {
+			result = append(result, a)
+		}
+	}
+	return result
+}
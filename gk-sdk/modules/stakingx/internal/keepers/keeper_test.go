@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
+	"github.com/coinexchain/cet-sdk/modules/incentive"
 	"github.com/coinexchain/cet-sdk/modules/stakingx"
 	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/keepers"
 	"github.com/coinexchain/cet-sdk/testapp"
@@ -35,12 +36,30 @@ func TestInitExportGenesis(t *testing.T) {
 		Params: stakingx.Params{
 			MinSelfDelegation:          stakingx.DefaultMinSelfDelegation,
 			MinMandatoryCommissionRate: stakingx.DefaultMinMandatoryCommissionRate,
+			MaxCommissionChangeRate:    stakingx.DefaultMaxCommissionChangeRate,
+			MaxValidatorBondedShare:    stakingx.DefaultMaxValidatorBondedShare,
 		},
 	}
 
 	stakingx.InitGenesis(ctx, sxk.Keeper, genesisState)
 	exportGenesis := stakingx.ExportGenesis(ctx, sxk.Keeper)
-	require.Equal(t, genesisState, exportGenesis)
+	require.Equal(t, genesisState.Params, exportGenesis.Params)
+	require.Contains(t, *exportGenesis.NonBondableAddresses, incentive.PoolAddr)
+
+	// exported addresses are kept as they are
+	sxk2, ctx2, _ := setUpInput()
+	stakingx.InitGenesis(ctx2, sxk2.Keeper, exportGenesis)
+	require.Equal(t, exportGenesis, stakingx.ExportGenesis(ctx2, sxk2.Keeper))
+
+	// so is the list emptied by governance
+	require.Nil(t, sxk2.ChangeNonBondableAddresses(ctx2, "empty", nil, *exportGenesis.NonBondableAddresses))
+	bz := stakingx.ModuleCdc.MustMarshalJSON(stakingx.ExportGenesis(ctx2, sxk2.Keeper))
+	var emptied stakingx.GenesisState
+	stakingx.ModuleCdc.MustUnmarshalJSON(bz, &emptied)
+	require.NotNil(t, emptied.NonBondableAddresses)
+	sxk3, ctx3, _ := setUpInput()
+	stakingx.InitGenesis(ctx3, sxk3.Keeper, emptied)
+	require.Empty(t, sxk3.GetNonBondableAddresses(ctx3))
 }
 
 func TestCalcBondPoolStatus(t *testing.T) {
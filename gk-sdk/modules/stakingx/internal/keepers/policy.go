@@ -0,0 +1,126 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/types"
+)
+
+type ValidatorCompliance struct {
+	OperatorAddress        sdk.ValAddress `json:"operator_address"`
+	Moniker                string         `json:"moniker"`
+	Jailed                 bool           `json:"jailed"`
+	MinSelfDelegation      sdk.Int        `json:"min_self_delegation"`
+	CommissionRate         sdk.Dec        `json:"commission_rate"`
+	BondedShare            sdk.Dec        `json:"bonded_share"`
+	MeetsMinSelfDelegation bool           `json:"meets_min_self_delegation"`
+	MeetsMinCommissionRate bool           `json:"meets_min_commission_rate"`
+	WithinMaxBondedShare   bool           `json:"within_max_bonded_share"`
+	Compliant              bool           `json:"compliant"`
+}
+
+// CheckCommissionChange rejects commission rate edits larger than MaxCommissionChangeRate.
+// Together with staking's one-edit-per-day rule this bounds the daily change.
+func (k Keeper) CheckCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress, newRate sdk.Dec) sdk.Error {
+	validator, found := k.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return nil // left to the staking handler
+	}
+
+	maxChange := k.GetParams(ctx).MaxCommissionChangeRate
+	change := newRate.Sub(validator.Commission.Rate).Abs()
+	if change.GT(maxChange) {
+		return types.ErrCommissionChangeTooLarge(maxChange, change)
+	}
+	return nil
+}
+
+// CheckValidatorBondedShare rejects delegations which would push the validator's share
+// of total bonded tokens past MaxValidatorBondedShare. srcValAddr is the source validator
+// of a redelegation, and is nil for the other delegations.
+func (k Keeper) CheckValidatorBondedShare(ctx sdk.Context, valAddr, srcValAddr sdk.ValAddress, amount sdk.Int) sdk.Error {
+	validator, found := k.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return nil // left to the staking handler
+	}
+
+	// the total only grows when the tokens move into the bonded pool
+	bondsMore := validator.IsBonded()
+	if srcValAddr != nil {
+		if src, found := k.sk.GetValidator(ctx, srcValAddr); found && src.IsBonded() {
+			bondsMore = false
+		}
+	}
+	return k.checkBondedShare(ctx, validator.Tokens, amount, bondsMore)
+}
+
+// CheckNewValidatorBondedShare rejects the self-delegation of a new validator
+// which would push its share of total bonded tokens past MaxValidatorBondedShare.
+func (k Keeper) CheckNewValidatorBondedShare(ctx sdk.Context, amount sdk.Int) sdk.Error {
+	// a new validator is not bonded
+	return k.checkBondedShare(ctx, sdk.ZeroInt(), amount, false)
+}
+
+func (k Keeper) checkBondedShare(ctx sdk.Context, tokens, amount sdk.Int, bondsMore bool) sdk.Error {
+	maxShare := k.GetParams(ctx).MaxValidatorBondedShare
+	if maxShare.GTE(sdk.OneDec()) {
+		return nil
+	}
+
+	totalBonded := k.sk.TotalBondedTokens(ctx)
+	if !totalBonded.IsPositive() {
+		return nil // nothing bonded yet, e.g. the genesis validators
+	}
+	if bondsMore {
+		totalBonded = totalBonded.Add(amount)
+	}
+
+	share := calcBondedShare(tokens.Add(amount), totalBonded)
+	if share.GT(maxShare) {
+		return types.ErrValidatorBondedShareTooLarge(maxShare, share)
+	}
+	return nil
+}
+
+// GetValidatorsCompliance reports every validator against the stakingx policies
+func (k Keeper) GetValidatorsCompliance(ctx sdk.Context) []ValidatorCompliance {
+	params := k.GetParams(ctx)
+	totalBonded := k.sk.TotalBondedTokens(ctx)
+	minSelfDelegation := sdk.NewInt(params.MinSelfDelegation)
+
+	validators := k.sk.GetAllValidators(ctx)
+	result := make([]ValidatorCompliance, 0, len(validators))
+	for _, val := range validators {
+		result = append(result, newValidatorCompliance(val, params, minSelfDelegation, totalBonded))
+	}
+	return result
+}
+
+func newValidatorCompliance(val staking.Validator, params types.Params, minSelfDelegation, totalBonded sdk.Int) ValidatorCompliance {
+	share := sdk.ZeroDec()
+	if val.IsBonded() {
+		share = calcBondedShare(val.Tokens, totalBonded)
+	}
+
+	c := ValidatorCompliance{
+		OperatorAddress:        val.OperatorAddress,
+		Moniker:                val.GetMoniker(),
+		Jailed:                 val.Jailed,
+		MinSelfDelegation:      val.MinSelfDelegation,
+		CommissionRate:         val.Commission.Rate,
+		BondedShare:            share,
+		MeetsMinSelfDelegation: val.MinSelfDelegation.GTE(minSelfDelegation),
+		MeetsMinCommissionRate: val.Commission.Rate.GTE(params.MinMandatoryCommissionRate),
+		WithinMaxBondedShare:   share.LTE(params.MaxValidatorBondedShare),
+	}
+	c.Compliant = c.MeetsMinSelfDelegation && c.MeetsMinCommissionRate && c.WithinMaxBondedShare
+	return c
+}
+
+func calcBondedShare(tokens, totalBonded sdk.Int) sdk.Dec {
+	if !totalBonded.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return tokens.ToDec().QuoInt(totalBonded)
+}
@@ -0,0 +1,128 @@
+package keepers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/coinexchain/cet-sdk/modules/stakingx"
+	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/keepers"
+	"github.com/coinexchain/cet-sdk/testutil"
+)
+
+func setUpValidators(t *testing.T) (keepers.MockKeeper, sdk.Context, sdk.ValAddress, sdk.ValAddress) {
+	sxk, ctx, _ := setUpInput()
+	sxk.SetParams(ctx, stakingx.DefaultParams())
+
+	_, pk1, addr1 := testutil.KeyPubAddr()
+	_, pk2, addr2 := testutil.KeyPubAddr()
+	val1 := staking.NewValidator(sdk.ValAddress(addr1), pk1, staking.Description{Moniker: "v1"})
+	val1.Status = sdk.Bonded
+	val1.Tokens = sdk.NewInt(600)
+	val1.Commission = staking.NewCommission(sdk.NewDecWithPrec(2, 1), sdk.OneDec(), sdk.NewDecWithPrec(1, 1))
+	val1.MinSelfDelegation = sdk.NewInt(stakingx.DefaultMinSelfDelegation)
+	val2 := staking.NewValidator(sdk.ValAddress(addr2), pk2, staking.Description{Moniker: "v2"})
+	val2.Status = sdk.Bonded
+	val2.Tokens = sdk.NewInt(400)
+	val2.Commission = staking.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.OneDec(), sdk.NewDecWithPrec(1, 1))
+	val2.MinSelfDelegation = sdk.OneInt()
+	sxk.Sk.SetValidator(ctx, val1)
+	sxk.Sk.SetValidator(ctx, val2)
+
+	bondedAcc := sxk.SupplyKeeper.GetModuleAccount(ctx, staking.BondedPoolName)
+	require.Nil(t, bondedAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("cet", 1000))))
+	sxk.Ak.SetAccount(ctx, bondedAcc)
+
+	return sxk, ctx, val1.OperatorAddress, val2.OperatorAddress
+}
+
+func TestCheckCommissionChange(t *testing.T) {
+	sxk, ctx, val1, _ := setUpValidators(t)
+
+	require.Nil(t, sxk.CheckCommissionChange(ctx, val1, sdk.NewDecWithPrec(21, 2)))
+	require.Nil(t, sxk.CheckCommissionChange(ctx, val1, sdk.NewDecWithPrec(19, 2)))
+	err := sxk.CheckCommissionChange(ctx, val1, sdk.NewDecWithPrec(22, 2))
+	require.Equal(t, stakingx.CodeCommissionChangeTooLarge, err.Code())
+	err = sxk.CheckCommissionChange(ctx, val1, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, stakingx.CodeCommissionChangeTooLarge, err.Code())
+
+	// unknown validators are left to the staking module
+	_, _, addr := testutil.KeyPubAddr()
+	require.Nil(t, sxk.CheckCommissionChange(ctx, sdk.ValAddress(addr), sdk.OneDec()))
+}
+
+func TestCheckValidatorBondedShare(t *testing.T) {
+	sxk, ctx, val1, val2 := setUpValidators(t)
+
+	// no cap by default
+	require.Nil(t, sxk.CheckValidatorBondedShare(ctx, val1, nil, sdk.NewInt(1e8)))
+	require.Nil(t, sxk.CheckNewValidatorBondedShare(ctx, sdk.NewInt(1e8)))
+
+	params := sxk.GetParams(ctx)
+	params.MaxValidatorBondedShare = sdk.NewDecWithPrec(5, 1)
+	sxk.SetParams(ctx, params)
+
+	err := sxk.CheckValidatorBondedShare(ctx, val1, nil, sdk.NewInt(1))
+	require.Equal(t, stakingx.CodeValidatorBondedShareTooLarge, err.Code())
+	require.Nil(t, sxk.CheckValidatorBondedShare(ctx, val2, nil, sdk.NewInt(200)))
+	err = sxk.CheckValidatorBondedShare(ctx, val2, nil, sdk.NewInt(201))
+	require.Equal(t, stakingx.CodeValidatorBondedShareTooLarge, err.Code())
+
+	// a bonded to bonded redelegation does not change the total
+	require.Nil(t, sxk.CheckValidatorBondedShare(ctx, val2, val1, sdk.NewInt(100)))
+	err = sxk.CheckValidatorBondedShare(ctx, val2, val1, sdk.NewInt(101))
+	require.Equal(t, stakingx.CodeValidatorBondedShareTooLarge, err.Code())
+
+	// neither does a delegation to an unbonded validator
+	validator, _ := sxk.Sk.GetValidator(ctx, val2)
+	validator.Status = sdk.Unbonded
+	sxk.Sk.SetValidator(ctx, validator)
+	require.Nil(t, sxk.CheckValidatorBondedShare(ctx, val2, nil, sdk.NewInt(100)))
+	err = sxk.CheckValidatorBondedShare(ctx, val2, nil, sdk.NewInt(101))
+	require.Equal(t, stakingx.CodeValidatorBondedShareTooLarge, err.Code())
+
+	// nor the self-delegation of a new validator
+	require.Nil(t, sxk.CheckNewValidatorBondedShare(ctx, sdk.NewInt(500)))
+	err = sxk.CheckNewValidatorBondedShare(ctx, sdk.NewInt(501))
+	require.Equal(t, stakingx.CodeValidatorBondedShareTooLarge, err.Code())
+}
+
+func TestQueryCompliance(t *testing.T) {
+	sxk, ctx, val1, val2 := setUpValidators(t)
+	params := sxk.GetParams(ctx)
+	params.MaxValidatorBondedShare = sdk.NewDecWithPrec(5, 1)
+	sxk.SetParams(ctx, params)
+
+	cdc := codec.New()
+	querier := keepers.NewQuerier(sxk.Keeper, cdc)
+	res, err := querier(ctx, []string{keepers.QueryCompliance}, abci.RequestQuery{})
+	require.Nil(t, err)
+
+	var compliance []stakingx.ValidatorCompliance
+	cdc.MustUnmarshalJSON(res, &compliance)
+	require.Len(t, compliance, 2)
+
+	byAddr := make(map[string]stakingx.ValidatorCompliance)
+	for _, c := range compliance {
+		byAddr[c.OperatorAddress.String()] = c
+	}
+
+	c1 := byAddr[val1.String()]
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), c1.BondedShare)
+	require.True(t, c1.MeetsMinSelfDelegation)
+	require.True(t, c1.MeetsMinCommissionRate)
+	require.False(t, c1.WithinMaxBondedShare)
+	require.False(t, c1.Compliant)
+
+	c2 := byAddr[val2.String()]
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), c2.BondedShare)
+	require.False(t, c2.MeetsMinSelfDelegation)
+	require.False(t, c2.MeetsMinCommissionRate)
+	require.True(t, c2.WithinMaxBondedShare)
+	require.False(t, c2.Compliant)
+}
@@ -13,6 +13,10 @@ import (
 const (
 	QueryPool       = "pool"
 	QueryParameters = "parameters"
+	QueryCompliance = "compliance"
+
+	QueryNonBondableAddresses        = "non-bondable-addresses"
+	QueryNonBondableAddressesChanges = "non-bondable-addresses-changes"
 )
 
 type BondPool struct {
@@ -30,6 +34,12 @@ func NewQuerier(k Keeper, cdc *codec.Codec) sdk.Querier {
 			return queryBondPool(ctx, cdc, k)
 		case QueryParameters:
 			return queryParameters(ctx, cdc, k)
+		case QueryCompliance:
+			return queryCompliance(ctx, cdc, k)
+		case QueryNonBondableAddresses:
+			return queryNonBondableAddresses(ctx, cdc, k)
+		case QueryNonBondableAddressesChanges:
+			return queryNonBondableAddressesChanges(ctx, cdc, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown stakingx query endpoint")
 		}
@@ -59,3 +69,36 @@ func queryParameters(ctx sdk.Context, cdc *codec.Codec, k Keeper) ([]byte, sdk.E
 
 	return res, nil
 }
+
+func queryCompliance(ctx sdk.Context, cdc *codec.Codec, k Keeper) ([]byte, sdk.Error) {
+	compliance := k.GetValidatorsCompliance(ctx)
+
+	res, err := codec.MarshalJSONIndent(cdc, compliance)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryNonBondableAddresses(ctx sdk.Context, cdc *codec.Codec, k Keeper) ([]byte, sdk.Error) {
+	addresses := k.GetNonBondableAddresses(ctx)
+
+	res, err := codec.MarshalJSONIndent(cdc, addresses)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryNonBondableAddressesChanges(ctx sdk.Context, cdc *codec.Codec, k Keeper) ([]byte, sdk.Error) {
+	changes := k.GetNonBondableAddressesChanges(ctx)
+
+	res, err := codec.MarshalJSONIndent(cdc, changes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
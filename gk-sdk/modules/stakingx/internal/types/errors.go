@@ -11,6 +11,13 @@ const (
 	CodeInvalidMinSelfDelegation        sdk.CodeType = 401
 	CodeMinSelfDelegationBelowRequired  sdk.CodeType = 402
 	CodeBelowMinMandatoryCommissionRate sdk.CodeType = 403
+	CodeInvalidMaxCommissionChangeRate  sdk.CodeType = 404
+	CodeInvalidMaxValidatorBondedShare  sdk.CodeType = 405
+	CodeCommissionChangeTooLarge        sdk.CodeType = 406
+	CodeValidatorBondedShareTooLarge    sdk.CodeType = 407
+	CodeInvalidNonBondableAddressChange sdk.CodeType = 408
+	CodeNonBondableAddressExists        sdk.CodeType = 409
+	CodeNonBondableAddressNotFound      sdk.CodeType = 410
 )
 
 func ErrInvalidMinSelfDelegation(val int64) sdk.Error {
@@ -27,3 +34,38 @@ func ErrRateBelowMinMandatoryCommissionRate(expected, actual sdk.Dec) sdk.Error
 	return sdk.NewError(CodeSpaceStakingX, CodeBelowMinMandatoryCommissionRate,
 		"commission rate is %v, less than min mandatory commission rate %v", actual, expected)
 }
+
+func ErrInvalidMaxCommissionChangeRate(rate sdk.Dec) sdk.Error {
+	return sdk.NewError(CodeSpaceStakingX, CodeInvalidMaxCommissionChangeRate,
+		"invalid max commission change rate: %v", rate)
+}
+
+func ErrInvalidMaxValidatorBondedShare(share sdk.Dec) sdk.Error {
+	return sdk.NewError(CodeSpaceStakingX, CodeInvalidMaxValidatorBondedShare,
+		"invalid max validator bonded share: %v", share)
+}
+
+func ErrCommissionChangeTooLarge(max, actual sdk.Dec) sdk.Error {
+	return sdk.NewError(CodeSpaceStakingX, CodeCommissionChangeTooLarge,
+		"commission rate change is %v, more than max commission change rate %v", actual, max)
+}
+
+func ErrValidatorBondedShareTooLarge(max, actual sdk.Dec) sdk.Error {
+	return sdk.NewError(CodeSpaceStakingX, CodeValidatorBondedShareTooLarge,
+		"validator bonded share would be %v, more than max validator bonded share %v", actual, max)
+}
+
+func ErrInvalidNonBondableAddressChange(reason string) sdk.Error {
+	return sdk.NewError(CodeSpaceStakingX, CodeInvalidNonBondableAddressChange,
+		"invalid non-bondable address change: %s", reason)
+}
+
+func ErrNonBondableAddressExists(addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(CodeSpaceStakingX, CodeNonBondableAddressExists,
+		"%s is already a non-bondable address", addr)
+}
+
+func ErrNonBondableAddressNotFound(addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(CodeSpaceStakingX, CodeNonBondableAddressNotFound,
+		"%s is not a non-bondable address", addr)
+}
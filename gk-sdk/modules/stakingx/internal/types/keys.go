@@ -11,4 +11,7 @@ const (
 
 	// QuerierRoute is the querier route for stakingx
 	QuerierRoute = ModuleName
+
+	// RouterKey is the gov proposal route for stakingx
+	RouterKey = ModuleName
 )
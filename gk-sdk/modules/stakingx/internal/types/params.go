@@ -14,8 +14,13 @@ const (
 var (
 	KeyMinSelfDelegation          = []byte("MinSelfDelegation")
 	KeyMinMandatoryCommissionRate = []byte("MinMandatoryCommissionRate")
+	KeyMaxCommissionChangeRate    = []byte("MaxCommissionChangeRate")
+	KeyMaxValidatorBondedShare    = []byte("MaxValidatorBondedShare")
 
 	DefaultMinMandatoryCommissionRate = sdk.NewDecWithPrec(1, 1)
+	DefaultMaxCommissionChangeRate    = sdk.NewDecWithPrec(1, 2)
+	// a share of 1 means no validator is ever capped
+	DefaultMaxValidatorBondedShare = sdk.OneDec()
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -24,6 +29,11 @@ var _ params.ParamSet = (*Params)(nil)
 type Params struct {
 	MinSelfDelegation          int64   `json:"min_self_delegation"`
 	MinMandatoryCommissionRate sdk.Dec `json:"min_mandatory_commission_rate"`
+	// MaxCommissionChangeRate limits how much a validator may change its commission
+	// rate in one edit, which the staking module allows at most once per day
+	MaxCommissionChangeRate sdk.Dec `json:"max_commission_change_rate"`
+	// MaxValidatorBondedShare caps a single validator's share of total bonded tokens
+	MaxValidatorBondedShare sdk.Dec `json:"max_validator_bonded_share"`
 }
 
 // ParamKeyTable for stakingx module
@@ -36,6 +46,8 @@ func DefaultParams() Params {
 	return Params{
 		MinSelfDelegation:          DefaultMinSelfDelegation,
 		MinMandatoryCommissionRate: DefaultMinMandatoryCommissionRate,
+		MaxCommissionChangeRate:    DefaultMaxCommissionChangeRate,
+		MaxValidatorBondedShare:    DefaultMaxValidatorBondedShare,
 	}
 }
 
@@ -45,5 +57,32 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{Key: KeyMinSelfDelegation, Value: &p.MinSelfDelegation},
 		{Key: KeyMinMandatoryCommissionRate, Value: &p.MinMandatoryCommissionRate},
+		{Key: KeyMaxCommissionChangeRate, Value: &p.MaxCommissionChangeRate},
+		{Key: KeyMaxValidatorBondedShare, Value: &p.MaxValidatorBondedShare},
 	}
 }
+
+// ValidateGenesis checks the parameters are within their allowed ranges
+func (p Params) ValidateGenesis() error {
+	if p.MinSelfDelegation <= 0 {
+		return ErrInvalidMinSelfDelegation(p.MinSelfDelegation)
+	}
+	if !isValidRate(p.MaxCommissionChangeRate) {
+		return ErrInvalidMaxCommissionChangeRate(p.MaxCommissionChangeRate)
+	}
+	if !isValidRate(p.MaxValidatorBondedShare) {
+		return ErrInvalidMaxValidatorBondedShare(p.MaxValidatorBondedShare)
+	}
+	return nil
+}
+
+// ValidateParams validates the stakingx params in the subspace
+func ValidateParams(ctx sdk.Context, ss params.Subspace) error {
+	var p Params
+	ss.GetParamSet(ctx, &p)
+	return p.ValidateGenesis()
+}
+
+func isValidRate(rate sdk.Dec) bool {
+	return !rate.IsNil() && rate.IsPositive() && rate.LTE(sdk.OneDec())
+}
@@ -16,6 +16,8 @@ type MergedParams struct {
 	BondDenom                  string        `json:"bond_denom" yaml:"bond_denom"`
 	MinSelfDelegation          int64         `json:"min_self_delegation" yaml:"min_self_delegation"`
 	MinMandatoryCommissionRate sdk.Dec       `json:"min_mandatory_commission_rate" yaml:"min_mandatory_commission_rate"`
+	MaxCommissionChangeRate    sdk.Dec       `json:"max_commission_change_rate" yaml:"max_commission_change_rate"`
+	MaxValidatorBondedShare    sdk.Dec       `json:"max_validator_bonded_share" yaml:"max_validator_bonded_share"`
 }
 
 func NewMergedParams(params staking.Params, paramsx Params) MergedParams {
@@ -26,6 +28,8 @@ func NewMergedParams(params staking.Params, paramsx Params) MergedParams {
 		BondDenom:                  params.BondDenom,
 		MinSelfDelegation:          paramsx.MinSelfDelegation,
 		MinMandatoryCommissionRate: paramsx.MinMandatoryCommissionRate,
+		MaxCommissionChangeRate:    paramsx.MaxCommissionChangeRate,
+		MaxValidatorBondedShare:    paramsx.MaxValidatorBondedShare,
 	}
 }
 
@@ -36,7 +40,10 @@ func (p MergedParams) String() string {
   Max Entries:                   %d
   Bonded Coin Denom:             %s
   Min Self Delegation:           %d
-  Min Mandatory Commission Rate: %s`,
+  Min Mandatory Commission Rate: %s
+  Max Commission Change Rate:    %s
+  Max Validator Bonded Share:    %s`,
 		p.UnbondingTime, p.MaxValidators, p.MaxEntries, p.BondDenom,
-		p.MinSelfDelegation, p.MinMandatoryCommissionRate)
+		p.MinSelfDelegation, p.MinMandatoryCommissionRate,
+		p.MaxCommissionChangeRate, p.MaxValidatorBondedShare)
 }
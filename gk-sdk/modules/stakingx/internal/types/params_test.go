@@ -49,6 +49,8 @@ func TestParamGetSet(t *testing.T) {
 	testParam := stakingx.Params{
 		MinSelfDelegation:          0,
 		MinMandatoryCommissionRate: stakingx.DefaultMinMandatoryCommissionRate,
+		MaxCommissionChangeRate:    stakingx.DefaultMaxCommissionChangeRate,
+		MaxValidatorBondedShare:    stakingx.DefaultMaxValidatorBondedShare,
 	}
 
 	//expect SetParam don't panic
@@ -57,3 +59,19 @@ func TestParamGetSet(t *testing.T) {
 	//expect GetParam equals defaultParam
 	require.Equal(t, testParam, sxk.GetParams(ctx))
 }
+
+func TestParamsValidateGenesis(t *testing.T) {
+	params := stakingx.DefaultParams()
+	require.Nil(t, params.ValidateGenesis())
+
+	params.MaxCommissionChangeRate = sdk.ZeroDec()
+	require.NotNil(t, params.ValidateGenesis())
+
+	params = stakingx.DefaultParams()
+	params.MaxValidatorBondedShare = sdk.NewDecWithPrec(11, 1)
+	require.NotNil(t, params.ValidateGenesis())
+
+	params = stakingx.DefaultParams()
+	params.MinSelfDelegation = 0
+	require.NotNil(t, params.ValidateGenesis())
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeNonBondableAddressesChange defines the type for a NonBondableAddressesChangeProposal
+	ProposalTypeNonBondableAddressesChange = "NonBondableAddressesChange"
+)
+
+// Assert NonBondableAddressesChangeProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = NonBondableAddressesChangeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeNonBondableAddressesChange)
+	govtypes.RegisterProposalTypeCodec(NonBondableAddressesChangeProposal{}, "stakingx/NonBondableAddressesChangeProposal")
+}
+
+// NonBondableAddressesChangeProposal adds and removes the addresses whose CET is
+// excluded from the bondable tokens, such as foundation wallets
+type NonBondableAddressesChangeProposal struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Add         []sdk.AccAddress `json:"add"`
+	Remove      []sdk.AccAddress `json:"remove"`
+}
+
+func NewNonBondableAddressesChangeProposal(title, description string, add, remove []sdk.AccAddress) NonBondableAddressesChangeProposal {
+	return NonBondableAddressesChangeProposal{Title: title, Description: description, Add: add, Remove: remove}
+}
+
+// GetTitle returns the title of a non-bondable addresses change proposal.
+func (p NonBondableAddressesChangeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a non-bondable addresses change proposal.
+func (p NonBondableAddressesChangeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a non-bondable addresses change proposal.
+func (p NonBondableAddressesChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a non-bondable addresses change proposal.
+func (p NonBondableAddressesChangeProposal) ProposalType() string {
+	return ProposalTypeNonBondableAddressesChange
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p NonBondableAddressesChangeProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(CodeSpaceStakingX, p); err != nil {
+		return err
+	}
+	if len(p.Add) == 0 && len(p.Remove) == 0 {
+		return ErrInvalidNonBondableAddressChange("no address is added or removed")
+	}
+
+	seen := make(map[string]bool, len(p.Add)+len(p.Remove))
+	for _, addr := range append(append([]sdk.AccAddress{}, p.Add...), p.Remove...) {
+		if addr.Empty() {
+			return ErrInvalidNonBondableAddressChange("empty address")
+		}
+		if seen[addr.String()] {
+			return ErrInvalidNonBondableAddressChange(fmt.Sprintf("duplicated address %s", addr))
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p NonBondableAddressesChangeProposal) String() string {
+	return fmt.Sprintf(`Non-Bondable Addresses Change Proposal:
+  Title:       %s
+  Description: %s
+  Add:         %v
+  Remove:      %v
+`, p.Title, p.Description, p.Add, p.Remove)
+}
+
+// NonBondableAddressesChange is an audit record of a passed NonBondableAddressesChangeProposal
+type NonBondableAddressesChange struct {
+	Height  int64            `json:"height"`
+	Title   string           `json:"title"`
+	Added   []sdk.AccAddress `json:"added"`
+	Removed []sdk.AccAddress `json:"removed"`
+}
@@ -17,6 +17,7 @@ import (
 	stakingx_cli "github.com/coinexchain/cet-sdk/modules/stakingx/client/cli"
 	stakingx_rest "github.com/coinexchain/cet-sdk/modules/stakingx/client/rest"
 	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/types"
 )
 
 var (
@@ -34,6 +35,7 @@ func (AppModuleBasic) Name() string {
 
 // register module codec
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
 }
 
 // default genesis state
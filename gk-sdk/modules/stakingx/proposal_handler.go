@@ -0,0 +1,24 @@
+package stakingx
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/keepers"
+	"github.com/coinexchain/cet-sdk/modules/stakingx/internal/types"
+)
+
+func NewProposalHandler(k keepers.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.NonBondableAddressesChangeProposal:
+			return k.ChangeNonBondableAddresses(ctx, c.Title, c.Add, c.Remove)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized stakingx proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package stakingx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/coinexchain/cet-sdk/modules/stakingx"
+	"github.com/coinexchain/cet-sdk/testapp"
+	"github.com/coinexchain/cet-sdk/testutil"
+)
+
+func TestNonBondableAddressesChangeProposal(t *testing.T) {
+	app := testapp.NewTestApp()
+	ctx := app.NewCtx().WithBlockHeight(10)
+	foundation := testutil.ToAccAddress("foundation__________")
+	other := testutil.ToAccAddress("other_______________")
+	app.StakingXKeeper.SetNonBondableAddresses(ctx, []sdk.AccAddress{other})
+
+	handler := stakingx.NewProposalHandler(app.StakingXKeeper)
+	p := stakingx.NewNonBondableAddressesChangeProposal("title", "desc", []sdk.AccAddress{foundation}, []sdk.AccAddress{other})
+	require.Nil(t, handler(ctx, p))
+	require.Equal(t, []sdk.AccAddress{foundation}, app.StakingXKeeper.GetNonBondableAddresses(ctx))
+
+	// already added or not present
+	p = stakingx.NewNonBondableAddressesChangeProposal("title", "desc", []sdk.AccAddress{foundation}, nil)
+	require.Equal(t, stakingx.CodeNonBondableAddressExists, handler(ctx, p).Code())
+	p = stakingx.NewNonBondableAddressesChangeProposal("title", "desc", nil, []sdk.AccAddress{other})
+	require.Equal(t, stakingx.CodeNonBondableAddressNotFound, handler(ctx, p).Code())
+
+	changes := app.StakingXKeeper.GetNonBondableAddressesChanges(ctx)
+	require.Equal(t, []stakingx.NonBondableAddressesChange{{
+		Height:  10,
+		Title:   "title",
+		Added:   []sdk.AccAddress{foundation},
+		Removed: []sdk.AccAddress{other},
+	}}, changes)
+
+	res, err := stakingx.NewAppModule(app.StakingXKeeper).NewQuerierHandler()(ctx,
+		[]string{stakingx.QueryNonBondableAddressesChanges}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.Contains(t, string(res), foundation.String())
+
+	require.Equal(t, sdk.CodeUnknownRequest, handler(ctx, govtypes.TextProposal{}).Code())
+}
+
+func TestNonBondableAddressesChangeProposalValidateBasic(t *testing.T) {
+	addr := testutil.ToAccAddress("foundation__________")
+	require.Nil(t, stakingx.NewNonBondableAddressesChangeProposal("t", "d", []sdk.AccAddress{addr}, nil).ValidateBasic())
+	require.NotNil(t, stakingx.NewNonBondableAddressesChangeProposal("t", "d", nil, nil).ValidateBasic())
+	require.NotNil(t, stakingx.NewNonBondableAddressesChangeProposal("t", "d", []sdk.AccAddress{addr}, []sdk.AccAddress{addr}).ValidateBasic())
+	require.NotNil(t, stakingx.NewNonBondableAddressesChangeProposal("", "d", []sdk.AccAddress{addr}, nil).ValidateBasic())
+}
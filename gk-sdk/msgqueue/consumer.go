@@ -0,0 +1,392 @@
+package msgqueue
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// ConsumerPollInterval is the interval to check a dir source for new messages
+var ConsumerPollInterval = 100 * time.Millisecond
+
+// ConsumedMsg is a message of a block. Value is the payload decoded into the type registered
+// with RegisterSchema, e.g. market.FillOrderInfo for fill_order_info, or nil if the topic has no schema.
+type ConsumedMsg struct {
+	Topic   string
+	Payload []byte
+	Value   interface{}
+}
+
+// Block holds the messages between the height_info and the commit of a block
+type Block struct {
+	Height int64
+	Info   NewHeightInfo
+	Msgs   []ConsumedMsg
+}
+
+// ConsumerCheckpoint is the position after the last consumed block
+type ConsumerCheckpoint struct {
+	Height    int64 `json:"height"`
+	FileIndex int   `json:"file_index"`
+	Offset    int64 `json:"offset"`
+}
+
+// Consumer tails the messages written by a dir, prune or pipe writer and delivers them block by block.
+// Its checkpoint is saved after every block, so a restarted consumer continues with the next block.
+// The files removed by FileDeleter are skipped, the blocks in them are lost if they were not consumed.
+type Consumer struct {
+	source         string
+	encoding       string
+	path           string
+	checkpointFile string
+	checkpoint     ConsumerCheckpoint
+	log            log.Logger
+
+	// the position of the next line, which may be in the middle of a block
+	fileIndex int
+	offset    int64
+	height    int64
+	block     []StreamMsg
+
+	pipe       *os.File
+	pipeReader *bufio.Reader
+	// the block of a pipe whose handler failed, which can not be read from the pipe again
+	failed *Block
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewConsumer creates a consumer of source, which is configured like the writers, i.e. dir:path, prune:path
+// or pipe:path, with ?encoding=json if the messages are written in JSON envelopes.
+// The checkpoint is loaded from and saved to checkpointFile unless it is empty.
+func NewConsumer(source, checkpointFile string) (*Consumer, error) {
+	source, opts, err := splitOptions(source)
+	if err != nil {
+		return nil, err
+	}
+	if opts.encoding == EncodingProto {
+		return nil, fmt.Errorf("the lines of %s can not hold the %s encoding", source, opts.encoding)
+	}
+	c := &Consumer{
+		source:         source,
+		encoding:       opts.encoding,
+		checkpointFile: checkpointFile,
+		done:           make(chan struct{}),
+	}
+	switch {
+	case strings.HasPrefix(source, CfgPrefixDir):
+		c.path = strings.TrimPrefix(source, CfgPrefixDir)
+	case strings.HasPrefix(source, CfgPrefixPrune):
+		c.path = strings.TrimPrefix(source, CfgPrefixPrune)
+	case strings.HasPrefix(source, CfgNamedPipe):
+		c.path = strings.TrimPrefix(source, CfgNamedPipe)
+	default:
+		return nil, fmt.Errorf("unsupported consumer source: %s", source)
+	}
+	if len(checkpointFile) != 0 {
+		bz, err := ioutil.ReadFile(checkpointFile)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(bz, &c.checkpoint); err != nil {
+				return nil, fmt.Errorf("invalid checkpoint file %s: %s", checkpointFile, err.Error())
+			}
+		}
+	}
+	if !c.isPipe() {
+		if err := c.seekCheckpoint(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *Consumer) SetLogger(logger log.Logger) {
+	c.log = logger
+}
+
+// Checkpoint returns the position after the last consumed block
+func (c *Consumer) Checkpoint() ConsumerCheckpoint {
+	return c.checkpoint
+}
+
+// Run calls handler with the blocks until the consumer is closed. If handler fails, Run returns its error
+// and the block is delivered again by the next call of Run. The failed block of a pipe source is only
+// kept in memory, it is lost if the consumer is recreated.
+func (c *Consumer) Run(handler func(Block) error) error {
+	if c.isPipe() {
+		return c.runPipe(handler)
+	}
+	for {
+		progressed, err := c.readDir(handler)
+		if err != nil {
+			_ = c.seekCheckpoint()
+			return err
+		}
+		if progressed {
+			continue
+		}
+		select {
+		case <-c.done:
+			return nil
+		case <-time.After(ConsumerPollInterval):
+		}
+	}
+}
+
+// Close stops Run
+func (c *Consumer) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		if c.pipe != nil {
+			_ = c.pipe.Close()
+		}
+	})
+}
+
+func (c *Consumer) isPipe() bool {
+	return strings.HasPrefix(c.source, CfgNamedPipe)
+}
+
+func (c *Consumer) isClosed() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// seekCheckpoint moves to the checkpoint, or to the first file if there is no checkpoint
+func (c *Consumer) seekCheckpoint() error {
+	c.fileIndex, c.offset, c.block = c.checkpoint.FileIndex, c.checkpoint.Offset, nil
+	if c.checkpoint.Height != 0 {
+		return nil
+	}
+	indexes, err := getFileIndexes(c.path)
+	if err != nil {
+		return err
+	}
+	if len(indexes) != 0 {
+		c.fileIndex, c.offset = indexes[0], 0
+	}
+	return nil
+}
+
+// readDir reads the complete lines of the current file, and moves to the next file after the current one
+// is finished. It returns false if there is nothing to read.
+func (c *Consumer) readDir(handler func(Block) error) (bool, error) {
+	// the writer has finished the current file if the next one exists
+	_, err := os.Stat(GetFileName(c.path, c.fileIndex+1))
+	hasNext := err == nil
+	file, err := os.Open(GetFileName(c.path, c.fileIndex))
+	if os.IsNotExist(err) {
+		return c.skipRemovedFile()
+	} else if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	if _, err := file.Seek(c.offset, io.SeekStart); err != nil {
+		return false, err
+	}
+
+	progressed := false
+	reader := bufio.NewReader(file)
+	for !c.isClosed() {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			// io.EOF, or the partial line being written
+			break
+		}
+		c.offset += int64(len(line))
+		progressed = true
+		if err := c.consumeLine(line, handler); err != nil {
+			return false, err
+		}
+	}
+	if hasNext && !c.isClosed() {
+		// a block written by a dir writer may continue in the next file
+		c.fileIndex, c.offset = c.fileIndex+1, 0
+		return true, nil
+	}
+	return progressed, nil
+}
+
+// skipRemovedFile moves to the first file after the one removed by FileDeleter
+func (c *Consumer) skipRemovedFile() (bool, error) {
+	indexes, err := getFileIndexes(c.path)
+	if err != nil {
+		return false, err
+	}
+	for _, index := range indexes {
+		if index > c.fileIndex {
+			if c.log != nil {
+				c.log.Error(fmt.Sprintf("file %s has been removed, skip to %s",
+					GetFileName(c.path, c.fileIndex), GetFileName(c.path, index)))
+			}
+			c.fileIndex, c.offset, c.block = index, 0, nil
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *Consumer) runPipe(handler func(Block) error) error {
+	if c.pipe == nil {
+		file, err := os.OpenFile(c.path, os.O_RDONLY, os.ModeNamedPipe)
+		if err != nil {
+			return err
+		}
+		c.pipe = file
+		// the lines read ahead are kept for the next call of Run
+		c.pipeReader = bufio.NewReader(file)
+	}
+	if c.failed != nil {
+		if err := c.deliver(*c.failed, handler); err != nil {
+			return err
+		}
+		c.failed = nil
+	}
+	for {
+		line, err := c.pipeReader.ReadBytes('\n')
+		if c.isClosed() {
+			return nil
+		}
+		if err == io.EOF {
+			// no writer has opened the pipe
+			time.Sleep(ConsumerPollInterval)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := c.consumeLine(line, handler); err != nil {
+			c.block = nil
+			return err
+		}
+	}
+}
+
+func (c *Consumer) consumeLine(line []byte, handler func(Block) error) error {
+	msg, ok := parseLine(line, &c.height)
+	if !ok {
+		return nil
+	}
+	if err := c.openEnvelope(&msg); err != nil {
+		return err
+	}
+	if msg.Topic == heightInfoKey {
+		// an incomplete block left by a crash is written again
+		c.block = c.block[:0]
+	} else if len(c.block) == 0 {
+		// the rest of a block whose height_info is not read
+		return nil
+	}
+	// the payload of parseLine refers to line
+	msg.Payload = append([]byte(nil), msg.Payload...)
+	c.block = append(c.block, msg)
+	if msg.Topic != commitKey {
+		return nil
+	}
+
+	block := c.block
+	c.block = nil
+	if block[0].Height <= c.checkpoint.Height {
+		return nil
+	}
+	b, err := decodeBlock(block)
+	if err != nil {
+		return err
+	}
+	if err := c.deliver(b, handler); err != nil {
+		if c.isPipe() {
+			c.failed = &b
+		}
+		return err
+	}
+	return nil
+}
+
+// deliver calls handler with a block and saves the checkpoint after it
+func (c *Consumer) deliver(b Block, handler func(Block) error) error {
+	if err := handler(b); err != nil {
+		return err
+	}
+	return c.saveCheckpoint(ConsumerCheckpoint{Height: b.Height, FileIndex: c.fileIndex, Offset: c.offset})
+}
+
+// openEnvelope replaces the payload of msg with the one in its envelope,
+// and fails if msg is not written with the encoding of the consumer
+func (c *Consumer) openEnvelope(msg *StreamMsg) error {
+	if c.encoding == EncodingRaw {
+		// every block starts with a height_info, checking it is enough
+		if msg.Topic == heightInfoKey && isJSONEnvelope(msg.Topic, msg.Payload) {
+			return fmt.Errorf("the messages of %s are in JSON envelopes, add ?encoding=%s to the source",
+				c.source, EncodingJSON)
+		}
+		return nil
+	}
+	env, err := DecodeEnvelope(c.encoding, msg.Payload)
+	if err != nil || env.Type != msg.Topic {
+		return fmt.Errorf("the %s after height %d in %s is not in a %s envelope",
+			msg.Topic, c.height, c.source, c.encoding)
+	}
+	c.height = env.Height
+	msg.Height, msg.Payload = env.Height, env.Payload
+	return nil
+}
+
+func isJSONEnvelope(topic string, payload []byte) bool {
+	var env jsonEnvelope
+	return json.Unmarshal(payload, &env) == nil && env.Type == topic && len(env.Payload) != 0
+}
+
+func decodeBlock(msgs []StreamMsg) (Block, error) {
+	var b Block
+	if err := json.Unmarshal(msgs[0].Payload, &b.Info); err != nil {
+		return b, err
+	}
+	b.Height = b.Info.Height
+	b.Msgs = make([]ConsumedMsg, 0, len(msgs)-2)
+	for _, msg := range msgs[1 : len(msgs)-1] {
+		cm := ConsumedMsg{Topic: msg.Topic, Payload: msg.Payload}
+		if rt, ok := payloadType(msg.Topic); ok {
+			ptr := reflect.New(rt)
+			if err := json.Unmarshal(msg.Payload, ptr.Interface()); err != nil {
+				return b, fmt.Errorf("decode %s at height %d failed: %s", msg.Topic, b.Height, err.Error())
+			}
+			cm.Value = ptr.Elem().Interface()
+		}
+		b.Msgs = append(b.Msgs, cm)
+	}
+	return b, nil
+}
+
+// saveCheckpoint replaces the checkpoint file atomically
+func (c *Consumer) saveCheckpoint(checkpoint ConsumerCheckpoint) error {
+	if len(c.checkpointFile) != 0 {
+		bz, err := json.Marshal(checkpoint)
+		if err != nil {
+			return err
+		}
+		tmp := c.checkpointFile + ".tmp"
+		if err := ioutil.WriteFile(tmp, bz, 0644); err != nil {
+			return err
+		}
+		if err := os.Rename(tmp, c.checkpointFile); err != nil {
+			return err
+		}
+	}
+	c.checkpoint = checkpoint
+	return nil
+}
@@ -0,0 +1,208 @@
+package msgqueue
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeConsumerBlock(t *testing.T, w MsgWriter, height int64) {
+	require.NoError(t, w.WriteKV([]byte("height_info"), []byte(fmt.Sprintf(`{"height":%d}`, height))))
+	require.NoError(t, w.WriteKV([]byte(testOrderTopic), []byte(fmt.Sprintf(`{"order_id":"o-%d","quantity":%d}`, height, height))))
+	require.NoError(t, w.WriteKV([]byte("unknown_info"), []byte(`{"a":1}`)))
+	require.NoError(t, w.WriteKV([]byte("commit"), []byte("{}")))
+}
+
+func runConsumer(c *Consumer, handler func(Block) error) (chan Block, chan error) {
+	blocks, res := make(chan Block, 100), make(chan error, 1)
+	go func() {
+		res <- c.Run(func(b Block) error {
+			if err := handler(b); err != nil {
+				return err
+			}
+			blocks <- b
+			return nil
+		})
+	}()
+	return blocks, res
+}
+
+func nextBlock(t *testing.T, blocks chan Block) Block {
+	select {
+	case b := <-blocks:
+		return b
+	case <-time.After(5 * time.Second):
+		t.Fatal("no block is consumed")
+		return Block{}
+	}
+}
+
+func TestConsumer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consumer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	interval, maxSize := ConsumerPollInterval, MaxFileSize
+	ConsumerPollInterval, MaxFileSize = time.Millisecond, 300
+	defer func() { ConsumerPollInterval, MaxFileSize = interval, maxSize }()
+
+	// the blocks are split into several files by the size of the files
+	w, err := NewDirMsgWriter(dir, getFilePathAndIndex)
+	require.NoError(t, err)
+	for h := int64(1); h <= 3; h++ {
+		writeConsumerBlock(t, w, h)
+	}
+	require.NoError(t, w.WriteKV([]byte("height_info"), []byte(`{"height":4}`)))
+
+	checkpoint := filepath.Join(dir, "checkpoint.json")
+	c, err := NewConsumer(CfgPrefixDir+dir, checkpoint)
+	require.NoError(t, err)
+	fail := true
+	blocks, res := runConsumer(c, func(b Block) error {
+		if b.Height == 2 && fail {
+			fail = false
+			return errors.New("handler failed")
+		}
+		return nil
+	})
+	b := nextBlock(t, blocks)
+	require.EqualValues(t, 1, b.Height)
+	require.EqualValues(t, 1, b.Info.Height)
+	require.Len(t, b.Msgs, 2)
+	require.Equal(t, testOrderInfo{OrderID: "o-1", Quantity: 1}, b.Msgs[0].Value)
+	require.Nil(t, b.Msgs[1].Value)
+	require.Equal(t, `{"a":1}`, string(b.Msgs[1].Payload))
+
+	// the failed block is delivered again
+	require.EqualError(t, <-res, "handler failed")
+	require.EqualValues(t, 1, c.Checkpoint().Height)
+	blocks, res = runConsumer(c, func(Block) error { return nil })
+	require.EqualValues(t, 2, nextBlock(t, blocks).Height)
+	require.EqualValues(t, 3, nextBlock(t, blocks).Height)
+
+	require.NoError(t, w.WriteKV([]byte(testOrderTopic), []byte(`{"order_id":"o-4"}`)))
+	require.NoError(t, w.WriteKV([]byte("commit"), []byte("{}")))
+	require.EqualValues(t, 4, nextBlock(t, blocks).Height)
+	c.Close()
+	require.NoError(t, <-res)
+	require.True(t, c.Checkpoint().FileIndex > 0)
+
+	// a restarted consumer continues from its checkpoint, skipping the removed files
+	for h := int64(5); h <= 8; h++ {
+		writeConsumerBlock(t, w, h)
+	}
+	require.NoError(t, w.Close())
+	indexes, err := getFileIndexes(dir)
+	require.NoError(t, err)
+	for _, index := range indexes {
+		if index <= c.Checkpoint().FileIndex {
+			require.NoError(t, os.Remove(GetFileName(dir, index)))
+		}
+	}
+	c, err = NewConsumer(CfgPrefixDir+dir, checkpoint)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, c.Checkpoint().Height)
+	blocks, res = runConsumer(c, func(Block) error { return nil })
+	first := nextBlock(t, blocks).Height
+	require.True(t, first > 4)
+	for h := first + 1; h <= 8; h++ {
+		require.EqualValues(t, h, nextBlock(t, blocks).Height)
+	}
+	c.Close()
+	require.NoError(t, <-res)
+
+	_, err = NewConsumer("kafka:localhost:9092", "")
+	require.Error(t, err)
+}
+
+func TestConsumerOfPipe(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consumer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	interval := ConsumerPollInterval
+	ConsumerPollInterval = time.Millisecond
+	defer func() { ConsumerPollInterval = interval }()
+
+	// the consumer reads a pipe like a file which never ends
+	path := filepath.Join(dir, "pipe")
+	w, err := NewFileMsgWriter(path)
+	require.NoError(t, err)
+	for h := int64(1); h <= 3; h++ {
+		writeConsumerBlock(t, w, h)
+	}
+	require.NoError(t, w.Close())
+
+	c, err := NewConsumer(CfgNamedPipe+path, "")
+	require.NoError(t, err)
+	blocks, res := runConsumer(c, func(b Block) error {
+		if b.Height == 2 {
+			return errors.New("handler failed")
+		}
+		return nil
+	})
+	require.EqualValues(t, 1, nextBlock(t, blocks).Height)
+	require.EqualError(t, <-res, "handler failed")
+
+	// the failed block is kept, and so are the lines read after it
+	blocks, res = runConsumer(c, func(Block) error { return nil })
+	require.EqualValues(t, 2, nextBlock(t, blocks).Height)
+	require.EqualValues(t, 3, nextBlock(t, blocks).Height)
+	c.Close()
+	require.NoError(t, <-res)
+}
+
+func TestConsumerOfEnvelopes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consumer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	interval := ConsumerPollInterval
+	ConsumerPollInterval = time.Millisecond
+	defer func() { ConsumerPollInterval = interval }()
+
+	dw, err := NewDirMsgWriter(dir, getFilePathAndIndex)
+	require.NoError(t, err)
+	w := NewEncodingMsgWriter(dw, EncodingJSON)
+	writeConsumerBlock(t, w, 1)
+	writeConsumerBlock(t, w, 2)
+	require.NoError(t, w.Close())
+
+	c, err := NewConsumer(CfgPrefixDir+dir+"?encoding=json", "")
+	require.NoError(t, err)
+	blocks, res := runConsumer(c, func(Block) error { return nil })
+	b := nextBlock(t, blocks)
+	require.EqualValues(t, 1, b.Height)
+	require.EqualValues(t, 1, b.Info.Height)
+	require.Len(t, b.Msgs, 2)
+	require.Equal(t, testOrderInfo{OrderID: "o-1", Quantity: 1}, b.Msgs[0].Value)
+	require.Equal(t, `{"a":1}`, string(b.Msgs[1].Payload))
+	require.EqualValues(t, 2, nextBlock(t, blocks).Height)
+	c.Close()
+	require.NoError(t, <-res)
+
+	// the envelopes are not taken for raw messages
+	c, err = NewConsumer(CfgPrefixDir+dir, "")
+	require.NoError(t, err)
+	_, res = runConsumer(c, func(Block) error { return nil })
+	require.EqualError(t, <-res, fmt.Sprintf("the messages of %s are in JSON envelopes, add ?encoding=json to the source",
+		CfgPrefixDir+dir))
+
+	// and the raw messages are not taken for envelopes
+	rawDir := filepath.Join(dir, "raw")
+	require.NoError(t, os.Mkdir(rawDir, 0755))
+	rw, err := NewDirMsgWriter(rawDir, getFilePathAndIndex)
+	require.NoError(t, err)
+	writeConsumerBlock(t, rw, 1)
+	require.NoError(t, rw.Close())
+	c, err = NewConsumer(CfgPrefixDir+rawDir+"?encoding=json", "")
+	require.NoError(t, err)
+	_, res = runConsumer(c, func(Block) error { return nil })
+	require.Error(t, <-res)
+
+	_, err = NewConsumer(CfgPrefixDir+dir+"?encoding=proto", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,146 @@
+package msgqueue
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// The encodings of the messages, selected per writer with the encoding option of its config,
+// e.g. kafka:broker1,broker2?encoding=json
+const (
+	// EncodingRaw writes the payload as it is, the layout used before the envelopes were added
+	EncodingRaw = "raw"
+	// EncodingJSON writes a JSON envelope: {"type":"<topic>","version":1,"height":100,"payload":{...}}
+	EncodingJSON = "json"
+	// EncodingProto writes the protobuf Envelope of msgqueue.proto, whose payload is encoded with
+	// the field numbers of the topic's schema. Topics without a schema keep their JSON payload.
+	EncodingProto = "proto"
+)
+
+type jsonEnvelope struct {
+	Type    string          `json:"type"`
+	Version uint32          `json:"version"`
+	Height  int64           `json:"height"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// the payloads are encoded without registering any interface, a topic whose payload
+// can not be encoded this way keeps its JSON payload
+var payloadCdc = codec.New()
+
+// NewEnvelope wraps the JSON payload of a topic
+func NewEnvelope(topic string, height int64, payload []byte) Envelope {
+	return Envelope{
+		Type:    topic,
+		Version: SchemaVersion(topic),
+		Height:  height,
+		Payload: payload,
+	}
+}
+
+// Encode encodes the envelope, whose payload is JSON, with one of the encodings
+func (m Envelope) Encode(encoding string) ([]byte, error) {
+	switch encoding {
+	case EncodingRaw:
+		return m.Payload, nil
+	case EncodingJSON:
+		return json.Marshal(jsonEnvelope{Type: m.Type, Version: m.Version, Height: m.Height, Payload: m.Payload})
+	case EncodingProto:
+		if bz, ok := jsonToProto(m.Type, m.Payload); ok {
+			m.Payload, m.PayloadFormat = bz, PayloadFormat_PROTO
+		}
+		return proto.Marshal(&m)
+	default:
+		return nil, fmt.Errorf("unsupported encoding: %s", encoding)
+	}
+}
+
+// DecodeEnvelope decodes a message written with one of the encodings,
+// a raw message has no envelope so only its payload is filled
+func DecodeEnvelope(encoding string, bz []byte) (Envelope, error) {
+	var m Envelope
+	switch encoding {
+	case EncodingRaw:
+		m.Payload = bz
+	case EncodingJSON:
+		var env jsonEnvelope
+		if err := json.Unmarshal(bz, &env); err != nil {
+			return m, err
+		}
+		m = Envelope{Type: env.Type, Version: env.Version, Height: env.Height, Payload: env.Payload}
+	case EncodingProto:
+		if err := proto.Unmarshal(bz, &m); err != nil {
+			return m, err
+		}
+	default:
+		return m, fmt.Errorf("unsupported encoding: %s", encoding)
+	}
+	return m, nil
+}
+
+// DecodePayload decodes the payload into ptr, which points to the registered type of the topic
+// when the payload is in the protobuf format
+func (m Envelope) DecodePayload(ptr interface{}) error {
+	if m.PayloadFormat == PayloadFormat_PROTO {
+		return payloadCdc.UnmarshalBinaryBare(m.Payload, ptr)
+	}
+	return json.Unmarshal(m.Payload, ptr)
+}
+
+func jsonToProto(topic string, payload []byte) (bz []byte, ok bool) {
+	rt, ok := payloadType(topic)
+	if !ok {
+		return nil, false
+	}
+	// amino panics on the types it does not support
+	defer func() {
+		if r := recover(); r != nil {
+			bz, ok = nil, false
+		}
+	}()
+	ptr := reflect.New(rt)
+	if err := json.Unmarshal(payload, ptr.Interface()); err != nil {
+		return nil, false
+	}
+	bz, err := payloadCdc.MarshalBinaryBare(ptr.Elem().Interface())
+	return bz, err == nil
+}
+
+var _ MsgWriter = (*encodingMsgWriter)(nil)
+
+// encodingMsgWriter wraps the messages in envelopes before writing them
+type encodingMsgWriter struct {
+	MsgWriter
+	encoding string
+	height   int64
+}
+
+func NewEncodingMsgWriter(w MsgWriter, encoding string) MsgWriter {
+	if encoding == EncodingRaw {
+		return w
+	}
+	return &encodingMsgWriter{MsgWriter: w, encoding: encoding}
+}
+
+func (w *encodingMsgWriter) WriteKV(k, v []byte) error {
+	if string(k) == heightInfoKey {
+		var info NewHeightInfo
+		if err := json.Unmarshal(v, &info); err == nil {
+			w.height = info.Height
+		}
+	}
+	bz, err := NewEnvelope(string(k), w.height, v).Encode(w.encoding)
+	if err != nil {
+		return err
+	}
+	return w.MsgWriter.WriteKV(k, bz)
+}
+
+func (w *encodingMsgWriter) String() string {
+	return w.MsgWriter.String() + "+" + w.encoding
+}
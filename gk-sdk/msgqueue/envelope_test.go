@@ -0,0 +1,163 @@
+package msgqueue
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type testOrderInfo struct {
+	OrderID  string         `json:"order_id"`
+	Sender   sdk.AccAddress `json:"sender"`
+	Side     byte           `json:"side"`
+	Price    sdk.Dec        `json:"price"`
+	Quantity int64          `json:"quantity"`
+	Fees     sdk.Coins      `json:"fees"`
+	Hash     []byte         `json:"hash"`
+	Ignored  string         `json:"-"`
+	Filled   bool           `json:"filled,omitempty"`
+}
+
+const testOrderTopic = "test_order_info"
+
+func init() {
+	RegisterSchema(testOrderTopic, 2, testOrderInfo{})
+}
+
+func TestSchema(t *testing.T) {
+	schema, ok := GetSchema(testOrderTopic)
+	require.True(t, ok)
+	require.EqualValues(t, 2, schema.Version)
+	require.EqualValues(t, 2, SchemaVersion(testOrderTopic))
+	require.EqualValues(t, 0, SchemaVersion("unknown"))
+
+	expected := []SchemaField{
+		{Name: "order_id", Number: 1, JSONType: "string", ProtoType: "string"},
+		{Name: "sender", Number: 2, JSONType: "string", ProtoType: "bytes"},
+		{Name: "side", Number: 3, JSONType: "number", ProtoType: "uint32"},
+		{Name: "price", Number: 4, JSONType: "string", ProtoType: "string"},
+		{Name: "quantity", Number: 5, JSONType: "number", ProtoType: "int64"},
+		{Name: "fees", Number: 6, JSONType: "object", ProtoType: "message", Repeated: true, Fields: []SchemaField{
+			{Name: "denom", Number: 1, JSONType: "string", ProtoType: "string"},
+			{Name: "amount", Number: 2, JSONType: "string", ProtoType: "string"},
+		}},
+		{Name: "hash", Number: 7, JSONType: "base64", ProtoType: "bytes"},
+		{Name: "filled", Number: 8, JSONType: "boolean", ProtoType: "bool"},
+	}
+	require.Equal(t, expected, schema.Fields)
+
+	topics := make([]string, 0)
+	for _, s := range GetSchemas() {
+		topics = append(topics, s.Topic)
+	}
+	require.Equal(t, []string{"commit", "height_info", testOrderTopic}, topics)
+
+	require.Panics(t, func() { RegisterSchema(testOrderTopic, 3, testOrderInfo{}) })
+	require.Panics(t, func() { RegisterSchema("test_zero_version", 0, testOrderInfo{}) })
+	require.Panics(t, func() { RegisterSchema("test_not_struct", 1, "") })
+}
+
+func TestEnvelope(t *testing.T) {
+	info := testOrderInfo{
+		OrderID:  "coinex1-1",
+		Sender:   sdk.AccAddress("sender______________"),
+		Side:     1,
+		Price:    sdk.NewDecWithPrec(15, 1),
+		Quantity: 100,
+		Fees:     sdk.NewCoins(sdk.NewInt64Coin("cet", 10)),
+		Hash:     []byte{1, 2, 3},
+	}
+	payload, _ := json.Marshal(info)
+
+	// raw keeps the legacy layout
+	bz, err := NewEnvelope(testOrderTopic, 10, payload).Encode(EncodingRaw)
+	require.NoError(t, err)
+	require.Equal(t, payload, bz)
+
+	for _, encoding := range []string{EncodingJSON, EncodingProto} {
+		bz, err := NewEnvelope(testOrderTopic, 10, payload).Encode(encoding)
+		require.NoError(t, err)
+		env, err := DecodeEnvelope(encoding, bz)
+		require.NoError(t, err)
+		require.Equal(t, testOrderTopic, env.Type)
+		require.EqualValues(t, 2, env.Version)
+		require.EqualValues(t, 10, env.Height)
+
+		var res testOrderInfo
+		require.NoError(t, env.DecodePayload(&res))
+		require.Equal(t, info, res)
+	}
+
+	// the proto payload is more compact than the JSON one
+	bz, _ = NewEnvelope(testOrderTopic, 10, payload).Encode(EncodingProto)
+	env, _ := DecodeEnvelope(EncodingProto, bz)
+	require.Equal(t, PayloadFormat_PROTO, env.PayloadFormat)
+	require.True(t, len(env.Payload) < len(payload))
+
+	// the topics without a schema keep their JSON payload
+	bz, err = NewEnvelope("unknown", 10, []byte(`{"a":1}`)).Encode(EncodingProto)
+	require.NoError(t, err)
+	env, err = DecodeEnvelope(EncodingProto, bz)
+	require.NoError(t, err)
+	require.Equal(t, PayloadFormat_JSON, env.PayloadFormat)
+	require.EqualValues(t, 0, env.Version)
+	require.Equal(t, `{"a":1}`, string(env.Payload))
+
+	_, err = NewEnvelope(testOrderTopic, 10, payload).Encode("xml")
+	require.Error(t, err)
+}
+
+func TestEncodingMsgWriter(t *testing.T) {
+	_, _, err := splitOptions("kafka:a,b?encoding=xml")
+	require.Error(t, err)
+	_, _, err = splitOptions("kafka:a,b?compression=gzip")
+	require.Error(t, err)
+	_, _, err = splitOptions("kafka:a,b?outbox=/tmp/outbox&keep=-1")
+	require.Error(t, err)
+	cfg, opts, err := splitOptions("kafka:a,b?encoding=proto&outbox=/tmp/outbox&keep=100")
+	require.NoError(t, err)
+	require.Equal(t, "kafka:a,b", cfg)
+	require.Equal(t, writerOptions{encoding: EncodingProto, outbox: "/tmp/outbox", keep: 100}, opts)
+
+	// binary envelopes can not be written to files, the dir writers only write raw messages
+	_, err = createMsgWriter("os:stdout?encoding=proto")
+	require.Error(t, err)
+	_, err = createMsgWriter("dir:encoding_test?encoding=json")
+	require.Error(t, err)
+	os.RemoveAll("encoding_test")
+	// only the kafka writer delivers through an outbox
+	_, err = createMsgWriter("file:outbox.txt?outbox=outbox_test")
+	require.Error(t, err)
+	_, err = createMsgWriter("os:stdout?keep=100")
+	require.Error(t, err)
+	// only the streaming writers have subscribers
+	_, _, err = splitOptions("ws:127.0.0.1:0?max_subscribers=0")
+	require.Error(t, err)
+	_, err = createMsgWriter("os:stdout?max_subscribers=10")
+	require.Error(t, err)
+	_, err = createMsgWriter("grpc:127.0.0.1:0?origins=https://a.com")
+	require.Error(t, err)
+	sw, err := createMsgWriter("ws:127.0.0.1:0?max_subscribers=10&origins=https://a.com,https://b.com")
+	require.NoError(t, err)
+	require.Equal(t, 10, sw.(*wsMsgWriter).maxSubs)
+	require.Equal(t, []string{"https://a.com", "https://b.com"}, sw.(*wsMsgWriter).origins)
+	require.NoError(t, sw.Close())
+
+	defer os.Remove("envelopes.txt")
+	w, err := createMsgWriter("file:envelopes.txt?encoding=json")
+	require.NoError(t, err)
+	require.Equal(t, "file+json", w.String())
+	require.NoError(t, w.WriteKV([]byte("height_info"), []byte(`{"height":7}`)))
+	require.NoError(t, w.WriteKV([]byte("commit"), []byte(`{}`)))
+	require.NoError(t, w.Close())
+
+	data, err := ioutil.ReadFile("envelopes.txt")
+	require.NoError(t, err)
+	require.Equal(t, `height_info#{"type":"height_info","version":1,"height":7,"payload":{"height":7}}`+"\r\n"+
+		`commit#{"type":"commit","version":1,"height":7,"payload":{}}`+"\r\n", string(data))
+}
@@ -39,6 +39,16 @@ func NewRegulateWriteDir(dir string) (*RegulateWriteDir, error) {
 	return rgw, nil
 }
 
+// Sync commits the written messages to the disk
+func (r *RegulateWriteDir) Sync() error {
+	return r.MsgWriter.(*dirMsgWriter).Sync()
+}
+
+// Dir returns the directory of the files
+func (r *RegulateWriteDir) Dir() string {
+	return r.MsgWriter.(*dirMsgWriter).dir
+}
+
 func (r *RegulateWriteDir) timeToNewFile() func(k, v []byte) bool {
 	return func(k, v []byte) bool {
 		if string(k) == ("height_info") {
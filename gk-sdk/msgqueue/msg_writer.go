@@ -14,7 +14,66 @@ type MsgWriter interface {
 // kafka:broker1,broker2,broker3
 // file:path/to/file
 // os:stdout
+// ws:host:port
+// grpc:host:port
+// kafka, file, os and pipe writers accept ?encoding=json, kafka writers also accept ?encoding=proto
+// and ?outbox=path/to/dir, which makes them deliver the messages through a local outbox, and
+// ?keep=N, which keeps the files of the last N delivered heights in the outbox
+// ws and grpc writers accept ?max_subscribers=N, ws writers also accept ?origins=https://a.com,https://b.com
 func createMsgWriter(cfg string) (MsgWriter, error) {
+	cfg, opts, err := splitOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(opts.outbox) != 0 {
+		if !strings.HasPrefix(cfg, CfgPrefixKafka) {
+			return nil, fmt.Errorf("outbox is only supported by kafka writers: %s", cfg)
+		}
+		brokers := strings.TrimPrefix(cfg, CfgPrefixKafka)
+		return NewKafkaOutboxMsgWriter(brokers, opts.outbox, opts.encoding, opts.keep)
+	}
+	if opts.keep != 0 {
+		return nil, fmt.Errorf("keep is only supported with an outbox: %s", cfg)
+	}
+	isStream := strings.HasPrefix(cfg, CfgPrefixWS) || strings.HasPrefix(cfg, CfgPrefixGRPC)
+	if opts.maxSubscribers != 0 && !isStream {
+		return nil, fmt.Errorf("max_subscribers is only supported by ws and grpc writers: %s", cfg)
+	}
+	if len(opts.origins) != 0 && !strings.HasPrefix(cfg, CfgPrefixWS) {
+		return nil, fmt.Errorf("origins is only supported by ws writers: %s", cfg)
+	}
+	w, err := newMsgWriter(cfg)
+	if err != nil {
+		return w, err
+	}
+	if sw, ok := w.(interface{ SetMaxSubscribers(int) }); ok && opts.maxSubscribers != 0 {
+		sw.SetMaxSubscribers(opts.maxSubscribers)
+	}
+	if ws, ok := w.(*wsMsgWriter); ok && len(opts.origins) != 0 {
+		ws.SetAllowedOrigins(opts.origins)
+	}
+	if opts.encoding == EncodingRaw {
+		return w, nil
+	}
+	if !isEncodingSupported(cfg, opts.encoding) {
+		_ = w.Close()
+		return nil, fmt.Errorf("unsupported encoding %s for %s", opts.encoding, w.String())
+	}
+	return NewEncodingMsgWriter(w, opts.encoding), nil
+}
+
+// the dir and prune writers are read by trade-server and the streaming writers,
+// which expect the raw messages
+func isEncodingSupported(cfg, encoding string) bool {
+	if strings.HasPrefix(cfg, CfgPrefixKafka) {
+		return true
+	}
+	// the line based writers can not hold binary messages
+	return encoding == EncodingJSON && (strings.HasPrefix(cfg, CfgPrefixFile) ||
+		strings.HasPrefix(cfg, CfgPrefixOS) || strings.HasPrefix(cfg, CfgNamedPipe))
+}
+
+func newMsgWriter(cfg string) (MsgWriter, error) {
 	if cfg == "nop" {
 		return NewNopMsgWriter(), nil
 	} else if strings.HasPrefix(cfg, CfgPrefixKafka) {
@@ -34,6 +93,12 @@ func createMsgWriter(cfg string) (MsgWriter, error) {
 	} else if strings.HasPrefix(cfg, CfgPrefixPrune) {
 		dirPath := strings.TrimPrefix(cfg, CfgPrefixPrune)
 		return NewRegulateWriteDir(dirPath)
+	} else if strings.HasPrefix(cfg, CfgPrefixWS) {
+		addr := strings.TrimPrefix(cfg, CfgPrefixWS)
+		return NewWebSocketMsgWriter(addr)
+	} else if strings.HasPrefix(cfg, CfgPrefixGRPC) {
+		addr := strings.TrimPrefix(cfg, CfgPrefixGRPC)
+		return NewGRPCMsgWriter(addr)
 	}
 	return nil, fmt.Errorf("unsupported config: %s", cfg)
 }
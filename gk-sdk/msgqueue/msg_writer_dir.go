@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 )
 
 const (
@@ -76,6 +77,14 @@ func (w *dirMsgWriter) Close() error {
 	return w.WriteCloser.Close()
 }
 
+// Sync commits the written messages to the disk
+func (w *dirMsgWriter) Sync() error {
+	if file, ok := w.WriteCloser.(*os.File); ok {
+		return file.Sync()
+	}
+	return nil
+}
+
 func (w *dirMsgWriter) String() string {
 	return "dir"
 }
@@ -0,0 +1,80 @@
+package msgqueue
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ MsgWriter = (*grpcMsgWriter)(nil)
+
+// grpcMsgWriter serves the messages through the msgqueue.MsgQueue service
+type grpcMsgWriter struct {
+	*streamHub
+	listener net.Listener
+	server   *grpc.Server
+}
+
+func NewGRPCMsgWriter(addr string) (MsgWriter, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return &grpcMsgWriter{}, err
+	}
+	w := &grpcMsgWriter{
+		streamHub: newStreamHub(),
+		listener:  listener,
+		server:    grpc.NewServer(),
+	}
+	RegisterMsgQueueServer(w.server, w)
+	go func() {
+		_ = w.server.Serve(listener)
+	}()
+	return w, nil
+}
+
+func (w *grpcMsgWriter) Subscribe(req *SubscribeRequest, stream MsgQueue_SubscribeServer) error {
+	filter := StreamFilter{
+		Topics:      req.Topics,
+		TradingPair: req.TradingPair,
+		Address:     req.Address,
+		FromHeight:  req.FromHeight,
+	}
+	if filter.FromHeight < 0 {
+		return status.Error(codes.InvalidArgument, errInvalidStreamFilter.Error())
+	}
+	err := w.streamHub.Subscribe(filter, func(msg StreamMsg) error {
+		return stream.Send(&StreamMessage{Topic: msg.Topic, Height: msg.Height, Payload: msg.Payload, Version: SchemaVersion(msg.Topic)})
+	}, stream.Context().Done())
+	switch err {
+	case nil:
+		return nil
+	case ErrSlowConsumer, ErrTooManySubscribers:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case ErrHeightNotAvailable:
+		return status.Error(codes.OutOfRange, err.Error())
+	case ErrStreamClosed:
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return err
+	}
+}
+
+// Addr returns the address the server listens on
+func (w *grpcMsgWriter) Addr() string {
+	return w.listener.Addr().String()
+}
+
+func (w *grpcMsgWriter) Close() error {
+	if w.streamHub == nil {
+		return nil
+	}
+	_ = w.streamHub.Close()
+	w.server.Stop()
+	return nil
+}
+
+func (w *grpcMsgWriter) String() string {
+	return "grpc"
+}
@@ -0,0 +1,74 @@
+package msgqueue
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	cfgOptionsSeparator = "?"
+	cfgOptionEncoding   = "encoding"
+	cfgOptionOutbox     = "outbox"
+	cfgOptionName       = "name"
+	cfgOptionKeep       = "keep"
+
+	cfgOptionMaxSubscribers = "max_subscribers"
+	cfgOptionOrigins        = "origins"
+)
+
+// writerOptions are appended to the config of a writer as a query string,
+// e.g. kafka:broker1,broker2?encoding=json&outbox=/path/to/outbox&keep=100000&name=fills
+type writerOptions struct {
+	encoding string
+	outbox   string
+	// keep is the number of the delivered heights kept in the outbox for replaying
+	keep int64
+	// maxSubscribers and origins limit the subscribers of the ws and grpc writers,
+	// zero maxSubscribers means StreamMaxSubscribers
+	maxSubscribers int
+	origins        []string
+	// name is used by the routes of the producer, which defaults to the kind of the writer
+	name string
+}
+
+func splitOptions(cfg string) (string, writerOptions, error) {
+	opts := writerOptions{encoding: EncodingRaw}
+	idx := strings.LastIndex(cfg, cfgOptionsSeparator)
+	if idx < 0 {
+		return cfg, opts, nil
+	}
+	values, err := url.ParseQuery(cfg[idx+1:])
+	if err != nil {
+		return cfg, opts, fmt.Errorf("invalid options of %s: %s", cfg, err.Error())
+	}
+	for key := range values {
+		switch key {
+		case cfgOptionEncoding:
+			opts.encoding = values.Get(key)
+		case cfgOptionOutbox:
+			opts.outbox = values.Get(key)
+		case cfgOptionKeep:
+			if opts.keep, err = strconv.ParseInt(values.Get(key), 10, 64); err != nil || opts.keep < 0 {
+				return cfg, opts, fmt.Errorf("invalid heights to keep: %s", values.Get(key))
+			}
+		case cfgOptionMaxSubscribers:
+			if opts.maxSubscribers, err = strconv.Atoi(values.Get(key)); err != nil || opts.maxSubscribers <= 0 {
+				return cfg, opts, fmt.Errorf("invalid max subscribers: %s", values.Get(key))
+			}
+		case cfgOptionOrigins:
+			opts.origins = strings.Split(values.Get(key), ",")
+		case cfgOptionName:
+			opts.name = values.Get(key)
+		default:
+			return cfg, opts, fmt.Errorf("unsupported option: %s", key)
+		}
+	}
+	switch opts.encoding {
+	case EncodingRaw, EncodingJSON, EncodingProto:
+	default:
+		return cfg, opts, fmt.Errorf("unsupported encoding: %s", opts.encoding)
+	}
+	return cfg[:idx], opts, nil
+}
@@ -0,0 +1,532 @@
+package msgqueue
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// The kafka writers configured with ?outbox=path/to/dir write the messages to a local outbox
+// through the dir writer, and a background goroutine delivers the committed blocks to kafka.
+// The node keeps going when kafka is down, the blocks are delivered once it is back.
+//
+// Every kafka message carries the height of its block and its sequence in the block as the
+// "height" and "seq" headers. The writer resumes after the last message found in kafka, so
+// the messages are delivered exactly once, and the consumers can dedup the replayed ones.
+//
+// The files whose blocks are all delivered are removed, except those holding the last
+// ?keep=N delivered heights, which are kept for replaying.
+
+const (
+	HeaderHeight = "height"
+	HeaderSeq    = "seq"
+)
+
+var (
+	// OutboxRetryInterval is the first interval between the attempts to deliver to kafka
+	OutboxRetryInterval = 100 * time.Millisecond
+	// OutboxMaxRetryInterval is the longest interval between the attempts to deliver to kafka
+	OutboxMaxRetryInterval = 10 * time.Second
+	// OutboxPollInterval is the interval to check the outbox without being notified
+	OutboxPollInterval = time.Second
+
+	errOutboxClosed = errors.New("the outbox is closed")
+)
+
+// kafkaSink delivers the messages read from the outbox
+type kafkaSink interface {
+	Send(topic string, value []byte, height int64, seq int) error
+	// LastPosition returns the height and the sequence of the last delivered message
+	LastPosition() (height int64, seq int, err error)
+	Close() error
+}
+
+type kafkaSinkCreator func() (kafkaSink, error)
+
+// outboxPosition orders the messages by their block height and their sequence in the block
+type outboxPosition struct {
+	height int64
+	seq    int
+}
+
+func (p outboxPosition) before(other outboxPosition) bool {
+	return p.height < other.height || (p.height == other.height && p.seq < other.seq)
+}
+
+var _ MsgWriter = (*kafkaOutboxMsgWriter)(nil)
+
+type kafkaOutboxMsgWriter struct {
+	outbox     *RegulateWriteDir
+	newSink    kafkaSinkCreator
+	encoding   string
+	notify     chan struct{}
+	quit       chan struct{}
+	done       chan struct{}
+	mu         sync.Mutex
+	log        log.Logger
+	closeOnce  sync.Once
+	delivered  outboxPosition
+	positioned bool
+	keep       int64
+}
+
+func NewKafkaOutboxMsgWriter(brokers, dir, encoding string, keep int64) (MsgWriter, error) {
+	bs := strings.Split(brokers, ",")
+	return newKafkaOutboxMsgWriter(dir, encoding, keep, func() (kafkaSink, error) {
+		return newSaramaSink(bs)
+	})
+}
+
+func newKafkaOutboxMsgWriter(dir, encoding string, keep int64, newSink kafkaSinkCreator) (*kafkaOutboxMsgWriter, error) {
+	outbox, err := NewRegulateWriteDir(dir)
+	if err != nil {
+		return &kafkaOutboxMsgWriter{}, err
+	}
+	w := &kafkaOutboxMsgWriter{
+		outbox:   outbox,
+		newSink:  newSink,
+		encoding: encoding,
+		notify:   make(chan struct{}, 1),
+		quit:     make(chan struct{}),
+		done:     make(chan struct{}),
+		keep:     keep,
+	}
+	go w.run()
+	return w, nil
+}
+
+// SetLogger sets the logger of the delivery errors
+func (w *kafkaOutboxMsgWriter) SetLogger(logger log.Logger) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.log = logger
+}
+
+func (w *kafkaOutboxMsgWriter) logError(msg string, keyvals ...interface{}) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.log != nil {
+		w.log.Error(msg, keyvals...)
+	}
+}
+
+func (w *kafkaOutboxMsgWriter) WriteKV(k, v []byte) error {
+	if err := w.outbox.WriteKV(k, v); err != nil {
+		return err
+	}
+	if string(k) != commitKey {
+		return nil
+	}
+	if err := w.outbox.Sync(); err != nil {
+		return err
+	}
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (w *kafkaOutboxMsgWriter) run() {
+	defer close(w.done)
+	var (
+		sink     kafkaSink
+		reader   *outboxReader
+		interval = OutboxRetryInterval
+	)
+	defer func() {
+		if sink != nil {
+			_ = sink.Close()
+		}
+	}()
+
+	for {
+		err := w.deliver(&sink, &reader)
+		wait := OutboxPollInterval
+		if err == errOutboxClosed {
+			return
+		} else if err != nil {
+			w.logError("deliver msgqueue outbox to kafka failed", "dir", w.outbox.Dir(), "err", err.Error())
+			// the failed message may have been delivered, so find the last one in kafka again
+			w.positioned, reader = false, nil
+			if sink != nil {
+				_ = sink.Close()
+				sink = nil
+			}
+			wait, interval = interval, interval*2
+			if interval > OutboxMaxRetryInterval {
+				interval = OutboxMaxRetryInterval
+			}
+		} else {
+			interval = OutboxRetryInterval
+			if err := pruneOutbox(w.outbox.Dir(), w.delivered.height-w.keep, reader.fileIndex); err != nil {
+				w.logError("prune msgqueue outbox failed", "dir", w.outbox.Dir(), "err", err.Error())
+			}
+		}
+
+		select {
+		case <-w.quit:
+			return
+		case <-w.notify:
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (w *kafkaOutboxMsgWriter) deliver(sink *kafkaSink, reader **outboxReader) (err error) {
+	if *sink == nil {
+		if *sink, err = w.newSink(); err != nil {
+			return err
+		}
+	}
+	if !w.positioned {
+		height, seq, err := (*sink).LastPosition()
+		if err != nil {
+			return err
+		}
+		w.delivered, w.positioned = outboxPosition{height: height, seq: seq}, true
+	}
+	if *reader == nil {
+		if *reader, err = newOutboxReader(w.outbox.Dir(), w.delivered.height); err != nil {
+			return err
+		}
+	}
+
+	return (*reader).readBlocks(func(block []StreamMsg) error {
+		for seq, msg := range block {
+			select {
+			case <-w.quit:
+				return errOutboxClosed
+			default:
+			}
+			pos := outboxPosition{height: msg.Height, seq: seq}
+			if !w.delivered.before(pos) {
+				continue
+			}
+			value, err := NewEnvelope(msg.Topic, msg.Height, msg.Payload).Encode(w.encoding)
+			if err != nil {
+				return err
+			}
+			if err := (*sink).Send(msg.Topic, value, msg.Height, seq); err != nil {
+				return err
+			}
+			w.delivered = pos
+		}
+		return nil
+	})
+}
+
+func (w *kafkaOutboxMsgWriter) Close() error {
+	if w.outbox == nil {
+		return nil
+	}
+	w.closeOnce.Do(func() {
+		close(w.quit)
+		<-w.done
+	})
+	return w.outbox.Close()
+}
+
+func (w *kafkaOutboxMsgWriter) String() string {
+	return "kafka-outbox"
+}
+
+// outboxReader reads the committed blocks of an outbox, and remembers where it stopped
+// so that the blocks written later are read by the next call
+type outboxReader struct {
+	dir       string
+	fileIndex int
+	offset    int64
+}
+
+// newOutboxReader starts from the last file whose first block is not after fromHeight
+func newOutboxReader(dir string, fromHeight int64) (*outboxReader, error) {
+	indexes, err := getFileIndexes(dir)
+	if err != nil {
+		return nil, err
+	}
+	r := &outboxReader{dir: dir}
+	for i, index := range indexes {
+		if height, ok := getFileFirstHeight(GetFileName(dir, index)); i != 0 && (!ok || height > fromHeight) {
+			break
+		}
+		r.fileIndex = index
+	}
+	return r, nil
+}
+
+func getFileIndexes(dir string) ([]int, error) {
+	fileNames, err := getAllFilesFromDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make([]int, 0, len(fileNames))
+	for _, name := range fileNames {
+		if index, err := strconv.Atoi(strings.TrimPrefix(name, filePrefix)); err == nil {
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Ints(indexes)
+	return indexes, nil
+}
+
+// pruneOutbox removes the files before the file of index upTo whose blocks are all below height.
+// The blocks of a file are below the first block of the next file.
+func pruneOutbox(dir string, height int64, upTo int) error {
+	indexes, err := getFileIndexes(dir)
+	if err != nil {
+		return err
+	}
+	for i := 0; i+1 < len(indexes) && indexes[i+1] <= upTo; i++ {
+		next, ok := getFileFirstHeight(GetFileName(dir, indexes[i+1]))
+		if !ok || next > height {
+			return nil
+		}
+		if err := os.Remove(GetFileName(dir, indexes[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readBlocks calls fn with the blocks ended by commit. A block is read again by the next call
+// if fn fails, and an incomplete block left by a crash is skipped when the block is written again.
+func (r *outboxReader) readBlocks(fn func(block []StreamMsg) error) error {
+	for {
+		// the writer has finished the current file if the next one exists
+		_, err := os.Stat(GetFileName(r.dir, r.fileIndex+1))
+		hasNext := err == nil
+		if err := r.readFile(fn); err != nil {
+			return err
+		}
+		if !hasNext {
+			return nil
+		}
+		r.fileIndex, r.offset = r.fileIndex+1, 0
+	}
+}
+
+func (r *outboxReader) readFile(fn func(block []StreamMsg) error) error {
+	file, err := os.Open(GetFileName(r.dir, r.fileIndex))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := file.Seek(r.offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	var (
+		reader = bufio.NewReader(file)
+		offset = r.offset
+		height int64
+		block  []StreamMsg
+	)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			// io.EOF, or the partial line being written
+			return nil
+		}
+		offset += int64(len(line))
+		msg, ok := parseLine(line, &height)
+		if !ok {
+			continue
+		}
+		if msg.Topic == heightInfoKey {
+			block = block[:0]
+		}
+		// the payload of parseLine refers to line
+		msg.Payload = append([]byte(nil), msg.Payload...)
+		block = append(block, msg)
+		if msg.Topic != commitKey || block[0].Topic != heightInfoKey {
+			continue
+		}
+		if err := fn(block); err != nil {
+			return err
+		}
+		r.offset, block = offset, block[:0]
+	}
+}
+
+// ReplayOutbox republishes the blocks in [fromHeight, toHeight] from the outbox of a kafka writer,
+// toHeight is ignored if it is not positive. cfg is the config of the writer, e.g.
+// kafka:broker1,broker2?outbox=path/to/dir&encoding=json. It returns the number of the republished blocks.
+func ReplayOutbox(cfg string, fromHeight, toHeight int64) (int, error) {
+	cfg, opts, err := splitOptions(cfg)
+	if err != nil {
+		return 0, err
+	}
+	if !strings.HasPrefix(cfg, CfgPrefixKafka) || len(opts.outbox) == 0 {
+		return 0, fmt.Errorf("not a kafka writer with an outbox: %s", cfg)
+	}
+	sink, err := newSaramaSink(strings.Split(strings.TrimPrefix(cfg, CfgPrefixKafka), ","))
+	if err != nil {
+		return 0, err
+	}
+	defer sink.Close()
+	return replayOutbox(opts.outbox, opts.encoding, sink, fromHeight, toHeight)
+}
+
+var errReplayDone = errors.New("replay done")
+
+func replayOutbox(dir, encoding string, sink kafkaSink, fromHeight, toHeight int64) (int, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return 0, err
+	}
+	reader, err := newOutboxReader(dir, fromHeight)
+	if err != nil {
+		return 0, err
+	}
+	count, last := 0, int64(0)
+	err = reader.readBlocks(func(block []StreamMsg) error {
+		height := block[0].Height
+		// skip the blocks written again after a crash
+		if height < fromHeight || height <= last {
+			return nil
+		}
+		if toHeight > 0 && height > toHeight {
+			return errReplayDone
+		}
+		for seq, msg := range block {
+			value, err := NewEnvelope(msg.Topic, height, msg.Payload).Encode(encoding)
+			if err != nil {
+				return err
+			}
+			if err := sink.Send(msg.Topic, value, height, seq); err != nil {
+				return err
+			}
+		}
+		count, last = count+1, height
+		return nil
+	})
+	if err == errReplayDone {
+		err = nil
+	}
+	if err == nil && count == 0 {
+		err = fmt.Errorf("no block from height %d in %s", fromHeight, dir)
+	}
+	return count, err
+}
+
+type saramaSink struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+}
+
+func newSaramaSink(brokers []string) (kafkaSink, error) {
+	config := sarama.NewConfig()
+	// the idempotent producer never writes a message twice when it retries
+	config.Version = sarama.V0_11_0_0
+	config.Producer.Idempotent = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Producer.Timeout = 5 * time.Second
+	config.Net.MaxOpenRequests = 1
+	config.Consumer.Return.Errors = true
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+	return &saramaSink{client: client, producer: producer}, nil
+}
+
+func (s *saramaSink) Send(topic string, value []byte, height int64, seq int) error {
+	_, _, err := s.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: KafkaPubTopic,
+		Key:   sarama.StringEncoder(topic),
+		Value: sarama.ByteEncoder(value),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(HeaderHeight), Value: []byte(strconv.FormatInt(height, 10))},
+			{Key: []byte(HeaderSeq), Value: []byte(strconv.Itoa(seq))},
+		},
+	})
+	return err
+}
+
+// LastPosition reads the last message of every partition. The messages are sent one by one,
+// so the greatest position among them is the one of the last delivered message.
+func (s *saramaSink) LastPosition() (int64, int, error) {
+	partitions, err := s.client.Partitions(KafkaPubTopic)
+	if err == sarama.ErrUnknownTopicOrPartition {
+		return 0, 0, nil
+	} else if err != nil {
+		return 0, 0, err
+	}
+	consumer, err := sarama.NewConsumerFromClient(s.client)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer consumer.Close()
+
+	var last outboxPosition
+	for _, partition := range partitions {
+		oldest, err := s.client.GetOffset(KafkaPubTopic, partition, sarama.OffsetOldest)
+		if err != nil {
+			return 0, 0, err
+		}
+		newest, err := s.client.GetOffset(KafkaPubTopic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return 0, 0, err
+		}
+		if newest <= oldest {
+			continue
+		}
+		pos, err := lastPositionOfPartition(consumer, partition, newest-1)
+		if err != nil {
+			return 0, 0, err
+		}
+		if last.before(pos) {
+			last = pos
+		}
+	}
+	return last.height, last.seq, nil
+}
+
+func lastPositionOfPartition(consumer sarama.Consumer, partition int32, offset int64) (outboxPosition, error) {
+	pc, err := consumer.ConsumePartition(KafkaPubTopic, partition, offset)
+	if err != nil {
+		return outboxPosition{}, err
+	}
+	defer pc.Close()
+
+	select {
+	case msg := <-pc.Messages():
+		var pos outboxPosition
+		// the messages written without an outbox have no position
+		for _, header := range msg.Headers {
+			switch string(header.Key) {
+			case HeaderHeight:
+				pos.height, _ = strconv.ParseInt(string(header.Value), 10, 64)
+			case HeaderSeq:
+				pos.seq, _ = strconv.Atoi(string(header.Value))
+			}
+		}
+		return pos, nil
+	case err := <-pc.Errors():
+		return outboxPosition{}, err
+	case <-time.After(10 * time.Second):
+		return outboxPosition{}, fmt.Errorf("read the last message of partition %d timeout", partition)
+	}
+}
+
+func (s *saramaSink) Close() error {
+	_ = s.producer.Close()
+	return s.client.Close()
+}
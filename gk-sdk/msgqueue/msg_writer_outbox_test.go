@@ -0,0 +1,251 @@
+package msgqueue
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+type sentMsg struct {
+	topic  string
+	value  string
+	height int64
+	seq    int
+}
+
+type fakeSink struct {
+	mu     sync.Mutex
+	msgs   []sentMsg
+	failAt int // fail the Send of this message, negative means never
+	down   bool
+}
+
+func newFakeSink() *fakeSink {
+	return &fakeSink{failAt: -1}
+}
+
+func (s *fakeSink) Send(topic string, value []byte, height int64, seq int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.down {
+		return errors.New("kafka is down")
+	}
+	if len(s.msgs) == s.failAt {
+		// the message is written but not acknowledged
+		s.msgs = append(s.msgs, sentMsg{topic, string(value), height, seq})
+		s.failAt = -1
+		return errors.New("timeout")
+	}
+	s.msgs = append(s.msgs, sentMsg{topic, string(value), height, seq})
+	return nil
+}
+
+func (s *fakeSink) LastPosition() (int64, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.down {
+		return 0, 0, errors.New("kafka is down")
+	}
+	if len(s.msgs) == 0 {
+		return 0, 0, nil
+	}
+	last := s.msgs[len(s.msgs)-1]
+	return last.height, last.seq, nil
+}
+
+func (s *fakeSink) Close() error {
+	return nil
+}
+
+func (s *fakeSink) setDown(down bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.down = down
+}
+
+func (s *fakeSink) sent() []sentMsg {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]sentMsg(nil), s.msgs...)
+}
+
+func (s *fakeSink) waitHeight(t *testing.T, height int64) {
+	waitFor(t, func() bool {
+		msgs := s.sent()
+		return len(msgs) != 0 && msgs[len(msgs)-1].height == height && msgs[len(msgs)-1].topic == commitKey
+	})
+}
+
+// every block of writeStreamBlock has 4 messages
+func requireBlocks(t *testing.T, msgs []sentMsg, from, to int64) {
+	require.Len(t, msgs, int(to-from+1)*4)
+	for i, msg := range msgs {
+		require.Equal(t, from+int64(i/4), msg.height)
+		require.Equal(t, i%4, msg.seq)
+	}
+}
+
+func setupOutboxTest(t *testing.T) (string, func()) {
+	viper.Set("genesis_block_height", 0)
+	dir, err := ioutil.TempDir("", "outbox")
+	require.NoError(t, err)
+	interval := OutboxRetryInterval
+	OutboxRetryInterval = time.Millisecond
+	return dir, func() {
+		OutboxRetryInterval = interval
+		os.RemoveAll(dir)
+	}
+}
+
+func TestKafkaOutboxMsgWriter(t *testing.T) {
+	dir, cleanup := setupOutboxTest(t)
+	defer cleanup()
+
+	sink := newFakeSink()
+	newSink := func() (kafkaSink, error) { return sink, nil }
+	w, err := newKafkaOutboxMsgWriter(dir, EncodingRaw, 0, newSink)
+	require.NoError(t, err)
+	for h := int64(1); h <= 3; h++ {
+		writeStreamBlock(t, w, h)
+	}
+	sink.waitHeight(t, 3)
+
+	// kafka is down, the node keeps going
+	sink.setDown(true)
+	for h := int64(4); h <= 5; h++ {
+		writeStreamBlock(t, w, h)
+	}
+	time.Sleep(10 * time.Millisecond)
+	requireBlocks(t, sink.sent(), 1, 3)
+	sink.setDown(false)
+	sink.waitHeight(t, 5)
+
+	// a message written without being acknowledged is not sent again
+	sink.failAt = len(sink.sent()) + 2
+	writeStreamBlock(t, w, 6)
+	sink.waitHeight(t, 6)
+	requireBlocks(t, sink.sent(), 1, 6)
+	require.NoError(t, w.Close())
+
+	// the blocks delivered before a restart are not sent again, the uncommitted block
+	// left by a crash is skipped when it is written again
+	w, err = newKafkaOutboxMsgWriter(dir, EncodingRaw, 0, newSink)
+	require.NoError(t, err)
+	require.NoError(t, w.outbox.WriteKV([]byte("height_info"), []byte(`{"height":7}`)))
+	require.NoError(t, w.outbox.WriteKV([]byte("create_order_info"), []byte(`{}`)))
+	writeStreamBlock(t, w, 6)
+	writeStreamBlock(t, w, 7)
+	sink.waitHeight(t, 7)
+	msgs := sink.sent()
+	requireBlocks(t, msgs, 1, 7)
+	require.Equal(t, fmt.Sprintf(testOrderMsg, testAddrA, 7), msgs[len(msgs)-3].value)
+	require.NoError(t, w.Close())
+}
+
+func TestKafkaOutboxEncoding(t *testing.T) {
+	dir, cleanup := setupOutboxTest(t)
+	defer cleanup()
+
+	sink := newFakeSink()
+	w, err := newKafkaOutboxMsgWriter(dir, EncodingJSON, 0, func() (kafkaSink, error) { return sink, nil })
+	require.NoError(t, err)
+	writeStreamBlock(t, w, 1)
+	sink.waitHeight(t, 1)
+	require.NoError(t, w.Close())
+
+	// the outbox keeps the raw messages
+	bz, err := ioutil.ReadFile(GetFileName(dir, 0))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "commit#{}\r\n")
+	require.Equal(t, `{"type":"commit","version":1,"height":1,"payload":{}}`, sink.sent()[3].value)
+}
+
+func TestOutboxReader(t *testing.T) {
+	dir, cleanup := setupOutboxTest(t)
+	defer cleanup()
+
+	block := func(height int64) string {
+		return fmt.Sprintf("height_info#{\"height\":%d}\r\nfill_order_info#{}\r\ncommit#{}\r\n", height)
+	}
+	require.NoError(t, ioutil.WriteFile(GetFileName(dir, 0), []byte(block(1)+block(2)+"height_info#{\"height\":3}\r\n"), 0644))
+
+	r, err := newOutboxReader(dir, 0)
+	require.NoError(t, err)
+	var heights []int64
+	readBlocks := func() {
+		require.NoError(t, r.readBlocks(func(block []StreamMsg) error {
+			require.Len(t, block, 3)
+			heights = append(heights, block[0].Height)
+			return nil
+		}))
+	}
+	readBlocks()
+	require.Equal(t, []int64{1, 2}, heights)
+
+	// the next file is read once the current one is finished
+	file, err := os.OpenFile(GetFileName(dir, 0), os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = file.WriteString("fill_order_info#{}\r\ncommit#{}\r\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	require.NoError(t, ioutil.WriteFile(GetFileName(dir, 1), []byte(block(4)+"height_info#{\"he"), 0644))
+	readBlocks()
+	require.Equal(t, []int64{1, 2, 3, 4}, heights)
+	readBlocks()
+	require.Equal(t, []int64{1, 2, 3, 4}, heights)
+
+	// a reader starts from the file holding its first height
+	r, err = newOutboxReader(dir, 4)
+	require.NoError(t, err)
+	require.Equal(t, 1, r.fileIndex)
+
+	// replay
+	sink := newFakeSink()
+	count, err := replayOutbox(dir, EncodingRaw, sink, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Len(t, sink.sent(), 6)
+	require.EqualValues(t, 2, sink.sent()[0].height)
+	_, err = replayOutbox(dir, EncodingRaw, sink, 5, 0)
+	require.Error(t, err)
+}
+
+func TestPruneOutbox(t *testing.T) {
+	dir, cleanup := setupOutboxTest(t)
+	defer cleanup()
+
+	block := func(height int64) string {
+		return fmt.Sprintf("height_info#{\"height\":%d}\r\ncommit#{}\r\n", height)
+	}
+	require.NoError(t, ioutil.WriteFile(GetFileName(dir, 0), []byte(block(1)+block(2)), 0644))
+	require.NoError(t, ioutil.WriteFile(GetFileName(dir, 1), []byte(block(3)+block(4)), 0644))
+	require.NoError(t, ioutil.WriteFile(GetFileName(dir, 2), []byte(block(5)), 0644))
+	exists := func(index int) bool {
+		_, err := os.Stat(GetFileName(dir, index))
+		return err == nil
+	}
+
+	// the blocks of file 1 are not all below height 4
+	require.NoError(t, pruneOutbox(dir, 4, 2))
+	require.False(t, exists(0))
+	require.True(t, exists(1))
+
+	// the file being read is kept
+	require.NoError(t, pruneOutbox(dir, 10, 1))
+	require.True(t, exists(1))
+	require.NoError(t, pruneOutbox(dir, 10, 2))
+	require.False(t, exists(1))
+	require.True(t, exists(2))
+
+	// the kept files can still be replayed
+	count, err := replayOutbox(dir, EncodingRaw, newFakeSink(), 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,443 @@
+package msgqueue
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The ws: and grpc: writers stream the messages to the subscribers connected to cetd.
+// Messages are pushed block by block, a block starts with height_info and ends with commit.
+
+const (
+	heightInfoKey = "height_info"
+	commitKey     = "commit"
+)
+
+var (
+	// StreamRecentBlocks is the number of committed blocks kept in memory for resuming subscribers
+	StreamRecentBlocks = 100
+	// StreamBufferBlocks is the number of blocks a subscriber can fall behind before it is dropped
+	StreamBufferBlocks = 1000
+	// StreamMaxSubscribers is the default number of subscribers a ws: or grpc: writer accepts
+	StreamMaxSubscribers = 100
+)
+
+var (
+	ErrSlowConsumer        = errors.New("the subscriber falls behind, resume it from the last received height")
+	ErrStreamClosed        = errors.New("the stream server is closed")
+	ErrHeightNotAvailable  = errors.New("the blocks to resume from are not available")
+	ErrTooManySubscribers  = errors.New("the stream server has too many subscribers")
+	errInvalidStreamFilter = errors.New("invalid stream filter")
+)
+
+// StreamMsg is a message pushed to the subscribers
+type StreamMsg struct {
+	Topic   string
+	Height  int64
+	Payload []byte
+}
+
+type streamBlock struct {
+	height int64
+	msgs   []StreamMsg
+}
+
+// StreamFilter selects the messages pushed to a subscriber. Empty fields select everything.
+// height_info and commit are only filtered by Topics, the other messages must contain
+// Address and have the trading_pair field of TradingPair.
+type StreamFilter struct {
+	Topics      []string
+	TradingPair string
+	Address     string
+	// resume from the block at FromHeight, zero means the next committed block
+	FromHeight int64
+}
+
+func (f StreamFilter) match(msg StreamMsg) bool {
+	if len(f.Topics) != 0 && !containsString(f.Topics, msg.Topic) {
+		return false
+	}
+	if msg.Topic == heightInfoKey || msg.Topic == commitKey {
+		return true
+	}
+	if len(f.Address) != 0 && !bytes.Contains(msg.Payload, []byte(f.Address)) {
+		return false
+	}
+	if len(f.TradingPair) != 0 {
+		var v struct {
+			TradingPair string `json:"trading_pair"`
+		}
+		if json.Unmarshal(msg.Payload, &v) != nil || v.TradingPair != f.TradingPair {
+			return false
+		}
+	}
+	return true
+}
+
+func (f StreamFilter) filter(msgs []StreamMsg) []StreamMsg {
+	res := make([]StreamMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		if f.match(msg) {
+			res = append(res, msg)
+		}
+	}
+	return res
+}
+
+func parseStreamFilter(topics, tradingPair, address, fromHeight string) (StreamFilter, error) {
+	filter := StreamFilter{TradingPair: tradingPair, Address: address}
+	if len(topics) != 0 {
+		filter.Topics = strings.Split(topics, ",")
+	}
+	if len(fromHeight) != 0 {
+		height, err := strconv.ParseInt(fromHeight, 10, 64)
+		if err != nil || height < 0 {
+			return filter, errInvalidStreamFilter
+		}
+		filter.FromHeight = height
+	}
+	return filter, nil
+}
+
+type streamSubscriber struct {
+	filter StreamFilter
+	blocks chan []StreamMsg
+	quit   chan struct{}
+	err    error
+}
+
+// streamHub fans the blocks written by the producer out to the subscribers. WriteKV never blocks,
+// a subscriber which has StreamBufferBlocks unsent blocks is dropped with ErrSlowConsumer.
+type streamHub struct {
+	mu        sync.Mutex
+	replayDir string
+	height    int64
+	pending   []StreamMsg
+	recent    []streamBlock
+	subs      map[*streamSubscriber]struct{}
+	closed    bool
+	// active counts the subscribers being caught up as well as the registered ones
+	active  int
+	maxSubs int
+}
+
+func newStreamHub() *streamHub {
+	return &streamHub{subs: make(map[*streamSubscriber]struct{}), maxSubs: StreamMaxSubscribers}
+}
+
+// SetMaxSubscribers limits the number of subscribers served at the same time
+func (h *streamHub) SetMaxSubscribers(n int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.maxSubs = n
+}
+
+// acquire reserves a place for a new subscriber, which is given back by release
+func (h *streamHub) acquire() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return ErrStreamClosed
+	}
+	if h.active >= h.maxSubs {
+		return ErrTooManySubscribers
+	}
+	h.active++
+	return nil
+}
+
+func (h *streamHub) release() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.active--
+}
+
+// SetReplayDir makes the hub resume subscribers from the files of a prune: writer
+func (h *streamHub) SetReplayDir(dir string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.replayDir = dir
+}
+
+func (h *streamHub) WriteKV(k, v []byte) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return ErrStreamClosed
+	}
+
+	topic := string(k)
+	if topic == heightInfoKey {
+		var info NewHeightInfo
+		if err := json.Unmarshal(v, &info); err == nil {
+			h.height = info.Height
+		}
+		h.pending = nil
+	}
+	// the hub keeps the payload after WriteKV returns
+	h.pending = append(h.pending, StreamMsg{Topic: topic, Height: h.height, Payload: append([]byte(nil), v...)})
+	if topic == commitKey {
+		h.commit(streamBlock{height: h.height, msgs: h.pending})
+		h.pending = nil
+	}
+	return nil
+}
+
+func (h *streamHub) commit(block streamBlock) {
+	h.recent = append(h.recent, block)
+	if len(h.recent) > StreamRecentBlocks {
+		h.recent = h.recent[len(h.recent)-StreamRecentBlocks:]
+	}
+	for sub := range h.subs {
+		h.push(sub, block)
+	}
+}
+
+func (h *streamHub) push(sub *streamSubscriber, block streamBlock) {
+	if block.height < sub.filter.FromHeight {
+		return
+	}
+	msgs := sub.filter.filter(block.msgs)
+	if len(msgs) == 0 {
+		return
+	}
+	select {
+	case sub.blocks <- msgs:
+	default:
+		h.drop(sub, ErrSlowConsumer)
+	}
+}
+
+func (h *streamHub) drop(sub *streamSubscriber, err error) {
+	delete(h.subs, sub)
+	sub.err = err
+	close(sub.quit)
+}
+
+func (h *streamHub) unsubscribe(sub *streamSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subs, sub)
+}
+
+func (h *streamHub) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for sub := range h.subs {
+		h.drop(sub, ErrStreamClosed)
+	}
+	return nil
+}
+
+// Subscribe pushes the messages selected by filter through send, until send fails, done is closed,
+// the subscriber falls behind or the hub is closed. It returns nil only when done is closed.
+func (h *streamHub) Subscribe(filter StreamFilter, send func(StreamMsg) error, done <-chan struct{}) error {
+	if err := h.acquire(); err != nil {
+		return err
+	}
+	defer h.release()
+
+	sub, err := h.catchUp(filter, send)
+	if err != nil {
+		return err
+	}
+	defer h.unsubscribe(sub)
+
+	for {
+		select {
+		case msgs := <-sub.blocks:
+			for _, msg := range msgs {
+				if err := send(msg); err != nil {
+					return err
+				}
+			}
+		case <-sub.quit:
+			return sub.err
+		case <-done:
+			return nil
+		}
+	}
+}
+
+// catchUp replays the blocks from filter.FromHeight and registers the subscriber once it reaches
+// the blocks kept in memory. The blocks older than them are complete in the replay dir, because
+// the producer writes every message to all its writers before writing the next one.
+func (h *streamHub) catchUp(filter StreamFilter, send func(StreamMsg) error) (*streamSubscriber, error) {
+	sub := &streamSubscriber{
+		filter: filter,
+		blocks: make(chan []StreamMsg, StreamBufferBlocks),
+		quit:   make(chan struct{}),
+	}
+	next := filter.FromHeight
+	exhausted := false
+	for {
+		h.mu.Lock()
+		if h.closed {
+			h.mu.Unlock()
+			return nil, ErrStreamClosed
+		}
+		inMemory := len(h.recent) != 0 && next >= h.recent[0].height
+		if next <= 0 || inMemory || (len(h.recent) == 0 && (exhausted || len(h.replayDir) == 0)) {
+			if !inMemory && next > 0 && h.height != 0 && next < h.height {
+				h.mu.Unlock()
+				return nil, ErrHeightNotAvailable
+			}
+			sub.filter.FromHeight = next
+			h.subs[sub] = struct{}{}
+			for _, block := range h.recent {
+				if _, ok := h.subs[sub]; ok {
+					h.push(sub, block)
+				}
+			}
+			h.mu.Unlock()
+			return sub, nil
+		}
+		if exhausted || len(h.replayDir) == 0 {
+			h.mu.Unlock()
+			return nil, ErrHeightNotAvailable
+		}
+		dir, upTo := h.replayDir, int64(math.MaxInt64)
+		if len(h.recent) != 0 {
+			upTo = h.recent[0].height
+		}
+		h.mu.Unlock()
+
+		last, err := replayFromDir(dir, next, upTo, filter, send)
+		if err != nil {
+			return nil, err
+		}
+		if last < next {
+			exhausted = true
+		} else {
+			next = last + 1
+		}
+	}
+}
+
+// replayFromDir sends the committed blocks in [from, upTo) found in the files of a prune: writer,
+// and returns the height of the last block it went through. A trailing incomplete block is skipped.
+func replayFromDir(dir string, from, upTo int64, filter StreamFilter, send func(StreamMsg) error) (int64, error) {
+	indexes, err := getFileIndexes(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	// start from the last file whose first block is not after from
+	start := 0
+	for i := 1; i < len(indexes); i++ {
+		if height, ok := getFileFirstHeight(GetFileName(dir, indexes[i])); !ok || height > from {
+			break
+		}
+		start = i
+	}
+
+	last := int64(0)
+	var block *streamBlock
+	for _, index := range indexes[start:] {
+		stop, err := replayFile(GetFileName(dir, index), func(msg StreamMsg) (bool, error) {
+			if msg.Topic == heightInfoKey {
+				block = &streamBlock{height: msg.Height}
+			}
+			if block == nil {
+				return false, nil
+			}
+			block.msgs = append(block.msgs, msg)
+			if msg.Topic != commitKey {
+				return false, nil
+			}
+			if block.height >= upTo {
+				return true, nil
+			}
+			if block.height >= from {
+				// the blocks from `from` were pruned
+				if last == 0 && block.height != from {
+					return true, ErrHeightNotAvailable
+				}
+				for _, m := range filter.filter(block.msgs) {
+					if err := send(m); err != nil {
+						return true, err
+					}
+				}
+				last = block.height
+			}
+			block = nil
+			return false, nil
+		})
+		if err != nil || stop {
+			return last, err
+		}
+	}
+	return last, nil
+}
+
+// replayFile calls fn with the complete lines of a file, until fn asks to stop
+func replayFile(filePath string, fn func(StreamMsg) (bool, error)) (bool, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	height := int64(0)
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			// io.EOF, or the partial line being written
+			return false, nil
+		}
+		msg, ok := parseLine(line, &height)
+		if !ok {
+			continue
+		}
+		if stop, err := fn(msg); stop || err != nil {
+			return stop, err
+		}
+	}
+}
+
+func getFileFirstHeight(filePath string) (int64, bool) {
+	height, found := int64(0), false
+	_, _ = replayFile(filePath, func(msg StreamMsg) (bool, error) {
+		found = msg.Topic == heightInfoKey
+		height = msg.Height
+		return true, nil
+	})
+	return height, found
+}
+
+// parseLine parses a line written by the dir writers, i.e. key#value\r\n
+func parseLine(line []byte, height *int64) (StreamMsg, bool) {
+	line = bytes.TrimRight(line, "\r\n")
+	sep := bytes.IndexByte(line, '#')
+	if sep < 0 {
+		return StreamMsg{}, false
+	}
+	msg := StreamMsg{Topic: string(line[:sep]), Payload: line[sep+1:]}
+	if msg.Topic == heightInfoKey {
+		var info NewHeightInfo
+		if err := json.Unmarshal(msg.Payload, &info); err != nil {
+			return StreamMsg{}, false
+		}
+		*height = info.Height
+	}
+	msg.Height = *height
+	return msg, true
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
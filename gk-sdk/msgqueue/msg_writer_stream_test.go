@@ -0,0 +1,289 @@
+package msgqueue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testOrderMsg = `{"trading_pair":"abc/cet","sender":"%s","height":%d}`
+	testAddrA    = "coinex1aaa"
+	testAddrB    = "coinex1bbb"
+)
+
+func writeStreamBlock(t *testing.T, w interface{ WriteKV(k, v []byte) error }, height int64) {
+	require.NoError(t, w.WriteKV([]byte("height_info"), []byte(fmt.Sprintf(`{"height":%d}`, height))))
+	require.NoError(t, w.WriteKV([]byte("create_order_info"), []byte(fmt.Sprintf(testOrderMsg, testAddrA, height))))
+	require.NoError(t, w.WriteKV([]byte("fill_order_info"),
+		[]byte(fmt.Sprintf(`{"trading_pair":"xyz/cet","sender":"%s","height":%d}`, testAddrB, height))))
+	require.NoError(t, w.WriteKV([]byte("commit"), []byte("{}")))
+}
+
+type recorder struct {
+	msgs chan StreamMsg
+}
+
+func newRecorder() *recorder {
+	return &recorder{msgs: make(chan StreamMsg, 1000)}
+}
+
+func (r *recorder) send(msg StreamMsg) error {
+	r.msgs <- msg
+	return nil
+}
+
+func (r *recorder) next(t *testing.T) StreamMsg {
+	select {
+	case msg := <-r.msgs:
+		return msg
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no message received")
+		return StreamMsg{}
+	}
+}
+
+func (r *recorder) expect(t *testing.T, topic string, height int64) {
+	msg := r.next(t)
+	require.Equal(t, topic, msg.Topic)
+	require.Equal(t, height, msg.Height)
+}
+
+func subscribeAsync(hub *streamHub, filter StreamFilter, r *recorder) (chan struct{}, chan error) {
+	done, res := make(chan struct{}), make(chan error, 1)
+	go func() {
+		res <- hub.Subscribe(filter, r.send, done)
+	}()
+	return done, res
+}
+
+// waitFor polls cond, require.Eventually of testify v1.4.0 may panic when cond is slow
+func waitFor(t *testing.T, cond func() bool) {
+	for deadline := time.Now().Add(5 * time.Second); !cond(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+	}
+}
+
+func waitSubscribers(t *testing.T, hub *streamHub, n int) {
+	waitFor(t, func() bool {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		return len(hub.subs) == n
+	})
+}
+
+func TestStreamFilter(t *testing.T) {
+	order := StreamMsg{Topic: "create_order_info", Payload: []byte(fmt.Sprintf(testOrderMsg, testAddrA, 1))}
+	commit := StreamMsg{Topic: "commit", Payload: []byte("{}")}
+
+	require.True(t, StreamFilter{}.match(order))
+	require.True(t, StreamFilter{Topics: []string{"create_order_info"}}.match(order))
+	require.False(t, StreamFilter{Topics: []string{"fill_order_info"}}.match(order))
+	require.True(t, StreamFilter{TradingPair: "abc/cet"}.match(order))
+	require.False(t, StreamFilter{TradingPair: "xyz/cet"}.match(order))
+	require.True(t, StreamFilter{Address: testAddrA}.match(order))
+	require.False(t, StreamFilter{Address: testAddrB}.match(order))
+
+	// height_info and commit are only filtered by topics
+	require.True(t, StreamFilter{TradingPair: "xyz/cet", Address: testAddrB}.match(commit))
+	require.False(t, StreamFilter{Topics: []string{"create_order_info"}}.match(commit))
+
+	filter, err := parseStreamFilter("a,b", "abc/cet", testAddrA, "10")
+	require.NoError(t, err)
+	require.Equal(t, StreamFilter{Topics: []string{"a", "b"}, TradingPair: "abc/cet", Address: testAddrA, FromHeight: 10}, filter)
+	_, err = parseStreamFilter("", "", "", "-1")
+	require.Error(t, err)
+}
+
+func TestStreamHubSubscribe(t *testing.T) {
+	hub := newStreamHub()
+	r := newRecorder()
+	done, res := subscribeAsync(hub, StreamFilter{TradingPair: "abc/cet"}, r)
+	waitSubscribers(t, hub, 1)
+
+	writeStreamBlock(t, hub, 1)
+	r.expect(t, "height_info", 1)
+	r.expect(t, "create_order_info", 1)
+	r.expect(t, "commit", 1)
+
+	// uncommitted messages are not pushed
+	require.NoError(t, hub.WriteKV([]byte("height_info"), []byte(`{"height":2}`)))
+	require.NoError(t, hub.WriteKV([]byte("create_order_info"), []byte(fmt.Sprintf(testOrderMsg, testAddrA, 2))))
+	require.Len(t, r.msgs, 0)
+
+	close(done)
+	require.NoError(t, <-res)
+	waitSubscribers(t, hub, 0)
+}
+
+func TestStreamHubResumeFromMemory(t *testing.T) {
+	defer func(n int) { StreamRecentBlocks = n }(StreamRecentBlocks)
+	StreamRecentBlocks = 3
+
+	hub := newStreamHub()
+	for h := int64(1); h <= 5; h++ {
+		writeStreamBlock(t, hub, h)
+	}
+
+	r := newRecorder()
+	done, _ := subscribeAsync(hub, StreamFilter{Topics: []string{"height_info"}, FromHeight: 4}, r)
+	r.expect(t, "height_info", 4)
+	r.expect(t, "height_info", 5)
+	writeStreamBlock(t, hub, 6)
+	r.expect(t, "height_info", 6)
+	close(done)
+
+	// blocks before the ones in memory are not available without a replay dir
+	err := hub.Subscribe(StreamFilter{FromHeight: 2}, newRecorder().send, nil)
+	require.Equal(t, ErrHeightNotAvailable, err)
+}
+
+func TestStreamHubResumeFromDir(t *testing.T) {
+	defer func(n int) { StreamRecentBlocks = n }(StreamRecentBlocks)
+	StreamRecentBlocks = 2
+	viper.Set("genesis_block_height", 0)
+	defer os.RemoveAll("stream_test")
+
+	dirWriter, err := NewRegulateWriteDir("stream_test")
+	require.NoError(t, err)
+	hub := newStreamHub()
+	hub.SetReplayDir(dirWriter.Dir())
+	for h := int64(1); h <= 5; h++ {
+		writeStreamBlock(t, dirWriter, h)
+		writeStreamBlock(t, hub, h)
+	}
+
+	r := newRecorder()
+	done, _ := subscribeAsync(hub, StreamFilter{Topics: []string{"height_info"}, FromHeight: 2}, r)
+	for h := int64(2); h <= 5; h++ {
+		r.expect(t, "height_info", h)
+	}
+	writeStreamBlock(t, dirWriter, 6)
+	writeStreamBlock(t, hub, 6)
+	r.expect(t, "height_info", 6)
+	close(done)
+	require.NoError(t, dirWriter.Close())
+}
+
+func TestStreamHubSlowConsumer(t *testing.T) {
+	defer func(n int) { StreamBufferBlocks = n }(StreamBufferBlocks)
+	StreamBufferBlocks = 2
+
+	hub := newStreamHub()
+	blocked := make(chan struct{})
+	res := make(chan error, 1)
+	go func() {
+		res <- hub.Subscribe(StreamFilter{}, func(StreamMsg) error {
+			<-blocked
+			return nil
+		}, nil)
+	}()
+	waitSubscribers(t, hub, 1)
+
+	// WriteKV never blocks on the subscriber
+	for h := int64(1); h <= 5; h++ {
+		writeStreamBlock(t, hub, h)
+	}
+	close(blocked)
+	require.Equal(t, ErrSlowConsumer, <-res)
+	waitSubscribers(t, hub, 0)
+}
+
+func TestWebSocketMsgWriter(t *testing.T) {
+	w, err := NewWebSocketMsgWriter("127.0.0.1:0")
+	require.NoError(t, err)
+	defer w.Close()
+
+	url := fmt.Sprintf("ws://%s/stream?address=%s", w.(*wsMsgWriter).Addr(), testAddrB)
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	waitSubscribers(t, w.(*wsMsgWriter).streamHub, 1)
+
+	writeStreamBlock(t, w, 7)
+	for _, topic := range []string{"height_info", "fill_order_info", "commit"} {
+		var frame wsFrame
+		require.NoError(t, conn.ReadJSON(&frame))
+		require.Equal(t, topic, frame.Type)
+		require.EqualValues(t, 7, frame.Height)
+	}
+
+	_, _, err = websocket.DefaultDialer.Dial(fmt.Sprintf("ws://%s/stream?from_height=x", w.(*wsMsgWriter).Addr()), nil)
+	require.Error(t, err)
+
+	// the pages of other hosts are rejected unless their origins are allowed
+	header := http.Header{"Origin": []string{"https://a.com"}}
+	_, _, err = websocket.DefaultDialer.Dial(url, header)
+	require.Error(t, err)
+	w.(*wsMsgWriter).SetAllowedOrigins([]string{"https://a.com"})
+	other, _, err := websocket.DefaultDialer.Dial(url, header)
+	require.NoError(t, err)
+	other.Close()
+	waitSubscribers(t, w.(*wsMsgWriter).streamHub, 1)
+
+	// the subscribers over the limit are closed at once
+	w.(*wsMsgWriter).SetMaxSubscribers(1)
+	other, _, err = websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	_, _, err = other.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseTryAgainLater))
+	other.Close()
+
+	// closing the writer closes the connections
+	require.NoError(t, w.Close())
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseTryAgainLater))
+}
+
+func TestGRPCMsgWriter(t *testing.T) {
+	w, err := NewGRPCMsgWriter("127.0.0.1:0")
+	require.NoError(t, err)
+	defer w.Close()
+
+	conn, err := grpc.Dial(w.(*grpcMsgWriter).Addr(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	client := NewMsgQueueClient(conn)
+
+	writeStreamBlock(t, w, 1)
+	writeStreamBlock(t, w, 2)
+	stream, err := client.Subscribe(context.Background(), &SubscribeRequest{
+		Topics:      []string{"create_order_info", "fill_order_info"},
+		TradingPair: "abc/cet",
+		FromHeight:  1,
+	})
+	require.NoError(t, err)
+	for h := int64(1); h <= 3; h++ {
+		if h == 3 {
+			writeStreamBlock(t, w, 3)
+		}
+		msg, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, "create_order_info", msg.Topic)
+		require.Equal(t, h, msg.Height)
+		require.Equal(t, fmt.Sprintf(testOrderMsg, testAddrA, h), string(msg.Payload))
+	}
+
+	stream, err = client.Subscribe(context.Background(), &SubscribeRequest{FromHeight: -1})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	w.(*grpcMsgWriter).SetMaxSubscribers(1)
+	stream, err = client.Subscribe(context.Background(), &SubscribeRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -39,6 +39,16 @@ func TestCreateMsgWriter(t *testing.T) {
 	defer os.RemoveAll("tmp")
 	require.Equal(t, "dir", w.String())
 	require.NoError(t, w.Close())
+
+	w, err = createMsgWriter("ws:127.0.0.1:0")
+	require.NoError(t, err)
+	require.Equal(t, "ws", w.String())
+	require.NoError(t, w.Close())
+
+	w, err = createMsgWriter("grpc:127.0.0.1:0")
+	require.NoError(t, err)
+	require.Equal(t, "grpc", w.String())
+	require.NoError(t, w.Close())
 }
 
 func TestNopMsgWriter(t *testing.T) {
@@ -0,0 +1,147 @@
+package msgqueue
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsStreamPath      = "/stream"
+	wsWriteTimeout    = 10 * time.Second
+	wsQueryTopics     = "topics"
+	wsQueryPair       = "trading_pair"
+	wsQueryAddress    = "address"
+	wsQueryFromHeight = "from_height"
+)
+
+var _ MsgWriter = (*wsMsgWriter)(nil)
+
+// wsMsgWriter serves the messages at ws://<addr>/stream?topics=t1,t2&trading_pair=abc/cet&address=coinex1...&from_height=100,
+// every message is sent as a text frame of {"type":"<topic>","version":<schema version>,"height":<height>,"payload":<message>}
+type wsMsgWriter struct {
+	*streamHub
+	listener net.Listener
+	server   *http.Server
+	upgrader websocket.Upgrader
+
+	originMu sync.RWMutex
+	origins  []string
+}
+
+type wsFrame struct {
+	Type    string          `json:"type"`
+	Version uint32          `json:"version"`
+	Height  int64           `json:"height"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+func NewWebSocketMsgWriter(addr string) (MsgWriter, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return &wsMsgWriter{}, err
+	}
+	w := &wsMsgWriter{
+		streamHub: newStreamHub(),
+		listener:  listener,
+	}
+	w.upgrader.CheckOrigin = w.checkOrigin
+	mux := http.NewServeMux()
+	mux.HandleFunc(wsStreamPath, w.serveStream)
+	w.server = &http.Server{Handler: mux}
+	go func() {
+		_ = w.server.Serve(listener)
+	}()
+	return w, nil
+}
+
+// SetAllowedOrigins sets the origins of the browsers allowed to subscribe, "*" allows all of them.
+// Without them only the pages served from the same host are allowed.
+func (w *wsMsgWriter) SetAllowedOrigins(origins []string) {
+	w.originMu.Lock()
+	defer w.originMu.Unlock()
+	w.origins = origins
+}
+
+// checkOrigin always accepts the clients which are not browsers, as they send no Origin header
+func (w *wsMsgWriter) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if len(origin) == 0 {
+		return true
+	}
+	w.originMu.RLock()
+	defer w.originMu.RUnlock()
+	if len(w.origins) == 0 {
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+	for _, allowed := range w.origins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *wsMsgWriter) serveStream(rw http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter, err := parseStreamFilter(query.Get(wsQueryTopics), query.Get(wsQueryPair),
+		query.Get(wsQueryAddress), query.Get(wsQueryFromHeight))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	conn, err := w.upgrader.Upgrade(rw, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	// the subscriber sends nothing, reading only detects it is gone
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = w.Subscribe(filter, func(msg StreamMsg) error {
+		payload := json.RawMessage(msg.Payload)
+		if !json.Valid(payload) {
+			payload, _ = json.Marshal(string(msg.Payload))
+		}
+		_ = conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+		return conn.WriteJSON(wsFrame{Type: msg.Topic, Version: SchemaVersion(msg.Topic), Height: msg.Height, Payload: payload})
+	}, done)
+	if err != nil {
+		_ = conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error()),
+			time.Now().Add(wsWriteTimeout))
+	}
+}
+
+// Addr returns the address the server listens on
+func (w *wsMsgWriter) Addr() string {
+	return w.listener.Addr().String()
+}
+
+func (w *wsMsgWriter) Close() error {
+	if w.streamHub == nil {
+		return nil
+	}
+	_ = w.streamHub.Close()
+	return w.server.Close()
+}
+
+func (w *wsMsgWriter) String() string {
+	return "ws"
+}
@@ -25,6 +25,8 @@ const (
 	CfgPrefixDir   = "dir:"
 	CfgNamedPipe   = "pipe:"
 	CfgPrefixPrune = "prune:"
+	CfgPrefixWS    = "ws:"
+	CfgPrefixGRPC  = "grpc:"
 )
 
 const RetryNum = math.MaxInt64
@@ -38,20 +40,26 @@ type MsgSender interface {
 }
 
 type producer struct {
-	toggle     bool
-	subTopics  map[string]struct{}
-	msgWriters []MsgWriter
-	log        log.Logger
+	toggle      bool
+	subTopics   map[string]struct{}
+	msgWriters  []MsgWriter
+	writerNames []string
+	router      *topicRouter
+	log         log.Logger
 }
 
 func NewProducer(log log.Logger) MsgSender {
 	brokers := viper.GetStringSlice(FlagBrokers)
 	topics := viper.GetString(FlagTopics)
 	featureToggle := viper.GetBool(FlagFeatureToggle)
-	return NewProducerFromConfig(brokers, topics, featureToggle, log)
+	return newProducer(brokers, topics, featureToggle, viper.GetString(FlagRoutes), log)
 }
 
 func NewProducerFromConfig(brokers []string, topics string, featureToggle bool, log log.Logger) MsgSender {
+	return newProducer(brokers, topics, featureToggle, "", log)
+}
+
+func newProducer(brokers []string, topics string, featureToggle bool, routesFile string, log log.Logger) producer {
 	p := producer{
 		subTopics:  make(map[string]struct{}),
 		msgWriters: nil,
@@ -59,6 +67,10 @@ func NewProducerFromConfig(brokers []string, topics string, featureToggle bool,
 	}
 
 	p.init(brokers, topics, featureToggle)
+	if len(routesFile) != 0 && len(p.msgWriters) != 0 {
+		p.router = newTopicRouter(routesFile, p.msgWriters, p.writerNames, log)
+		p.router.waitReloadSignal()
+	}
 	return p
 }
 
@@ -87,12 +99,17 @@ func (p *producer) init(brokers []string, topics string, featureToggle bool) {
 				p.log.Error(fmt.Sprintf("create msgWrite : %s failed, err : %s\n", broker, err.Error()))
 			}
 		} else {
+			if lw, ok := msgWriter.(interface{ SetLogger(log.Logger) }); ok && p.log != nil {
+				lw.SetLogger(p.log)
+			}
 			p.msgWriters = append(p.msgWriters, msgWriter)
+			p.writerNames = append(p.writerNames, writerName(broker))
 			if p.log != nil {
 				p.log.Info(fmt.Sprintf("create write : %s succueed", msgWriter.String()))
 			}
 		}
 	}
+	p.setReplayDir()
 	ts := strings.Split(topics, ",")
 	for _, topic := range ts {
 		p.subTopics[topic] = struct{}{}
@@ -100,7 +117,28 @@ func (p *producer) init(brokers []string, topics string, featureToggle bool) {
 	p.toggle = featureToggle
 }
 
+// setReplayDir lets the streaming writers resume subscribers from the files of the prune: writer
+func (p *producer) setReplayDir() {
+	dir := ""
+	for _, w := range p.msgWriters {
+		if rgw, ok := w.(*RegulateWriteDir); ok {
+			dir = rgw.Dir()
+		}
+	}
+	if len(dir) == 0 {
+		return
+	}
+	for _, w := range p.msgWriters {
+		if sw, ok := w.(interface{ SetReplayDir(string) }); ok {
+			sw.SetReplayDir(dir)
+		}
+	}
+}
+
 func (p producer) Close() {
+	if p.router != nil {
+		p.router.close()
+	}
 	for _, w := range p.msgWriters {
 		if err := w.Close(); err != nil {
 			if p.log != nil {
@@ -111,7 +149,11 @@ func (p producer) Close() {
 }
 
 func (p producer) SendMsg(k []byte, v []byte) {
-	for _, w := range p.msgWriters {
+	writers := p.msgWriters
+	if p.router != nil {
+		writers = p.router.route(string(k))
+	}
+	for _, w := range writers {
 		if err := Retry(RetryNum, time.Millisecond, func() error {
 			return w.WriteKV(k, v)
 		}); err != nil {
@@ -24,3 +24,102 @@ func TestNewProducer(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "foo#bar\r\n", string(data))
 }
+
+func TestProducerRoutes(t *testing.T) {
+	defer os.Remove("fills.txt")
+	defer os.Remove("others.txt")
+	defer os.Remove("routes.json")
+	require.NoError(t, ioutil.WriteFile("routes.json", []byte(`{"fill_order_info":["fills"],"notify_tx":[]}`), 0644))
+	p := newProducer([]string{"file:fills.txt?name=fills", "file:others.txt"}, "market", true, "routes.json", nil)
+	require.Equal(t, []string{"fills", "file"}, p.writerNames)
+
+	p.SendMsg([]byte("fill_order_info"), []byte("1"))
+	p.SendMsg([]byte("notify_tx"), []byte("2"))
+	p.SendMsg([]byte("height_info"), []byte("3"))
+
+	// the routes are reloaded, an invalid file keeps the current routes
+	require.NoError(t, ioutil.WriteFile("routes.json", []byte(`{"*":["file"],"notify_tx":["fills","unknown"]}`), 0644))
+	require.Error(t, p.router.reload())
+	p.SendMsg([]byte("notify_tx"), []byte("4"))
+	require.NoError(t, ioutil.WriteFile("routes.json", []byte(`{"*":["file"],"notify_tx":["fills","file"]}`), 0644))
+	require.NoError(t, p.router.reload())
+	p.SendMsg([]byte("height_info"), []byte("5"))
+	p.SendMsg([]byte("fill_order_info"), []byte("6"))
+	p.SendMsg([]byte("notify_tx"), []byte("7"))
+	p.Close()
+
+	data, err := ioutil.ReadFile("fills.txt")
+	require.NoError(t, err)
+	require.Equal(t, "fill_order_info#1\r\nheight_info#3\r\nheight_info#5\r\nnotify_tx#7\r\n", string(data))
+	data, err = ioutil.ReadFile("others.txt")
+	require.NoError(t, err)
+	require.Equal(t, "height_info#3\r\nheight_info#5\r\nfill_order_info#6\r\nnotify_tx#7\r\n", string(data))
+
+	// all the topics are sent to all the writers when the routes can not be loaded
+	p = newProducer([]string{"nop"}, "market", true, "no_routes.json", nil)
+	require.Len(t, p.router.route("fill_order_info"), 1)
+	p.Close()
+}
+
+func TestProducerRoutesBlockMarkers(t *testing.T) {
+	defer os.Remove("fills.txt")
+	defer os.Remove("others.txt")
+	defer os.Remove("unused.txt")
+	defer os.Remove("routes.json")
+	require.NoError(t, ioutil.WriteFile("routes.json", []byte(`{"notify_tx":["fills"],"*":["file"],"commit":[]}`), 0644))
+	p := newProducer([]string{"file:fills.txt?name=fills", "file:others.txt", "file:unused.txt?name=unused"},
+		"market", true, "routes.json", nil)
+
+	// the markers go to the writers of a routed topic, whatever the routes of the markers are
+	p.SendMsg([]byte("height_info"), []byte("1"))
+	p.SendMsg([]byte("notify_tx"), []byte("2"))
+	p.SendMsg([]byte("send_coins"), []byte("3"))
+	p.SendMsg([]byte("commit"), []byte("4"))
+
+	// the writers without a routed topic get no markers
+	require.NoError(t, ioutil.WriteFile("routes.json", []byte(`{"notify_tx":["fills"],"*":[]}`), 0644))
+	require.NoError(t, p.router.reload())
+	p.SendMsg([]byte("height_info"), []byte("5"))
+	p.SendMsg([]byte("send_coins"), []byte("6"))
+	p.SendMsg([]byte("commit"), []byte("7"))
+	p.Close()
+
+	data, err := ioutil.ReadFile("fills.txt")
+	require.NoError(t, err)
+	require.Equal(t, "height_info#1\r\nnotify_tx#2\r\ncommit#4\r\nheight_info#5\r\ncommit#7\r\n", string(data))
+	data, err = ioutil.ReadFile("others.txt")
+	require.NoError(t, err)
+	require.Equal(t, "height_info#1\r\nsend_coins#3\r\ncommit#4\r\n", string(data))
+	data, err = ioutil.ReadFile("unused.txt")
+	require.NoError(t, err)
+	require.Empty(t, data)
+}
+
+func TestProducerRoutesReloadMidBlock(t *testing.T) {
+	defer os.Remove("fills.txt")
+	defer os.Remove("others.txt")
+	defer os.Remove("routes.json")
+	require.NoError(t, ioutil.WriteFile("routes.json", []byte(`{"fill_order_info":["fills"],"*":["file"]}`), 0644))
+	p := newProducer([]string{"file:fills.txt?name=fills", "file:others.txt"}, "market", true, "routes.json", nil)
+
+	// the routes reloaded in a block are used from the next block
+	p.SendMsg([]byte("height_info"), []byte("1"))
+	p.SendMsg([]byte("fill_order_info"), []byte("2"))
+	require.NoError(t, ioutil.WriteFile("routes.json", []byte(`{"*":["fills"]}`), 0644))
+	require.NoError(t, p.router.reload())
+	p.SendMsg([]byte("fill_order_info"), []byte("3"))
+	p.SendMsg([]byte("send_coins"), []byte("4"))
+	p.SendMsg([]byte("commit"), []byte("5"))
+	p.SendMsg([]byte("height_info"), []byte("6"))
+	p.SendMsg([]byte("send_coins"), []byte("7"))
+	p.SendMsg([]byte("commit"), []byte("8"))
+	p.Close()
+
+	data, err := ioutil.ReadFile("fills.txt")
+	require.NoError(t, err)
+	require.Equal(t, "height_info#1\r\nfill_order_info#2\r\nfill_order_info#3\r\ncommit#5\r\n"+
+		"height_info#6\r\nsend_coins#7\r\ncommit#8\r\n", string(data))
+	data, err = ioutil.ReadFile("others.txt")
+	require.NoError(t, err)
+	require.Equal(t, "height_info#1\r\nsend_coins#4\r\ncommit#5\r\n", string(data))
+}
@@ -0,0 +1,196 @@
+package msgqueue
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// FlagRoutes is the path of the routes file, a JSON object which maps the topics to the names of the writers:
+//
+//	{
+//	  "fill_order_info": ["fills"],
+//	  "notify_unlock": ["file"],
+//	  "notify_tx": ["pipe"],
+//	  "*": ["prune", "fills"]
+//	}
+//
+// The writers are named with the name option of their configs, e.g. kafka:broker1?name=fills,
+// or by their kinds (kafka, file, pipe, prune...) by default. A topic routed to an empty list is
+// dropped, and the topics not in the file go to the writers of "*", or to all the writers when
+// there is no "*". The block markers height_info and commit are not routed by the file, they go to
+// every writer which has a routed topic, since the writers need them to frame the blocks. The file
+// is reloaded on the signal set by SetReloadRoutesSignal, and the reloaded routes take effect from
+// the next height_info, so that every block is sent by the same routes.
+const FlagRoutes = "msgqueue-routes"
+
+const routeOthers = "*"
+
+var reloadRoutesSignal os.Signal
+
+func SetReloadRoutesSignal(signal os.Signal) {
+	reloadRoutesSignal = signal
+}
+
+type routeTable map[string][]MsgWriter
+
+type topicRouter struct {
+	file  string
+	all   []MsgWriter
+	names []string // the names of all
+	named map[string][]MsgWriter
+	table atomic.Value // routeTable
+	log   log.Logger
+	done  chan struct{}
+
+	mu      sync.Mutex
+	pending routeTable // the reloaded routes waiting for the next block
+}
+
+func newTopicRouter(file string, writers []MsgWriter, names []string, log log.Logger) *topicRouter {
+	r := &topicRouter{
+		file:  file,
+		all:   writers,
+		names: names,
+		named: make(map[string][]MsgWriter),
+		log:   log,
+		done:  make(chan struct{}),
+	}
+	for i, w := range writers {
+		r.named[names[i]] = append(r.named[names[i]], w)
+	}
+	r.table.Store(routeTable{})
+	if err := r.reload(); err != nil && log != nil {
+		log.Error(fmt.Sprintf("load msgqueue routes failed, all topics are sent to all writers, err : %s", err.Error()))
+	}
+	r.applyPending()
+	return r
+}
+
+// reload keeps the current routes if the file is invalid, the loaded routes are applied by
+// the next height_info
+func (r *topicRouter) reload() error {
+	bz, err := ioutil.ReadFile(r.file)
+	if err != nil {
+		return err
+	}
+	var routes map[string][]string
+	if err := json.Unmarshal(bz, &routes); err != nil {
+		return fmt.Errorf("invalid routes file %s: %s", r.file, err.Error())
+	}
+	table := make(routeTable, len(routes)+2)
+	routed := make(map[string]bool, len(r.named))
+	for topic, names := range routes {
+		writers := make([]MsgWriter, 0, len(names))
+		for _, name := range names {
+			ws, ok := r.named[name]
+			if !ok {
+				return fmt.Errorf("unknown writer %s of topic %s, the writers are: %s",
+					name, topic, strings.Join(r.sortedNames(), ","))
+			}
+			writers = append(writers, ws...)
+			if topic != heightInfoKey && topic != commitKey {
+				routed[name] = true
+			}
+		}
+		table[topic] = writers
+	}
+	table[heightInfoKey] = r.markerWriters(routes, routed)
+	table[commitKey] = table[heightInfoKey]
+	r.mu.Lock()
+	r.pending = table
+	r.mu.Unlock()
+	if r.log != nil {
+		r.log.Info(fmt.Sprintf("msgqueue routes are loaded from %s", r.file))
+	}
+	return nil
+}
+
+// markerWriters returns the writers which have a routed topic, all of them when some topics
+// are not in the routes and have no "*"
+func (r *topicRouter) markerWriters(routes map[string][]string, routed map[string]bool) []MsgWriter {
+	if _, ok := routes[routeOthers]; !ok {
+		return r.all
+	}
+	writers := make([]MsgWriter, 0, len(r.all))
+	for i, w := range r.all {
+		if routed[r.names[i]] {
+			writers = append(writers, w)
+		}
+	}
+	return writers
+}
+
+func (r *topicRouter) sortedNames() []string {
+	names := make([]string, 0, len(r.named))
+	for name := range r.named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *topicRouter) applyPending() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.pending != nil {
+		r.table.Store(r.pending)
+		r.pending = nil
+	}
+}
+
+func (r *topicRouter) route(topic string) []MsgWriter {
+	if topic == heightInfoKey {
+		r.applyPending()
+	}
+	table := r.table.Load().(routeTable)
+	if writers, ok := table[topic]; ok {
+		return writers
+	}
+	if writers, ok := table[routeOthers]; ok {
+		return writers
+	}
+	return r.all
+}
+
+func (r *topicRouter) waitReloadSignal() {
+	if reloadRoutesSignal == nil {
+		return
+	}
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, reloadRoutesSignal)
+	go func() {
+		defer signal.Stop(c)
+		for {
+			select {
+			case <-c:
+				if err := r.reload(); err != nil && r.log != nil {
+					r.log.Error(fmt.Sprintf("reload msgqueue routes failed, the routes are not changed, err : %s", err.Error()))
+				}
+			case <-r.done:
+				return
+			}
+		}
+	}()
+}
+
+func (r *topicRouter) close() {
+	close(r.done)
+}
+
+// the default name of a writer is its kind, e.g. kafka for kafka:broker1,broker2
+func writerName(cfg string) string {
+	cfg, opts, err := splitOptions(cfg)
+	if err == nil && len(opts.name) != 0 {
+		return opts.name
+	}
+	return strings.SplitN(cfg, ":", 2)[0]
+}
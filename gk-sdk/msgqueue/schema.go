@@ -0,0 +1,233 @@
+package msgqueue
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Every topic published to the msgqueue registers the Go type of its payload and a schema version.
+// The version must be increased whenever a field is renamed, removed or changes its type,
+// so that the consumers can tell which layout a message has.
+
+// Schema describes the payload of a topic, it is generated from the registered Go type
+type Schema struct {
+	Topic   string        `json:"topic"`
+	Version uint32        `json:"version"`
+	Type    string        `json:"type"`
+	Fields  []SchemaField `json:"fields"`
+}
+
+// SchemaField describes a field of a payload. Number is the protobuf field number of the
+// compact encoding, JSONType and ProtoType are the types of the field in both encodings.
+type SchemaField struct {
+	Name      string        `json:"name"`
+	Number    int           `json:"number"`
+	JSONType  string        `json:"json_type"`
+	ProtoType string        `json:"proto_type"`
+	Repeated  bool          `json:"repeated,omitempty"`
+	Fields    []SchemaField `json:"fields,omitempty"`
+}
+
+type schemaEntry struct {
+	version uint32
+	rt      reflect.Type
+	schema  Schema
+}
+
+var (
+	schemaMu sync.RWMutex
+	schemas  = make(map[string]*schemaEntry)
+)
+
+func init() {
+	RegisterSchema(heightInfoKey, 1, NewHeightInfo{})
+	RegisterSchema(commitKey, 1, struct{}{})
+}
+
+// RegisterSchema registers the payload type of a topic, it panics if the topic is registered twice
+func RegisterSchema(topic string, version uint32, payload interface{}) {
+	rt := reflect.TypeOf(payload)
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if version == 0 {
+		panic(fmt.Sprintf("schema version of %s must be positive", topic))
+	}
+	if rt.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("payload of %s must be a struct", topic))
+	}
+
+	schemaMu.Lock()
+	defer schemaMu.Unlock()
+	if _, ok := schemas[topic]; ok {
+		panic(fmt.Sprintf("schema of %s is already registered", topic))
+	}
+	schemas[topic] = &schemaEntry{
+		version: version,
+		rt:      rt,
+		schema: Schema{
+			Topic:   topic,
+			Version: version,
+			Type:    typeName(rt),
+			Fields:  structFields(rt, map[reflect.Type]bool{}),
+		},
+	}
+}
+
+// GetSchema returns the schema of a registered topic
+func GetSchema(topic string) (Schema, bool) {
+	schemaMu.RLock()
+	defer schemaMu.RUnlock()
+	entry, ok := schemas[topic]
+	if !ok {
+		return Schema{}, false
+	}
+	return entry.schema, true
+}
+
+// GetSchemas returns the schemas of all the registered topics, sorted by topic
+func GetSchemas() []Schema {
+	schemaMu.RLock()
+	defer schemaMu.RUnlock()
+	res := make([]Schema, 0, len(schemas))
+	for _, entry := range schemas {
+		res = append(res, entry.schema)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Topic < res[j].Topic })
+	return res
+}
+
+// SchemaVersion returns the schema version of a topic, zero if it is not registered
+func SchemaVersion(topic string) uint32 {
+	schemaMu.RLock()
+	defer schemaMu.RUnlock()
+	if entry, ok := schemas[topic]; ok {
+		return entry.version
+	}
+	return 0
+}
+
+func payloadType(topic string) (reflect.Type, bool) {
+	schemaMu.RLock()
+	defer schemaMu.RUnlock()
+	if entry, ok := schemas[topic]; ok {
+		return entry.rt, true
+	}
+	return nil, false
+}
+
+var (
+	jsonMarshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	aminoMarshalerName = "MarshalAmino"
+	timeType           = reflect.TypeOf(time.Time{})
+)
+
+// structFields follows the field numbering of amino, which skips the unexported fields and
+// the ones tagged with json:"-"
+func structFields(rt reflect.Type, visiting map[reflect.Type]bool) []SchemaField {
+	visiting[rt] = true
+	defer delete(visiting, rt)
+
+	fields := make([]SchemaField, 0, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if len(name) == 0 {
+			name = field.Name
+		}
+		f := SchemaField{Name: name, Number: len(fields) + 1}
+		ft := field.Type
+		if ft.Kind() == reflect.Slice && ft.Elem().Kind() != reflect.Uint8 {
+			f.Repeated = true
+			ft = ft.Elem()
+		}
+		f.JSONType, f.ProtoType = fieldTypes(ft)
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.ProtoType == "message" && !visiting[ft] {
+			f.Fields = structFields(ft, visiting)
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+func fieldTypes(rt reflect.Type) (jsonType, protoType string) {
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt == timeType {
+		return "string", "timestamp"
+	}
+	// sdk.Dec, sdk.Int, sdk.AccAddress and the like
+	if rt.Implements(jsonMarshalerType) || reflect.PtrTo(rt).Implements(jsonMarshalerType) {
+		jsonType = "string"
+	}
+	if m, ok := reflect.PtrTo(rt).MethodByName(aminoMarshalerName); ok && m.Type.NumOut() == 2 {
+		_, protoType = fieldTypes(m.Type.Out(0))
+		return orDefault(jsonType, protoType), protoType
+	}
+
+	switch rt.Kind() {
+	case reflect.String:
+		protoType = "string"
+	case reflect.Bool:
+		protoType = "bool"
+	case reflect.Int, reflect.Int64:
+		protoType = "int64"
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		protoType = "int32"
+	case reflect.Uint, reflect.Uint64:
+		protoType = "uint64"
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		protoType = "uint32"
+	case reflect.Float32, reflect.Float64:
+		protoType = "double"
+	case reflect.Slice, reflect.Array:
+		if rt.Elem().Kind() == reflect.Uint8 {
+			return orDefault(jsonType, "base64"), "bytes"
+		}
+		protoType = "bytes"
+	case reflect.Struct:
+		return orDefault(jsonType, "object"), "message"
+	case reflect.Interface:
+		return orDefault(jsonType, "object"), "any"
+	default:
+		protoType = rt.Kind().String()
+	}
+	switch protoType {
+	case "int64", "int32", "uint64", "uint32", "double":
+		return orDefault(jsonType, "number"), protoType
+	case "bool":
+		return orDefault(jsonType, "boolean"), protoType
+	default:
+		return orDefault(jsonType, "string"), protoType
+	}
+}
+
+func typeName(rt reflect.Type) string {
+	if len(rt.Name()) == 0 {
+		return rt.String()
+	}
+	return rt.PkgPath() + "." + rt.Name()
+}
+
+func orDefault(s, def string) string {
+	if len(s) != 0 {
+		return s
+	}
+	return def
+}
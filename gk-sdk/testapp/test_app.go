@@ -33,9 +33,13 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	"github.com/coinexchain/cet-sdk/modules/comment"
 	"github.com/coinexchain/cet-sdk/modules/distributionx"
+	distrxclient "github.com/coinexchain/cet-sdk/modules/distributionx/client"
 	"github.com/coinexchain/cet-sdk/modules/incentive"
+	incentiveclient "github.com/coinexchain/cet-sdk/modules/incentive/client"
 	"github.com/coinexchain/cet-sdk/modules/market"
+	marketclient "github.com/coinexchain/cet-sdk/modules/market/client"
 	"github.com/coinexchain/cet-sdk/modules/stakingx"
+	stakingxclient "github.com/coinexchain/cet-sdk/modules/stakingx/client"
 	"github.com/coinexchain/cet-sdk/modules/supplyx"
 	"github.com/coinexchain/cet-sdk/msgqueue"
 	"github.com/coinexchain/cet-sdk/types"
@@ -43,13 +47,15 @@ import (
 
 var (
 	maccPerms = map[string][]string{
-		auth.FeeCollectorName:     nil,
-		dist.ModuleName:           nil,
-		staking.BondedPoolName:    {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
-		gov.ModuleName:            {supply.Burner},
-		authx.ModuleName:          nil,
-		asset.ModuleName:          {supply.Burner, supply.Minter},
+		auth.FeeCollectorName:        nil,
+		dist.ModuleName:              nil,
+		staking.BondedPoolName:       {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:    {supply.Burner, supply.Staking},
+		gov.ModuleName:               {supply.Burner},
+		authx.ModuleName:             nil,
+		asset.ModuleName:             {supply.Burner, supply.Minter},
+		market.MiningPoolName:        nil,
+		distributionx.StreamPoolName: nil,
 	}
 )
 
@@ -66,6 +72,7 @@ type TestApp struct {
 	tkeyStaking  *sdk.TransientStoreKey
 	keySlashing  *sdk.KVStoreKey
 	keyDistr     *sdk.KVStoreKey
+	keyDistrx    *sdk.KVStoreKey
 	keyGov       *sdk.KVStoreKey
 	keyParams    *sdk.KVStoreKey
 	tkeyParams   *sdk.TransientStoreKey
@@ -127,7 +134,10 @@ func makeCodec() *codec.Codec {
 		//modules of cosmos
 		auth.AppModuleBasic{},
 		crisis.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler),
+		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler, incentiveclient.ProposalHandler,
+			distrxclient.StreamProposalHandler, distrxclient.CancelStreamProposalHandler,
+			stakingxclient.ProposalHandler, marketclient.ListMarketProposalHandler, marketclient.DelistMarketProposalHandler,
+			marketclient.MarketFeeRateProposalHandler, marketclient.MarketHaltProposalHandler),
 		slashing.AppModuleBasic{},
 		staking.AppModuleBasic{},
 		bank.AppModuleBasic{},
@@ -158,6 +168,7 @@ func newTestApp(Cdc *codec.Codec) *TestApp {
 		keyStakingX:  sdk.NewKVStoreKey(stakingx.StoreKey),
 		tkeyStaking:  sdk.NewTransientStoreKey(staking.TStoreKey),
 		keyDistr:     sdk.NewKVStoreKey(dist.StoreKey),
+		keyDistrx:    sdk.NewKVStoreKey(distributionx.StoreKey),
 		keySlashing:  sdk.NewKVStoreKey(slashing.StoreKey),
 		keyGov:       sdk.NewKVStoreKey(gov.StoreKey),
 		keyParams:    sdk.NewKVStoreKey(params.StoreKey),
@@ -215,21 +226,13 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		staking.DefaultCodespace,
 	)
 
-	// register the proposal types
-	govRouter := gov.NewRouter()
-	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(dist.RouterKey, dist.NewCommunityPoolSpendProposalHandler(app.DistrKeeper))
-
-	app.GovKeeper = gov.NewKeeper(
-		app.Cdc,
-		app.keyGov,
-		app.ParamsKeeper, app.ParamsKeeper.Subspace(gov.DefaultParamspace),
-		//app.SupplyKeeper,
-		supplyxKeeper,
-		&StakingKeeper,
-		gov.DefaultCodespace,
-		govRouter,
+	app.IncentiveKeeper = incentive.NewKeeper(
+		app.Cdc, app.keyIncentive,
+		app.ParamsKeeper.Subspace(incentive.DefaultParamspace),
+		app.BankKeeper,
+		app.DistrKeeper,
+		app.SupplyKeeper,
+		auth.FeeCollectorName,
 	)
 
 	app.CrisisKeeper = crisis.NewKeeper(
@@ -262,13 +265,6 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.ParamsKeeper.Subspace(slashing.DefaultParamspace),
 		slashing.DefaultCodespace,
 	)
-	app.IncentiveKeeper = incentive.NewKeeper(
-		app.Cdc, app.keyIncentive,
-		app.ParamsKeeper.Subspace(incentive.DefaultParamspace),
-		app.BankKeeper,
-		app.SupplyKeeper,
-		auth.FeeCollectorName,
-	)
 	app.TokenKeeper = asset.NewBaseTokenKeeper(
 		app.Cdc, app.keyAsset,
 	)
@@ -280,9 +276,13 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.MsgQueProducer,
 	)
 	app.DistrxKeeper = distributionx.NewKeeper(
+		app.Cdc,
+		app.keyDistrx,
 		app.BankxKeeper,
 		app.DistrKeeper,
+		app.SupplyKeeper,
 	)
+
 	app.AssetKeeper = asset.NewBaseKeeper(
 		app.Cdc,
 		app.keyAsset,
@@ -338,13 +338,51 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.AssetKeeper,
 		app.AccountKeeper,
 		app.DistrxKeeper,
+		app.ParamsKeeper.Subspace(comment.DefaultParamspace),
 		eventTypeMsgQueue,
 	)
+	eventTypeMsgQueue = ""
+	if app.MsgQueProducer.IsSubscribed(alias.ModuleName) {
+		eventTypeMsgQueue = msgqueue.EventTypeMsgQueue
+	}
 	app.AliasKeeper = alias.NewBaseKeeper(
 		app.keyAlias,
 		app.BankxKeeper,
 		app.AssetKeeper,
 		app.ParamsKeeper.Subspace(alias.StoreKey),
+		eventTypeMsgQueue,
+	)
+
+	// register the proposal types
+	paramValidators := map[string]types.ParamValidator{
+		asset.DefaultParamspace:      asset.ValidateParams,
+		bankx.DefaultParamspace:      bankx.ValidateParams,
+		market.DefaultParamspace:     market.ValidateParams,
+		bancorlite.DefaultParamspace: bancorlite.ValidateParams,
+		alias.DefaultParamspace:      alias.ValidateParams,
+		authx.DefaultParamspace:      authx.ValidateParams,
+		incentive.DefaultParamspace:  app.IncentiveKeeper.ValidateParams,
+		stakingx.DefaultParamspace:   stakingx.ValidateParams,
+		comment.DefaultParamspace:    comment.ValidateParams,
+	}
+	govRouter := gov.NewRouter()
+	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, types.NewParamChangeProposalHandler(app.ParamsKeeper, paramValidators)).
+		AddRoute(dist.RouterKey, dist.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
+		AddRoute(incentive.RouterKey, incentive.NewProposalHandler(app.IncentiveKeeper)).
+		AddRoute(distributionx.RouterKey, distributionx.NewProposalHandler(app.DistrxKeeper)).
+		AddRoute(stakingx.RouterKey, stakingx.NewProposalHandler(app.StakingXKeeper)).
+		AddRoute(market.RouterKey, market.NewProposalHandler(app.MarketKeeper))
+
+	app.GovKeeper = gov.NewKeeper(
+		app.Cdc,
+		app.keyGov,
+		app.ParamsKeeper, app.ParamsKeeper.Subspace(gov.DefaultParamspace),
+		//app.SupplyKeeper,
+		supplyxKeeper,
+		&StakingKeeper,
+		gov.DefaultCodespace,
+		govRouter,
 	)
 }
 
@@ -365,6 +403,7 @@ func (app *TestApp) mountStores() {
 	cms.MountStoreWithDB(app.keySupply, sdk.StoreTypeIAVL, db)
 	cms.MountStoreWithDB(app.keyStaking, sdk.StoreTypeIAVL, db)
 	cms.MountStoreWithDB(app.keyDistr, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(app.keyDistrx, sdk.StoreTypeIAVL, db)
 	cms.MountStoreWithDB(app.keySlashing, sdk.StoreTypeIAVL, db)
 	cms.MountStoreWithDB(app.keyParams, sdk.StoreTypeIAVL, db)
 	cms.MountStoreWithDB(app.keyGov, sdk.StoreTypeIAVL, db)
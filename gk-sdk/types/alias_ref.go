@@ -0,0 +1,53 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AliasPrefix marks an alias where an address is expected, e.g. "@alice"
+const AliasPrefix = "@"
+
+const (
+	EventTypeResolveAlias  = "resolve_alias"
+	AttributeKeyAlias      = "alias"
+	AttributeKeyResolvedTo = "address"
+)
+
+// AliasRef records that Addr was written as Alias when the tx was built.
+// The ante handler rejects the tx if the alias is no longer bound to Addr.
+type AliasRef struct {
+	Alias string         `json:"alias"`
+	Addr  sdk.AccAddress `json:"addr"`
+}
+
+// AliasedMsg is implemented by the msgs which accept aliases in place of addresses
+type AliasedMsg interface {
+	sdk.Msg
+	GetAliasRefs() []AliasRef
+}
+
+// IsAliasRef returns true if s has the form of "@alias"
+func IsAliasRef(s string) bool {
+	return strings.HasPrefix(s, AliasPrefix) && len(s) > len(AliasPrefix)
+}
+
+// TrimAliasPrefix returns the alias in "@alias"
+func TrimAliasPrefix(s string) string {
+	return strings.TrimPrefix(s, AliasPrefix)
+}
+
+// NewResolveAliasEvents creates one event for each alias resolved in a msg,
+// so explorers can show both the alias and the address
+func NewResolveAliasEvents(refs []AliasRef) sdk.Events {
+	events := make(sdk.Events, 0, len(refs))
+	for _, ref := range refs {
+		events = append(events, sdk.NewEvent(
+			EventTypeResolveAlias,
+			sdk.NewAttribute(AttributeKeyAlias, ref.Alias),
+			sdk.NewAttribute(AttributeKeyResolvedTo, ref.Addr.String()),
+		))
+	}
+	return events
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestIsAliasRef(t *testing.T) {
+	require.True(t, IsAliasRef("@alice"))
+	require.False(t, IsAliasRef("@"))
+	require.False(t, IsAliasRef("alice"))
+	require.False(t, IsAliasRef("coinex1y5kdxnzn2tfwayyntf2n28q8q2s80mcul852ke"))
+	require.Equal(t, "alice", TrimAliasPrefix("@alice"))
+}
+
+func TestNewResolveAliasEvents(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr"))
+	events := NewResolveAliasEvents([]AliasRef{{Alias: "alice", Addr: addr}})
+	require.Equal(t, 1, len(events))
+	require.Equal(t, EventTypeResolveAlias, events[0].Type)
+	require.Equal(t, "alice", string(events[0].Attributes[0].Value))
+	require.Equal(t, addr.String(), string(events[0].Attributes[1].Value))
+	require.Equal(t, 0, len(NewResolveAliasEvents(nil)))
+}
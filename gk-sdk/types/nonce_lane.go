@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The sequences of the nonce lanes are put above NonceLaneSeqBits in the sequences of the orders,
+// which keeps the order IDs of different lanes unique, as an account sequence never reaches 2^40
+const NonceLaneSeqBits = 40
+
+// NonceLaneOrderSequence returns the sequence used by the orders created with seq of lane
+func NonceLaneOrderSequence(lane uint32, seq uint64) uint64 {
+	return uint64(lane)<<NonceLaneSeqBits | seq
+}
+
+type orderSequenceKey struct{}
+
+type orderSequence struct {
+	addr sdk.AccAddress
+	seq  uint64
+}
+
+// WithOrderSequence sets the sequence used by the orders of addr in the tx, instead of its account sequence
+func WithOrderSequence(ctx sdk.Context, addr sdk.AccAddress, seq uint64) sdk.Context {
+	return ctx.WithValue(orderSequenceKey{}, orderSequence{addr: addr, seq: seq})
+}
+
+// GetOrderSequence returns the sequence set by WithOrderSequence for addr
+func GetOrderSequence(ctx sdk.Context, addr sdk.AccAddress) (uint64, bool) {
+	v, ok := ctx.Value(orderSequenceKey{}).(orderSequence)
+	if !ok || !v.addr.Equals(addr) {
+		return 0, false
+	}
+	return v.seq, true
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestOrderSequence(t *testing.T) {
+	addr1, addr2 := sdk.AccAddress("addr1"), sdk.AccAddress("addr2")
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+	_, ok := GetOrderSequence(ctx, addr1)
+	require.False(t, ok)
+
+	ctx = WithOrderSequence(ctx, addr1, NonceLaneOrderSequence(2, 7))
+	seq, ok := GetOrderSequence(ctx, addr1)
+	require.True(t, ok)
+	require.Equal(t, uint64(2)<<40+7, seq)
+	_, ok = GetOrderSequence(ctx, addr2)
+	require.False(t, ok)
+}
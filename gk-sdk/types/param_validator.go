@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// ParamValidator checks the whole parameter set of a module's subspace,
+// after a ParameterChangeProposal has been applied to it
+type ParamValidator func(ctx sdk.Context, ss params.Subspace) error
+
+// NewParamChangeProposalHandler wraps the params module's proposal handler and runs the
+// validators of every subspace touched by a proposal. The gov keeper executes the handler
+// in a cached context both at submission and when the proposal passes, so invalid changes
+// are rejected before voting starts and can never reach the store.
+func NewParamChangeProposalHandler(k params.Keeper, validators map[string]ParamValidator) govtypes.Handler {
+	handler := params.NewParamChangeProposalHandler(k)
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+
+		p, ok := content.(params.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		checked := make(map[string]bool)
+		for _, c := range p.Changes {
+			validate, ok := validators[c.Subspace]
+			if !ok || checked[c.Subspace] {
+				continue
+			}
+			checked[c.Subspace] = true
+
+			ss, _ := k.GetSubspace(c.Subspace)
+			if err := validate(ctx, ss); err != nil {
+				return ErrInvalidParamChange(c.Subspace, err)
+			}
+		}
+		return nil
+	}
+}
+
+func ErrInvalidParamChange(subspace string, err error) sdk.Error {
+	return sdk.NewError(params.DefaultCodespace, params.CodeSettingParameter,
+		fmt.Sprintf("invalid %s params: %s", subspace, err.Error()))
+}
@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	"github.com/coinexchain/cet-sdk/modules/incentive"
+	"github.com/coinexchain/cet-sdk/modules/stakingx"
+	"github.com/coinexchain/cet-sdk/testapp"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func TestParamChangeProposalValidation(t *testing.T) {
+	app := testapp.NewTestApp()
+	ctx := app.NewCtx()
+	app.BankxKeeper.SetParams(ctx, bankx.DefaultParams())
+	app.BancorKeeper.SetParams(ctx, bancorlite.DefaultParams())
+	app.AccountXKeeper.SetParams(ctx, authx.DefaultParams())
+	app.IncentiveKeeper.SetParams(ctx, incentive.DefaultParams())
+	app.StakingXKeeper.SetParams(ctx, stakingx.DefaultParams())
+
+	handler := dex.NewParamChangeProposalHandler(app.ParamsKeeper, map[string]dex.ParamValidator{
+		bankx.DefaultParamspace:      bankx.ValidateParams,
+		bancorlite.DefaultParamspace: bancorlite.ValidateParams,
+		authx.DefaultParamspace:      authx.ValidateParams,
+		incentive.DefaultParamspace:  app.IncentiveKeeper.ValidateParams,
+		stakingx.DefaultParamspace:   stakingx.ValidateParams,
+	})
+	submit := func(changes ...params.ParamChange) sdk.Error {
+		cacheCtx, _ := ctx.CacheContext()
+		return handler(cacheCtx, params.NewParameterChangeProposal("title", "desc", changes))
+	}
+
+	require.Nil(t, submit(params.NewParamChange(bankx.DefaultParamspace, "ActivationFee", `"200"`)))
+	err := submit(params.NewParamChange(bankx.DefaultParamspace, "ActivationFee", `"-1"`))
+	require.Equal(t, params.CodeSettingParameter, err.Code())
+	require.Contains(t, err.Error(), "invalid bankx params")
+	require.NotNil(t, submit(params.NewParamChange(bancorlite.DefaultParamspace, "TradeFeeRate", `"-1"`)))
+
+	// every touched subspace is checked
+	require.NotNil(t, submit(
+		params.NewParamChange(bankx.DefaultParamspace, "ActivationFee", `"200"`),
+		params.NewParamChange(bancorlite.DefaultParamspace, "TradeFeeRate", `"-1"`),
+	))
+
+	// the rebate ratios of all the levels must not sum past the fee
+	require.Nil(t, submit(params.NewParamChange(authx.DefaultParamspace, "UpperLevelRebateRatios", `["1000"]`)))
+	require.NotNil(t, submit(params.NewParamChange(authx.DefaultParamspace, "UpperLevelRebateRatios", `["10000"]`)))
+
+	// the reward targets must be existing module accounts
+	plans := `[{"start_height":"0","end_height":"10","reward_per_block":"1","total_incentive":"10",` +
+		`"targets":[{"module_name":"%s","share":"100"}]}]`
+	require.Nil(t, submit(params.NewParamChange(incentive.DefaultParamspace, "incentivePlans",
+		fmt.Sprintf(plans, incentive.CommunityPoolTarget))))
+	require.NotNil(t, submit(params.NewParamChange(incentive.DefaultParamspace, "incentivePlans",
+		fmt.Sprintf(plans, "no_such_module"))))
+
+	require.NotNil(t, submit(params.NewParamChange(stakingx.DefaultParamspace, "MaxValidatorBondedShare", `"0.000000000000000000"`)))
+
+	// subspaces without a validator are only checked by the params module
+	require.Nil(t, submit(params.NewParamChange(asset.DefaultParamspace, "IssueTokenFee", `"-1"`)))
+
+	// proposals were only applied to cached contexts
+	require.Equal(t, bankx.DefaultParams(), app.BankxKeeper.GetParams(ctx))
+}